@@ -14,45 +14,114 @@ import (
 	"context"
 	"github.com/keitaroinc/goa"
 	"net/http"
+	"strconv"
 )
 
-// DeleteAppAppsContext provides the apps deleteApp action context.
-type DeleteAppAppsContext struct {
+// BulkAppsAppsContext provides the apps bulkApps action context.
+type BulkAppsAppsContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	AppID string
+	IdempotencyKey *string
+	Payload        *BulkPayload
 }
 
-// NewDeleteAppAppsContext parses the incoming request URL and body, performs validations and creates the
-// context used by the apps controller deleteApp action.
-func NewDeleteAppAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*DeleteAppAppsContext, error) {
+// NewBulkAppsAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller bulkApps action.
+func NewBulkAppsAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*BulkAppsAppsContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := DeleteAppAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := BulkAppsAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	headerIdempotencyKey := req.Header["Idempotency-Key"]
+	if len(headerIdempotencyKey) > 0 {
+		rawIdempotencyKey := headerIdempotencyKey[0]
+		req.Params["Idempotency-Key"] = []string{rawIdempotencyKey}
+		rctx.IdempotencyKey = &rawIdempotencyKey
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *BulkAppsAppsContext) OK(r *BulkResult) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.bulk.result+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *BulkAppsAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Conflict sends a HTTP response with status code 409.
+func (ctx *BulkAppsAppsContext) Conflict(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 409, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *BulkAppsAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// CheckDomainVerificationAppsContext provides the apps checkDomainVerification action context.
+type CheckDomainVerificationAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID  string
+	Method string
+}
+
+// NewCheckDomainVerificationAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller checkDomainVerification action.
+func NewCheckDomainVerificationAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*CheckDomainVerificationAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := CheckDomainVerificationAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
 	paramAppID := req.Params["appId"]
 	if len(paramAppID) > 0 {
 		rawAppID := paramAppID[0]
 		rctx.AppID = rawAppID
 	}
+	paramMethod := req.Params["method"]
+	if len(paramMethod) == 0 {
+		rctx.Method = "dns"
+	} else {
+		rawMethod := paramMethod[0]
+		rctx.Method = rawMethod
+		if !(rctx.Method == "dns" || rctx.Method == "http") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`method`, rctx.Method, []interface{}{"dns", "http"}))
+		}
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *DeleteAppAppsContext) OK(resp []byte) error {
+func (ctx *CheckDomainVerificationAppsContext) OK(r *DomainVerification) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.domain.verification+json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *DeleteAppAppsContext) BadRequest(r error) error {
+func (ctx *CheckDomainVerificationAppsContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -60,7 +129,7 @@ func (ctx *DeleteAppAppsContext) BadRequest(r error) error {
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *DeleteAppAppsContext) NotFound(r error) error {
+func (ctx *CheckDomainVerificationAppsContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -68,48 +137,56 @@ func (ctx *DeleteAppAppsContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *DeleteAppAppsContext) InternalServerError(r error) error {
+func (ctx *CheckDomainVerificationAppsContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetAppsContext provides the apps get action context.
-type GetAppsContext struct {
+// CheckOriginAppsContext provides the apps checkOrigin action context.
+type CheckOriginAppsContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	AppID string
+	AppID  string
+	Origin string
 }
 
-// NewGetAppsContext parses the incoming request URL and body, performs validations and creates the
-// context used by the apps controller get action.
-func NewGetAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetAppsContext, error) {
+// NewCheckOriginAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller checkOrigin action.
+func NewCheckOriginAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*CheckOriginAppsContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := CheckOriginAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
 	paramAppID := req.Params["appId"]
 	if len(paramAppID) > 0 {
 		rawAppID := paramAppID[0]
 		rctx.AppID = rawAppID
 	}
+	paramOrigin := req.Params["origin"]
+	if len(paramOrigin) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("origin"))
+	} else {
+		rawOrigin := paramOrigin[0]
+		rctx.Origin = rawOrigin
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *GetAppsContext) OK(r *Apps) error {
+func (ctx *CheckOriginAppsContext) OK(r *OriginCheck) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.origin.check+json")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *GetAppsContext) BadRequest(r error) error {
+func (ctx *CheckOriginAppsContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -117,7 +194,7 @@ func (ctx *GetAppsContext) BadRequest(r error) error {
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *GetAppsContext) NotFound(r error) error {
+func (ctx *CheckOriginAppsContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -125,44 +202,54 @@ func (ctx *GetAppsContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetAppsContext) InternalServerError(r error) error {
+func (ctx *CheckOriginAppsContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetMyAppsAppsContext provides the apps getMyApps action context.
-type GetMyAppsAppsContext struct {
+// DeleteAppAppsContext provides the apps deleteApp action context.
+type DeleteAppAppsContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	AppID string
 }
 
-// NewGetMyAppsAppsContext parses the incoming request URL and body, performs validations and creates the
-// context used by the apps controller getMyApps action.
-func NewGetMyAppsAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetMyAppsAppsContext, error) {
+// NewDeleteAppAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller deleteApp action.
+func NewDeleteAppAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*DeleteAppAppsContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetMyAppsAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := DeleteAppAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
 	return &rctx, err
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *GetMyAppsAppsContext) OK(resp []byte) error {
+// NoContent sends a HTTP response with status code 204.
+func (ctx *DeleteAppAppsContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *DeleteAppAppsContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *GetMyAppsAppsContext) NotFound(r error) error {
+func (ctx *DeleteAppAppsContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -170,50 +257,64 @@ func (ctx *GetMyAppsAppsContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetMyAppsAppsContext) InternalServerError(r error) error {
+func (ctx *DeleteAppAppsContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetUserAppsAppsContext provides the apps getUserApps action context.
-type GetUserAppsAppsContext struct {
+// DeleteLogoAppsContext provides the apps deleteLogo action context.
+type DeleteLogoAppsContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	UserID string
+	AppID string
 }
 
-// NewGetUserAppsAppsContext parses the incoming request URL and body, performs validations and creates the
-// context used by the apps controller getUserApps action.
-func NewGetUserAppsAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetUserAppsAppsContext, error) {
+// NewDeleteLogoAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller deleteLogo action.
+func NewDeleteLogoAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*DeleteLogoAppsContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetUserAppsAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramUserID := req.Params["userId"]
-	if len(paramUserID) > 0 {
-		rawUserID := paramUserID[0]
-		rctx.UserID = rawUserID
+	rctx := DeleteLogoAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
 	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *GetUserAppsAppsContext) OK(resp []byte) error {
+func (ctx *DeleteLogoAppsContext) OK(r *Apps) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *DeleteLogoAppsContext) OKAdmin(r *AppsAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKWithSecret sends a HTTP response with status code 200.
+func (ctx *DeleteLogoAppsContext) OKWithSecret(r *AppsWithSecret) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *GetUserAppsAppsContext) NotFound(r error) error {
+func (ctx *DeleteLogoAppsContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -221,30 +322,30 @@ func (ctx *GetUserAppsAppsContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetUserAppsAppsContext) InternalServerError(r error) error {
+func (ctx *DeleteLogoAppsContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegenerateClientSecretAppsContext provides the apps regenerateClientSecret action context.
-type RegenerateClientSecretAppsContext struct {
+// DeleteRateLimitAppsContext provides the apps deleteRateLimit action context.
+type DeleteRateLimitAppsContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
 	AppID string
 }
 
-// NewRegenerateClientSecretAppsContext parses the incoming request URL and body, performs validations and creates the
-// context used by the apps controller regenerateClientSecret action.
-func NewRegenerateClientSecretAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegenerateClientSecretAppsContext, error) {
+// NewDeleteRateLimitAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller deleteRateLimit action.
+func NewDeleteRateLimitAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*DeleteRateLimitAppsContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegenerateClientSecretAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := DeleteRateLimitAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
 	paramAppID := req.Params["appId"]
 	if len(paramAppID) > 0 {
 		rawAppID := paramAppID[0]
@@ -253,18 +354,14 @@ func NewRegenerateClientSecretAppsContext(ctx context.Context, r *http.Request,
 	return &rctx, err
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *RegenerateClientSecretAppsContext) OK(resp []byte) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
-	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+// NoContent sends a HTTP response with status code 204.
+func (ctx *DeleteRateLimitAppsContext) NoContent() error {
+	ctx.ResponseData.WriteHeader(204)
+	return nil
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *RegenerateClientSecretAppsContext) BadRequest(r error) error {
+func (ctx *DeleteRateLimitAppsContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -272,7 +369,7 @@ func (ctx *RegenerateClientSecretAppsContext) BadRequest(r error) error {
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *RegenerateClientSecretAppsContext) NotFound(r error) error {
+func (ctx *DeleteRateLimitAppsContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -280,75 +377,79 @@ func (ctx *RegenerateClientSecretAppsContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegenerateClientSecretAppsContext) InternalServerError(r error) error {
+func (ctx *DeleteRateLimitAppsContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterAppAppsContext provides the apps registerApp action context.
-type RegisterAppAppsContext struct {
+// DeleteUserQuotaAppsContext provides the apps deleteUserQuota action context.
+type DeleteUserQuotaAppsContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *AppPayload
+	UserID string
 }
 
-// NewRegisterAppAppsContext parses the incoming request URL and body, performs validations and creates the
-// context used by the apps controller registerApp action.
-func NewRegisterAppAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterAppAppsContext, error) {
+// NewDeleteUserQuotaAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller deleteUserQuota action.
+func NewDeleteUserQuotaAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*DeleteUserQuotaAppsContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterAppAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := DeleteUserQuotaAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramUserID := req.Params["userId"]
+	if len(paramUserID) > 0 {
+		rawUserID := paramUserID[0]
+		rctx.UserID = rawUserID
+	}
 	return &rctx, err
 }
 
-// Created sends a HTTP response with status code 201.
-func (ctx *RegisterAppAppsContext) Created(r *RegApps) error {
+// OK sends a HTTP response with status code 200.
+func (ctx *DeleteUserQuotaAppsContext) OK(r *AppQuota) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.reg.apps+json")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.app.quota+json")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 201, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *RegisterAppAppsContext) BadRequest(r error) error {
+// NotFound sends a HTTP response with status code 404.
+func (ctx *DeleteUserQuotaAppsContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterAppAppsContext) InternalServerError(r error) error {
+func (ctx *DeleteUserQuotaAppsContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// UpdateAppAppsContext provides the apps updateApp action context.
-type UpdateAppAppsContext struct {
+// ExemptFromReapingAppsContext provides the apps exemptFromReaping action context.
+type ExemptFromReapingAppsContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	AppID   string
-	Payload *AppPayload
+	AppID string
 }
 
-// NewUpdateAppAppsContext parses the incoming request URL and body, performs validations and creates the
-// context used by the apps controller updateApp action.
-func NewUpdateAppAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*UpdateAppAppsContext, error) {
+// NewExemptFromReapingAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller exemptFromReaping action.
+func NewExemptFromReapingAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*ExemptFromReapingAppsContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := UpdateAppAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := ExemptFromReapingAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
 	paramAppID := req.Params["appId"]
 	if len(paramAppID) > 0 {
 		rawAppID := paramAppID[0]
@@ -358,7 +459,23 @@ func NewUpdateAppAppsContext(ctx context.Context, r *http.Request, service *goa.
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *UpdateAppAppsContext) OK(r *Apps) error {
+func (ctx *ExemptFromReapingAppsContext) OK(r *Apps) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *ExemptFromReapingAppsContext) OKAdmin(r *AppsAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKWithSecret sends a HTTP response with status code 200.
+func (ctx *ExemptFromReapingAppsContext) OKWithSecret(r *AppsWithSecret) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
 	}
@@ -366,7 +483,7 @@ func (ctx *UpdateAppAppsContext) OK(r *Apps) error {
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *UpdateAppAppsContext) BadRequest(r error) error {
+func (ctx *ExemptFromReapingAppsContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -374,7 +491,7 @@ func (ctx *UpdateAppAppsContext) BadRequest(r error) error {
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *UpdateAppAppsContext) NotFound(r error) error {
+func (ctx *ExemptFromReapingAppsContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -382,35 +499,1539 @@ func (ctx *UpdateAppAppsContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *UpdateAppAppsContext) InternalServerError(r error) error {
+func (ctx *ExemptFromReapingAppsContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// VerifyAppAppsContext provides the apps verifyApp action context.
-type VerifyAppAppsContext struct {
+// ExportAppsAppsContext provides the apps exportApps action context.
+type ExportAppsAppsContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *AppCredentialsPayload
+	Domain         *string
+	Format         string
+	Owner          *string
+	RegisteredFrom *string
+	RegisteredTo   *string
+	Status         *string
 }
 
-// NewVerifyAppAppsContext parses the incoming request URL and body, performs validations and creates the
-// context used by the apps controller verifyApp action.
-func NewVerifyAppAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*VerifyAppAppsContext, error) {
+// NewExportAppsAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller exportApps action.
+func NewExportAppsAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*ExportAppsAppsContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := VerifyAppAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := ExportAppsAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramDomain := req.Params["domain"]
+	if len(paramDomain) > 0 {
+		rawDomain := paramDomain[0]
+		rctx.Domain = &rawDomain
+	}
+	paramFormat := req.Params["format"]
+	if len(paramFormat) == 0 {
+		rctx.Format = "csv"
+	} else {
+		rawFormat := paramFormat[0]
+		rctx.Format = rawFormat
+		if !(rctx.Format == "csv" || rctx.Format == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`format`, rctx.Format, []interface{}{"csv", "json"}))
+		}
+	}
+	paramOwner := req.Params["owner"]
+	if len(paramOwner) > 0 {
+		rawOwner := paramOwner[0]
+		rctx.Owner = &rawOwner
+	}
+	paramRegisteredFrom := req.Params["registeredFrom"]
+	if len(paramRegisteredFrom) > 0 {
+		rawRegisteredFrom := paramRegisteredFrom[0]
+		rctx.RegisteredFrom = &rawRegisteredFrom
+		if rctx.RegisteredFrom != nil {
+			if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *rctx.RegisteredFrom); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`registeredFrom`, *rctx.RegisteredFrom, `^\d{4}-\d{2}-\d{2}$`))
+			}
+		}
+	}
+	paramRegisteredTo := req.Params["registeredTo"]
+	if len(paramRegisteredTo) > 0 {
+		rawRegisteredTo := paramRegisteredTo[0]
+		rctx.RegisteredTo = &rawRegisteredTo
+		if rctx.RegisteredTo != nil {
+			if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *rctx.RegisteredTo); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`registeredTo`, *rctx.RegisteredTo, `^\d{4}-\d{2}-\d{2}$`))
+			}
+		}
+	}
+	paramStatus := req.Params["status"]
+	if len(paramStatus) > 0 {
+		rawStatus := paramStatus[0]
+		rctx.Status = &rawStatus
+		if rctx.Status != nil {
+			if !(*rctx.Status == "active" || *rctx.Status == "flagged" || *rctx.Status == "suspended" || *rctx.Status == "deleted") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError(`status`, *rctx.Status, []interface{}{"active", "flagged", "suspended", "deleted"}))
+			}
+		}
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *VerifyAppAppsContext) OK(r *Apps) error {
+func (ctx *ExportAppsAppsContext) OK(resp []byte) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+	}
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *ExportAppsAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ExportAppsAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetAppsContext provides the apps get action context.
+type GetAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID string
+}
+
+// NewGetAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller get action.
+func NewGetAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetAppsContext) OK(r *Apps) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *GetAppsContext) OKAdmin(r *AppsAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKWithSecret sends a HTTP response with status code 200.
+func (ctx *GetAppsContext) OKWithSecret(r *AppsWithSecret) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *GetAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetLogoAppsContext provides the apps getLogo action context.
+type GetLogoAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID       string
+	IfNoneMatch *string
+	Size        string
+}
+
+// NewGetLogoAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller getLogo action.
+func NewGetLogoAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetLogoAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetLogoAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	headerIfNoneMatch := req.Header["If-None-Match"]
+	if len(headerIfNoneMatch) > 0 {
+		rawIfNoneMatch := headerIfNoneMatch[0]
+		req.Params["If-None-Match"] = []string{rawIfNoneMatch}
+		rctx.IfNoneMatch = &rawIfNoneMatch
+	}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
+	paramSize := req.Params["size"]
+	if len(paramSize) == 0 {
+		rctx.Size = "original"
+	} else {
+		rawSize := paramSize[0]
+		rctx.Size = rawSize
+		if !(rctx.Size == "original" || rctx.Size == "thumbnail") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`size`, rctx.Size, []interface{}{"original", "thumbnail"}))
+		}
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetLogoAppsContext) OK(resp []byte) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+	}
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
+}
+
+// NotModified sends a HTTP response with status code 304.
+func (ctx *GetLogoAppsContext) NotModified() error {
+	ctx.ResponseData.WriteHeader(304)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetLogoAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetLogoAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetMyAppsAppsContext provides the apps getMyApps action context.
+type GetMyAppsAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Selector *string
+}
+
+// NewGetMyAppsAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller getMyApps action.
+func NewGetMyAppsAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetMyAppsAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetMyAppsAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramSelector := req.Params["selector"]
+	if len(paramSelector) > 0 {
+		rawSelector := paramSelector[0]
+		rctx.Selector = &rawSelector
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetMyAppsAppsContext) OK(r AppsCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json; type=collection")
+	}
+	if r == nil {
+		r = AppsCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *GetMyAppsAppsContext) OKAdmin(r AppsAdminCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json; type=collection")
+	}
+	if r == nil {
+		r = AppsAdminCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKWithSecret sends a HTTP response with status code 200.
+func (ctx *GetMyAppsAppsContext) OKWithSecret(r AppsWithSecretCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json; type=collection")
+	}
+	if r == nil {
+		r = AppsWithSecretCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *GetMyAppsAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetMyAppsAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetMyAppsAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetPublicAppsContext provides the apps getPublic action context.
+type GetPublicAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID string
+}
+
+// NewGetPublicAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller getPublic action.
+func NewGetPublicAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetPublicAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetPublicAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetPublicAppsContext) OK(r *PublicApp) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.public.app+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetPublicAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetPublicAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetRateLimitAppsContext provides the apps getRateLimit action context.
+type GetRateLimitAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID string
+}
+
+// NewGetRateLimitAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller getRateLimit action.
+func NewGetRateLimitAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetRateLimitAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetRateLimitAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetRateLimitAppsContext) OK(r *RateLimit) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.rate.limit+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *GetRateLimitAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetRateLimitAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetRateLimitAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetReapingCandidatesAppsContext provides the apps getReapingCandidates action context.
+type GetReapingCandidatesAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+}
+
+// NewGetReapingCandidatesAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller getReapingCandidates action.
+func NewGetReapingCandidatesAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetReapingCandidatesAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetReapingCandidatesAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetReapingCandidatesAppsContext) OK(r *ReapingReport) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.reaping.report+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetReapingCandidatesAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetUsageAppsContext provides the apps getUsage action context.
+type GetUsageAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID string
+	From  *string
+	To    *string
+}
+
+// NewGetUsageAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller getUsage action.
+func NewGetUsageAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetUsageAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetUsageAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
+	paramFrom := req.Params["from"]
+	if len(paramFrom) > 0 {
+		rawFrom := paramFrom[0]
+		rctx.From = &rawFrom
+		if rctx.From != nil {
+			if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *rctx.From); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`from`, *rctx.From, `^\d{4}-\d{2}-\d{2}$`))
+			}
+		}
+	}
+	paramTo := req.Params["to"]
+	if len(paramTo) > 0 {
+		rawTo := paramTo[0]
+		rctx.To = &rawTo
+		if rctx.To != nil {
+			if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *rctx.To); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`to`, *rctx.To, `^\d{4}-\d{2}-\d{2}$`))
+			}
+		}
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetUsageAppsContext) OK(r *AppUsage) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.app.usage+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *GetUsageAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetUsageAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetUsageAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetUserAppsAppsContext provides the apps getUserApps action context.
+type GetUserAppsAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Selector *string
+	UserID   string
+}
+
+// NewGetUserAppsAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller getUserApps action.
+func NewGetUserAppsAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetUserAppsAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetUserAppsAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramUserID := req.Params["userId"]
+	if len(paramUserID) > 0 {
+		rawUserID := paramUserID[0]
+		rctx.UserID = rawUserID
+	}
+	paramSelector := req.Params["selector"]
+	if len(paramSelector) > 0 {
+		rawSelector := paramSelector[0]
+		rctx.Selector = &rawSelector
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetUserAppsAppsContext) OK(r AppsCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json; type=collection")
+	}
+	if r == nil {
+		r = AppsCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *GetUserAppsAppsContext) OKAdmin(r AppsAdminCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json; type=collection")
+	}
+	if r == nil {
+		r = AppsAdminCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKWithSecret sends a HTTP response with status code 200.
+func (ctx *GetUserAppsAppsContext) OKWithSecret(r AppsWithSecretCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json; type=collection")
+	}
+	if r == nil {
+		r = AppsWithSecretCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *GetUserAppsAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetUserAppsAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetUserAppsAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetUserQuotaAppsContext provides the apps getUserQuota action context.
+type GetUserQuotaAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	UserID string
+}
+
+// NewGetUserQuotaAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller getUserQuota action.
+func NewGetUserQuotaAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetUserQuotaAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetUserQuotaAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramUserID := req.Params["userId"]
+	if len(paramUserID) > 0 {
+		rawUserID := paramUserID[0]
+		rctx.UserID = rawUserID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetUserQuotaAppsContext) OK(r *AppQuota) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.app.quota+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetUserQuotaAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// IssueDomainVerificationAppsContext provides the apps issueDomainVerification action context.
+type IssueDomainVerificationAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID          string
+	IdempotencyKey *string
+}
+
+// NewIssueDomainVerificationAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller issueDomainVerification action.
+func NewIssueDomainVerificationAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*IssueDomainVerificationAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := IssueDomainVerificationAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	headerIdempotencyKey := req.Header["Idempotency-Key"]
+	if len(headerIdempotencyKey) > 0 {
+		rawIdempotencyKey := headerIdempotencyKey[0]
+		req.Params["Idempotency-Key"] = []string{rawIdempotencyKey}
+		rctx.IdempotencyKey = &rawIdempotencyKey
+	}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *IssueDomainVerificationAppsContext) OK(r *DomainVerification) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.domain.verification+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *IssueDomainVerificationAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *IssueDomainVerificationAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// Conflict sends a HTTP response with status code 409.
+func (ctx *IssueDomainVerificationAppsContext) Conflict(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 409, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *IssueDomainVerificationAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ListAppsAppsContext provides the apps listApps action context.
+type ListAppsAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Domain         *string
+	Owner          *string
+	Page           int
+	PageSize       int
+	RegisteredFrom *string
+	RegisteredTo   *string
+	Status         *string
+}
+
+// NewListAppsAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller listApps action.
+func NewListAppsAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListAppsAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ListAppsAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramDomain := req.Params["domain"]
+	if len(paramDomain) > 0 {
+		rawDomain := paramDomain[0]
+		rctx.Domain = &rawDomain
+	}
+	paramOwner := req.Params["owner"]
+	if len(paramOwner) > 0 {
+		rawOwner := paramOwner[0]
+		rctx.Owner = &rawOwner
+	}
+	paramPage := req.Params["page"]
+	if len(paramPage) == 0 {
+		rctx.Page = 1
+	} else {
+		rawPage := paramPage[0]
+		if page, err2 := strconv.Atoi(rawPage); err2 == nil {
+			rctx.Page = page
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("page", rawPage, "integer"))
+		}
+		if rctx.Page < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`page`, rctx.Page, 1, true))
+		}
+	}
+	paramPageSize := req.Params["pageSize"]
+	if len(paramPageSize) == 0 {
+		rctx.PageSize = 50
+	} else {
+		rawPageSize := paramPageSize[0]
+		if pageSize, err2 := strconv.Atoi(rawPageSize); err2 == nil {
+			rctx.PageSize = pageSize
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("pageSize", rawPageSize, "integer"))
+		}
+		if rctx.PageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`pageSize`, rctx.PageSize, 1, true))
+		}
+		if rctx.PageSize > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`pageSize`, rctx.PageSize, 500, false))
+		}
+	}
+	paramRegisteredFrom := req.Params["registeredFrom"]
+	if len(paramRegisteredFrom) > 0 {
+		rawRegisteredFrom := paramRegisteredFrom[0]
+		rctx.RegisteredFrom = &rawRegisteredFrom
+		if rctx.RegisteredFrom != nil {
+			if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *rctx.RegisteredFrom); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`registeredFrom`, *rctx.RegisteredFrom, `^\d{4}-\d{2}-\d{2}$`))
+			}
+		}
+	}
+	paramRegisteredTo := req.Params["registeredTo"]
+	if len(paramRegisteredTo) > 0 {
+		rawRegisteredTo := paramRegisteredTo[0]
+		rctx.RegisteredTo = &rawRegisteredTo
+		if rctx.RegisteredTo != nil {
+			if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *rctx.RegisteredTo); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`registeredTo`, *rctx.RegisteredTo, `^\d{4}-\d{2}-\d{2}$`))
+			}
+		}
+	}
+	paramStatus := req.Params["status"]
+	if len(paramStatus) > 0 {
+		rawStatus := paramStatus[0]
+		rctx.Status = &rawStatus
+		if rctx.Status != nil {
+			if !(*rctx.Status == "active" || *rctx.Status == "flagged" || *rctx.Status == "suspended" || *rctx.Status == "deleted") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError(`status`, *rctx.Status, []interface{}{"active", "flagged", "suspended", "deleted"}))
+			}
+		}
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *ListAppsAppsContext) OK(r *AppPage) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.app.page+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *ListAppsAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ListAppsAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RegenerateClientSecretAppsContext provides the apps regenerateClientSecret action context.
+type RegenerateClientSecretAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID string
+}
+
+// NewRegenerateClientSecretAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller regenerateClientSecret action.
+func NewRegenerateClientSecretAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegenerateClientSecretAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RegenerateClientSecretAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *RegenerateClientSecretAppsContext) OK(r *Apps) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *RegenerateClientSecretAppsContext) OKAdmin(r *AppsAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKWithSecret sends a HTTP response with status code 200.
+func (ctx *RegenerateClientSecretAppsContext) OKWithSecret(r *AppsWithSecret) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *RegenerateClientSecretAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RegenerateClientSecretAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RegenerateClientSecretAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RegisterAppAppsContext provides the apps registerApp action context.
+type RegisterAppAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	IdempotencyKey *string
+	Payload        *AppPayload
+}
+
+// NewRegisterAppAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller registerApp action.
+func NewRegisterAppAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterAppAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RegisterAppAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	headerIdempotencyKey := req.Header["Idempotency-Key"]
+	if len(headerIdempotencyKey) > 0 {
+		rawIdempotencyKey := headerIdempotencyKey[0]
+		req.Params["Idempotency-Key"] = []string{rawIdempotencyKey}
+		rctx.IdempotencyKey = &rawIdempotencyKey
+	}
+	return &rctx, err
+}
+
+// Created sends a HTTP response with status code 201.
+func (ctx *RegisterAppAppsContext) Created(r *RegApps) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.reg.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 201, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *RegisterAppAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *RegisterAppAppsContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// Conflict sends a HTTP response with status code 409.
+func (ctx *RegisterAppAppsContext) Conflict(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 409, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RegisterAppAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RemoveReapingExemptionAppsContext provides the apps removeReapingExemption action context.
+type RemoveReapingExemptionAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID string
+}
+
+// NewRemoveReapingExemptionAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller removeReapingExemption action.
+func NewRemoveReapingExemptionAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveReapingExemptionAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RemoveReapingExemptionAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *RemoveReapingExemptionAppsContext) OK(r *Apps) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *RemoveReapingExemptionAppsContext) OKAdmin(r *AppsAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKWithSecret sends a HTTP response with status code 200.
+func (ctx *RemoveReapingExemptionAppsContext) OKWithSecret(r *AppsWithSecret) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *RemoveReapingExemptionAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RemoveReapingExemptionAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RemoveReapingExemptionAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// SearchAppsAppsContext provides the apps searchApps action context.
+type SearchAppsAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Limit          int
+	Owner          *string
+	Q              *string
+	RegisteredFrom *string
+	RegisteredTo   *string
+	Status         *string
+}
+
+// NewSearchAppsAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller searchApps action.
+func NewSearchAppsAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*SearchAppsAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := SearchAppsAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramLimit := req.Params["limit"]
+	if len(paramLimit) == 0 {
+		rctx.Limit = 50
+	} else {
+		rawLimit := paramLimit[0]
+		if limit, err2 := strconv.Atoi(rawLimit); err2 == nil {
+			rctx.Limit = limit
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("limit", rawLimit, "integer"))
+		}
+		if rctx.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`limit`, rctx.Limit, 1, true))
+		}
+		if rctx.Limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`limit`, rctx.Limit, 500, false))
+		}
+	}
+	paramOwner := req.Params["owner"]
+	if len(paramOwner) > 0 {
+		rawOwner := paramOwner[0]
+		rctx.Owner = &rawOwner
+	}
+	paramQ := req.Params["q"]
+	if len(paramQ) > 0 {
+		rawQ := paramQ[0]
+		rctx.Q = &rawQ
+	}
+	paramRegisteredFrom := req.Params["registeredFrom"]
+	if len(paramRegisteredFrom) > 0 {
+		rawRegisteredFrom := paramRegisteredFrom[0]
+		rctx.RegisteredFrom = &rawRegisteredFrom
+		if rctx.RegisteredFrom != nil {
+			if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *rctx.RegisteredFrom); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`registeredFrom`, *rctx.RegisteredFrom, `^\d{4}-\d{2}-\d{2}$`))
+			}
+		}
+	}
+	paramRegisteredTo := req.Params["registeredTo"]
+	if len(paramRegisteredTo) > 0 {
+		rawRegisteredTo := paramRegisteredTo[0]
+		rctx.RegisteredTo = &rawRegisteredTo
+		if rctx.RegisteredTo != nil {
+			if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *rctx.RegisteredTo); !ok {
+				err = goa.MergeErrors(err, goa.InvalidPatternError(`registeredTo`, *rctx.RegisteredTo, `^\d{4}-\d{2}-\d{2}$`))
+			}
+		}
+	}
+	paramStatus := req.Params["status"]
+	if len(paramStatus) > 0 {
+		rawStatus := paramStatus[0]
+		rctx.Status = &rawStatus
+		if rctx.Status != nil {
+			if !(*rctx.Status == "active" || *rctx.Status == "flagged" || *rctx.Status == "suspended" || *rctx.Status == "deleted") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError(`status`, *rctx.Status, []interface{}{"active", "flagged", "suspended", "deleted"}))
+			}
+		}
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *SearchAppsAppsContext) OK(r AppsCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json; type=collection")
+	}
+	if r == nil {
+		r = AppsCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *SearchAppsAppsContext) OKAdmin(r AppsAdminCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json; type=collection")
+	}
+	if r == nil {
+		r = AppsAdminCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKWithSecret sends a HTTP response with status code 200.
+func (ctx *SearchAppsAppsContext) OKWithSecret(r AppsWithSecretCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json; type=collection")
+	}
+	if r == nil {
+		r = AppsWithSecretCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *SearchAppsAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *SearchAppsAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// SetRateLimitAppsContext provides the apps setRateLimit action context.
+type SetRateLimitAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID   string
+	Payload *RateLimitPayload
+}
+
+// NewSetRateLimitAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller setRateLimit action.
+func NewSetRateLimitAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*SetRateLimitAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := SetRateLimitAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *SetRateLimitAppsContext) OK(r *RateLimit) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.rate.limit+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *SetRateLimitAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *SetRateLimitAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *SetRateLimitAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// SetUserQuotaAppsContext provides the apps setUserQuota action context.
+type SetUserQuotaAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	UserID  string
+	Payload *AppQuotaPayload
+}
+
+// NewSetUserQuotaAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller setUserQuota action.
+func NewSetUserQuotaAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*SetUserQuotaAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := SetUserQuotaAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramUserID := req.Params["userId"]
+	if len(paramUserID) > 0 {
+		rawUserID := paramUserID[0]
+		rctx.UserID = rawUserID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *SetUserQuotaAppsContext) OK(r *AppQuota) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.app.quota+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *SetUserQuotaAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *SetUserQuotaAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// UpdateAppAppsContext provides the apps updateApp action context.
+type UpdateAppAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID   string
+	Payload *AppPayload
+}
+
+// NewUpdateAppAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller updateApp action.
+func NewUpdateAppAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*UpdateAppAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := UpdateAppAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *UpdateAppAppsContext) OK(r *Apps) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *UpdateAppAppsContext) OKAdmin(r *AppsAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKWithSecret sends a HTTP response with status code 200.
+func (ctx *UpdateAppAppsContext) OKWithSecret(r *AppsWithSecret) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *UpdateAppAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *UpdateAppAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// Conflict sends a HTTP response with status code 409.
+func (ctx *UpdateAppAppsContext) Conflict(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 409, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *UpdateAppAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// UploadLogoAppsContext provides the apps uploadLogo action context.
+type UploadLogoAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	AppID string
+}
+
+// NewUploadLogoAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller uploadLogo action.
+func NewUploadLogoAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*UploadLogoAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := UploadLogoAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAppID := req.Params["appId"]
+	if len(paramAppID) > 0 {
+		rawAppID := paramAppID[0]
+		rctx.AppID = rawAppID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *UploadLogoAppsContext) OK(r *Apps) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *UploadLogoAppsContext) OKAdmin(r *AppsAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKWithSecret sends a HTTP response with status code 200.
+func (ctx *UploadLogoAppsContext) OKWithSecret(r *AppsWithSecret) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *UploadLogoAppsContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *UploadLogoAppsContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// RequestEntityTooLarge sends a HTTP response with status code 413.
+func (ctx *UploadLogoAppsContext) RequestEntityTooLarge(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 413, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *UploadLogoAppsContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// VerifyAppAppsContext provides the apps verifyApp action context.
+type VerifyAppAppsContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Payload *AppCredentialsPayload
+}
+
+// NewVerifyAppAppsContext parses the incoming request URL and body, performs validations and creates the
+// context used by the apps controller verifyApp action.
+func NewVerifyAppAppsContext(ctx context.Context, r *http.Request, service *goa.Service) (*VerifyAppAppsContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := VerifyAppAppsContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *VerifyAppAppsContext) OK(r *Apps) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *VerifyAppAppsContext) OKAdmin(r *AppsAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKWithSecret sends a HTTP response with status code 200.
+func (ctx *VerifyAppAppsContext) OKWithSecret(r *AppsWithSecret) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.apps+json")
 	}
@@ -35,13 +35,34 @@ func initService(service *goa.Service) {
 // AppsController is the controller interface for the Apps actions.
 type AppsController interface {
 	goa.Muxer
+	BulkApps(*BulkAppsAppsContext) error
+	CheckDomainVerification(*CheckDomainVerificationAppsContext) error
+	CheckOrigin(*CheckOriginAppsContext) error
 	DeleteApp(*DeleteAppAppsContext) error
+	DeleteLogo(*DeleteLogoAppsContext) error
+	DeleteRateLimit(*DeleteRateLimitAppsContext) error
+	DeleteUserQuota(*DeleteUserQuotaAppsContext) error
+	ExemptFromReaping(*ExemptFromReapingAppsContext) error
+	ExportApps(*ExportAppsAppsContext) error
 	Get(*GetAppsContext) error
+	GetLogo(*GetLogoAppsContext) error
 	GetMyApps(*GetMyAppsAppsContext) error
+	GetPublic(*GetPublicAppsContext) error
+	GetRateLimit(*GetRateLimitAppsContext) error
+	GetReapingCandidates(*GetReapingCandidatesAppsContext) error
+	GetUsage(*GetUsageAppsContext) error
 	GetUserApps(*GetUserAppsAppsContext) error
+	GetUserQuota(*GetUserQuotaAppsContext) error
+	IssueDomainVerification(*IssueDomainVerificationAppsContext) error
+	ListApps(*ListAppsAppsContext) error
 	RegenerateClientSecret(*RegenerateClientSecretAppsContext) error
 	RegisterApp(*RegisterAppAppsContext) error
+	RemoveReapingExemption(*RemoveReapingExemptionAppsContext) error
+	SearchApps(*SearchAppsAppsContext) error
+	SetRateLimit(*SetRateLimitAppsContext) error
+	SetUserQuota(*SetUserQuotaAppsContext) error
 	UpdateApp(*UpdateAppAppsContext) error
+	UploadLogo(*UploadLogoAppsContext) error
 	VerifyApp(*VerifyAppAppsContext) error
 }
 
@@ -49,13 +70,80 @@ type AppsController interface {
 func MountAppsController(service *goa.Service, ctrl AppsController) {
 	initService(service)
 	var h goa.Handler
+	service.Mux.Handle("OPTIONS", "/apps/bulk", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/:appId/domain-verification", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/:appId/origins/check", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
 	service.Mux.Handle("OPTIONS", "/apps/:appId", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/:appId/logo", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/:appId/rate-limit", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/users/:userId/quota", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/:appId/reaping-exemption", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/all/export", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
 	service.Mux.Handle("OPTIONS", "/apps/my", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/:appId/public", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/reaping/candidates", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/:appId/usage", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
 	service.Mux.Handle("OPTIONS", "/apps/users/:userId/all", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/all", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
 	service.Mux.Handle("OPTIONS", "/apps/:appId/regenerate-secret", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
 	service.Mux.Handle("OPTIONS", "/apps", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/apps/search", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
 	service.Mux.Handle("OPTIONS", "/apps/verify", ctrl.MuxHandler("preflight", handleAppsOrigin(cors.HandlePreflight()), nil))
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewBulkAppsAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*BulkPayload)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.BulkApps(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("POST", "/apps/bulk", ctrl.MuxHandler("bulkApps", h, unmarshalBulkAppsAppsPayload))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "BulkApps", "route", "POST /apps/bulk")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewCheckDomainVerificationAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.CheckDomainVerification(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("PUT", "/apps/:appId/domain-verification", ctrl.MuxHandler("checkDomainVerification", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "CheckDomainVerification", "route", "PUT /apps/:appId/domain-verification")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewCheckOriginAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.CheckOrigin(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("GET", "/apps/:appId/origins/check", ctrl.MuxHandler("checkOrigin", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "CheckOrigin", "route", "GET /apps/:appId/origins/check")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -72,6 +160,86 @@ func MountAppsController(service *goa.Service, ctrl AppsController) {
 	service.Mux.Handle("DELETE", "/apps/:appId", ctrl.MuxHandler("deleteApp", h, nil))
 	service.LogInfo("mount", "ctrl", "Apps", "action", "DeleteApp", "route", "DELETE /apps/:appId")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewDeleteLogoAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.DeleteLogo(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("DELETE", "/apps/:appId/logo", ctrl.MuxHandler("deleteLogo", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "DeleteLogo", "route", "DELETE /apps/:appId/logo")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewDeleteRateLimitAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.DeleteRateLimit(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("DELETE", "/apps/:appId/rate-limit", ctrl.MuxHandler("deleteRateLimit", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "DeleteRateLimit", "route", "DELETE /apps/:appId/rate-limit")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewDeleteUserQuotaAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.DeleteUserQuota(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("DELETE", "/apps/users/:userId/quota", ctrl.MuxHandler("deleteUserQuota", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "DeleteUserQuota", "route", "DELETE /apps/users/:userId/quota")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewExemptFromReapingAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.ExemptFromReaping(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("PUT", "/apps/:appId/reaping-exemption", ctrl.MuxHandler("exemptFromReaping", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "ExemptFromReaping", "route", "PUT /apps/:appId/reaping-exemption")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewExportAppsAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.ExportApps(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("GET", "/apps/all/export", ctrl.MuxHandler("exportApps", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "ExportApps", "route", "GET /apps/all/export")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -88,6 +256,22 @@ func MountAppsController(service *goa.Service, ctrl AppsController) {
 	service.Mux.Handle("GET", "/apps/:appId", ctrl.MuxHandler("get", h, nil))
 	service.LogInfo("mount", "ctrl", "Apps", "action", "Get", "route", "GET /apps/:appId")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewGetLogoAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetLogo(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("GET", "/apps/:appId/logo", ctrl.MuxHandler("getLogo", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "GetLogo", "route", "GET /apps/:appId/logo")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -104,6 +288,70 @@ func MountAppsController(service *goa.Service, ctrl AppsController) {
 	service.Mux.Handle("GET", "/apps/my", ctrl.MuxHandler("getMyApps", h, nil))
 	service.LogInfo("mount", "ctrl", "Apps", "action", "GetMyApps", "route", "GET /apps/my")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewGetPublicAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetPublic(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("GET", "/apps/:appId/public", ctrl.MuxHandler("getPublic", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "GetPublic", "route", "GET /apps/:appId/public")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewGetRateLimitAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetRateLimit(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("GET", "/apps/:appId/rate-limit", ctrl.MuxHandler("getRateLimit", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "GetRateLimit", "route", "GET /apps/:appId/rate-limit")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewGetReapingCandidatesAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetReapingCandidates(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("GET", "/apps/reaping/candidates", ctrl.MuxHandler("getReapingCandidates", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "GetReapingCandidates", "route", "GET /apps/reaping/candidates")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewGetUsageAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetUsage(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("GET", "/apps/:appId/usage", ctrl.MuxHandler("getUsage", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "GetUsage", "route", "GET /apps/:appId/usage")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -120,6 +368,54 @@ func MountAppsController(service *goa.Service, ctrl AppsController) {
 	service.Mux.Handle("GET", "/apps/users/:userId/all", ctrl.MuxHandler("getUserApps", h, nil))
 	service.LogInfo("mount", "ctrl", "Apps", "action", "GetUserApps", "route", "GET /apps/users/:userId/all")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewGetUserQuotaAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetUserQuota(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("GET", "/apps/users/:userId/quota", ctrl.MuxHandler("getUserQuota", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "GetUserQuota", "route", "GET /apps/users/:userId/quota")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewIssueDomainVerificationAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.IssueDomainVerification(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("POST", "/apps/:appId/domain-verification", ctrl.MuxHandler("issueDomainVerification", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "IssueDomainVerification", "route", "POST /apps/:appId/domain-verification")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewListAppsAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.ListApps(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("GET", "/apps/all", ctrl.MuxHandler("listApps", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "ListApps", "route", "GET /apps/all")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -158,6 +454,82 @@ func MountAppsController(service *goa.Service, ctrl AppsController) {
 	service.Mux.Handle("POST", "/apps", ctrl.MuxHandler("registerApp", h, unmarshalRegisterAppAppsPayload))
 	service.LogInfo("mount", "ctrl", "Apps", "action", "RegisterApp", "route", "POST /apps")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRemoveReapingExemptionAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.RemoveReapingExemption(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("DELETE", "/apps/:appId/reaping-exemption", ctrl.MuxHandler("removeReapingExemption", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "RemoveReapingExemption", "route", "DELETE /apps/:appId/reaping-exemption")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSearchAppsAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.SearchApps(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("GET", "/apps/search", ctrl.MuxHandler("searchApps", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "SearchApps", "route", "GET /apps/search")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSetRateLimitAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*RateLimitPayload)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.SetRateLimit(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("PUT", "/apps/:appId/rate-limit", ctrl.MuxHandler("setRateLimit", h, unmarshalSetRateLimitAppsPayload))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "SetRateLimit", "route", "PUT /apps/:appId/rate-limit")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSetUserQuotaAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*AppQuotaPayload)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.SetUserQuota(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("PUT", "/apps/users/:userId/quota", ctrl.MuxHandler("setUserQuota", h, unmarshalSetUserQuotaAppsPayload))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "SetUserQuota", "route", "PUT /apps/users/:userId/quota")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -180,6 +552,22 @@ func MountAppsController(service *goa.Service, ctrl AppsController) {
 	service.Mux.Handle("PUT", "/apps/:appId", ctrl.MuxHandler("updateApp", h, unmarshalUpdateAppAppsPayload))
 	service.LogInfo("mount", "ctrl", "Apps", "action", "UpdateApp", "route", "PUT /apps/:appId")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewUploadLogoAppsContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.UploadLogo(rctx)
+	}
+	h = handleAppsOrigin(h)
+	service.Mux.Handle("PUT", "/apps/:appId/logo", ctrl.MuxHandler("uploadLogo", h, nil))
+	service.LogInfo("mount", "ctrl", "Apps", "action", "UploadLogo", "route", "PUT /apps/:appId/logo")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -227,6 +615,21 @@ func handleAppsOrigin(h goa.Handler) goa.Handler {
 	}
 }
 
+// unmarshalBulkAppsAppsPayload unmarshals the request body into the context request data Payload field.
+func unmarshalBulkAppsAppsPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &bulkPayload{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
 // unmarshalRegisterAppAppsPayload unmarshals the request body into the context request data Payload field.
 func unmarshalRegisterAppAppsPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
 	payload := &appPayload{}
@@ -242,6 +645,36 @@ func unmarshalRegisterAppAppsPayload(ctx context.Context, service *goa.Service,
 	return nil
 }
 
+// unmarshalSetRateLimitAppsPayload unmarshals the request body into the context request data Payload field.
+func unmarshalSetRateLimitAppsPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &rateLimitPayload{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalSetUserQuotaAppsPayload unmarshals the request body into the context request data Payload field.
+func unmarshalSetUserQuotaAppsPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &appQuotaPayload{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
 // unmarshalUpdateAppAppsPayload unmarshals the request body into the context request data Payload field.
 func unmarshalUpdateAppAppsPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
 	payload := &appPayload{}
@@ -15,22 +15,134 @@ import (
 	"unicode/utf8"
 )
 
+// app-page media type (default view)
+//
+// Identifier: application/vnd.goa.app.page+json; view=default
+type AppPage struct {
+	// The apps on the page
+	Apps AppsAdminCollection `form:"apps" json:"apps" yaml:"apps" xml:"apps"`
+	// Number of the page, starting from 1
+	Page int `form:"page" json:"page" yaml:"page" xml:"page"`
+	// Maximal number of apps on a page
+	PageSize int `form:"pageSize" json:"pageSize" yaml:"pageSize" xml:"pageSize"`
+	// Number of apps that match the filters, on all pages
+	Total int `form:"total" json:"total" yaml:"total" xml:"total"`
+}
+
+// Validate validates the AppPage media type instance.
+func (mt *AppPage) Validate() (err error) {
+	if mt.Apps == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "apps"))
+	}
+
+	if err2 := mt.Apps.Validate(); err2 != nil {
+		err = goa.MergeErrors(err, err2)
+	}
+	return
+}
+
+// app-quota media type (default view)
+//
+// Identifier: application/vnd.goa.app.quota+json; view=default
+type AppQuota struct {
+	// Maximal number of apps the user can register. 0 means no limit.
+	MaxApps int `form:"maxApps" json:"maxApps" yaml:"maxApps" xml:"maxApps"`
+	// Whether the maximal number of apps is set for this user, instead of the default
+	Override bool `form:"override" json:"override" yaml:"override" xml:"override"`
+	// Number of apps the user has registered
+	Used int `form:"used" json:"used" yaml:"used" xml:"used"`
+	// User ID
+	UserID string `form:"userId" json:"userId" yaml:"userId" xml:"userId"`
+}
+
+// Validate validates the AppQuota media type instance.
+func (mt *AppQuota) Validate() (err error) {
+	if mt.UserID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "userId"))
+	}
+
+	if mt.MaxApps < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError(`response.maxApps`, mt.MaxApps, 0, true))
+	}
+	return
+}
+
+// app-usage media type (default view)
+//
+// Identifier: application/vnd.goa.app.usage+json; view=default
+type AppUsage struct {
+	// App ID
+	AppID string `form:"appId" json:"appId" yaml:"appId" xml:"appId"`
+	// Verification counts per day
+	Daily []*DailyUsage `form:"daily" json:"daily" yaml:"daily" xml:"daily"`
+	// Time of the last successful verification of the app
+	LastUsedAt *int `form:"lastUsedAt,omitempty" json:"lastUsedAt,omitempty" yaml:"lastUsedAt,omitempty" xml:"lastUsedAt,omitempty"`
+	// IP address from which the app was last successfully verified
+	LastUsedIP *string `form:"lastUsedIP,omitempty" json:"lastUsedIP,omitempty" yaml:"lastUsedIP,omitempty" xml:"lastUsedIP,omitempty"`
+}
+
+// Validate validates the AppUsage media type instance.
+func (mt *AppUsage) Validate() (err error) {
+	if mt.AppID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "appId"))
+	}
+	if mt.Daily == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "daily"))
+	}
+	for _, e := range mt.Daily {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
 // apps media type (default view)
 //
 // Identifier: application/vnd.goa.apps+json; view=default
 type Apps struct {
+	// Annotations of the app, free-form metadata that is not used to select apps
+	Annotations map[string]string `form:"annotations,omitempty" json:"annotations,omitempty" yaml:"annotations,omitempty" xml:"annotations,omitempty"`
+	// Email addresses of the people responsible for the app
+	Contacts []string `form:"contacts,omitempty" json:"contacts,omitempty" yaml:"contacts,omitempty" xml:"contacts,omitempty"`
 	// Description of the app
 	Description string `form:"description" json:"description" yaml:"description" xml:"description"`
 	// App domain
 	Domain string `form:"domain" json:"domain" yaml:"domain" xml:"domain"`
+	// Whether the owner has proven control over the app domain
+	DomainVerified *bool `form:"domainVerified,omitempty" json:"domainVerified,omitempty" yaml:"domainVerified,omitempty" xml:"domainVerified,omitempty"`
 	// Unique app ID
 	ID string `form:"id" json:"id" yaml:"id" xml:"id"`
+	// Labels of the app, used to select apps, like env: prod
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" yaml:"labels,omitempty" xml:"labels,omitempty"`
+	// Link to the normalized thumbnail of the logo
+	LogoThumbnailURL *string `form:"logoThumbnailUrl,omitempty" json:"logoThumbnailUrl,omitempty" yaml:"logoThumbnailUrl,omitempty" xml:"logoThumbnailUrl,omitempty"`
+	// Link to the logo of the app
+	LogoURL *string `form:"logoUrl,omitempty" json:"logoUrl,omitempty" yaml:"logoUrl,omitempty" xml:"logoUrl,omitempty"`
 	// Name of the app
 	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// Allowed origins of the app, like https://app.example.com or https://*.example.com
+	Origins []string `form:"origins,omitempty" json:"origins,omitempty" yaml:"origins,omitempty" xml:"origins,omitempty"`
 	// User ID
 	Owner string `form:"owner" json:"owner" yaml:"owner" xml:"owner"`
+	// Link to the privacy policy of the app, shown on the consent screen
+	PrivacyPolicyURL *string `form:"privacyPolicyUrl,omitempty" json:"privacyPolicyUrl,omitempty" yaml:"privacyPolicyUrl,omitempty" xml:"privacyPolicyUrl,omitempty"`
+	// Rate limit and quota policy for the app
+	RateLimit *RateLimit `form:"rateLimit,omitempty" json:"rateLimit,omitempty" yaml:"rateLimit,omitempty" xml:"rateLimit,omitempty"`
+	// Whether the app is exempt from the reaping job
+	ReapingExempt *bool `form:"reapingExempt,omitempty" json:"reapingExempt,omitempty" yaml:"reapingExempt,omitempty" xml:"reapingExempt,omitempty"`
 	// Time when app is registered
 	RegisteredAt int `form:"registeredAt" json:"registeredAt" yaml:"registeredAt" xml:"registeredAt"`
+	// Lifecycle status of the app
+	Status *string `form:"status,omitempty" json:"status,omitempty" yaml:"status,omitempty" xml:"status,omitempty"`
+	// Email address for support requests, shown on the consent screen
+	SupportEmail *string `form:"supportEmail,omitempty" json:"supportEmail,omitempty" yaml:"supportEmail,omitempty" xml:"supportEmail,omitempty"`
+	// Link to the terms of service of the app, shown on the consent screen
+	TermsOfServiceURL *string `form:"termsOfServiceUrl,omitempty" json:"termsOfServiceUrl,omitempty" yaml:"termsOfServiceUrl,omitempty" xml:"termsOfServiceUrl,omitempty"`
+	// Time of the last successful domain verification
+	VerifiedAt *int `form:"verifiedAt,omitempty" json:"verifiedAt,omitempty" yaml:"verifiedAt,omitempty" xml:"verifiedAt,omitempty"`
 }
 
 // Validate validates the Apps media type instance.
@@ -51,6 +163,472 @@ func (mt *Apps) Validate() (err error) {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "owner"))
 	}
 
+	if len(mt.Contacts) > 10 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.contacts`, mt.Contacts, len(mt.Contacts), 10, false))
+	}
+	if utf8.RuneCountInString(mt.Description) > 300 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.description`, mt.Description, utf8.RuneCountInString(mt.Description), 300, false))
+	}
+	if utf8.RuneCountInString(mt.Name) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.name`, mt.Name, utf8.RuneCountInString(mt.Name), 50, false))
+	}
+	if len(mt.Origins) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.origins`, mt.Origins, len(mt.Origins), 20, false))
+	}
+	if mt.RateLimit != nil {
+		if err2 := mt.RateLimit.Validate(); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if mt.Status != nil {
+		if !(*mt.Status == "active" || *mt.Status == "flagged" || *mt.Status == "suspended" || *mt.Status == "deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.status`, *mt.Status, []interface{}{"active", "flagged", "suspended", "deleted"}))
+		}
+	}
+	return
+}
+
+// apps media type (admin view)
+//
+// Identifier: application/vnd.goa.apps+json; view=admin
+type AppsAdmin struct {
+	// Annotations of the app, free-form metadata that is not used to select apps
+	Annotations map[string]string `form:"annotations,omitempty" json:"annotations,omitempty" yaml:"annotations,omitempty" xml:"annotations,omitempty"`
+	// Email addresses of the people responsible for the app
+	Contacts []string `form:"contacts,omitempty" json:"contacts,omitempty" yaml:"contacts,omitempty" xml:"contacts,omitempty"`
+	// Description of the app
+	Description string `form:"description" json:"description" yaml:"description" xml:"description"`
+	// App domain
+	Domain string `form:"domain" json:"domain" yaml:"domain" xml:"domain"`
+	// Whether the owner has proven control over the app domain
+	DomainVerified *bool `form:"domainVerified,omitempty" json:"domainVerified,omitempty" yaml:"domainVerified,omitempty" xml:"domainVerified,omitempty"`
+	// Unique app ID
+	ID string `form:"id" json:"id" yaml:"id" xml:"id"`
+	// Labels of the app, used to select apps, like env: prod
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" yaml:"labels,omitempty" xml:"labels,omitempty"`
+	// Time of the last use of the app
+	LastUsedAt *int `form:"lastUsedAt,omitempty" json:"lastUsedAt,omitempty" yaml:"lastUsedAt,omitempty" xml:"lastUsedAt,omitempty"`
+	// IP address of the last use of the app
+	LastUsedIP *string `form:"lastUsedIp,omitempty" json:"lastUsedIp,omitempty" yaml:"lastUsedIp,omitempty" xml:"lastUsedIp,omitempty"`
+	// Link to the normalized thumbnail of the logo
+	LogoThumbnailURL *string `form:"logoThumbnailUrl,omitempty" json:"logoThumbnailUrl,omitempty" yaml:"logoThumbnailUrl,omitempty" xml:"logoThumbnailUrl,omitempty"`
+	// Link to the logo of the app
+	LogoURL *string `form:"logoUrl,omitempty" json:"logoUrl,omitempty" yaml:"logoUrl,omitempty" xml:"logoUrl,omitempty"`
+	// Name of the app
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// Allowed origins of the app, like https://app.example.com or https://*.example.com
+	Origins []string `form:"origins,omitempty" json:"origins,omitempty" yaml:"origins,omitempty" xml:"origins,omitempty"`
+	// User ID
+	Owner string `form:"owner" json:"owner" yaml:"owner" xml:"owner"`
+	// Link to the privacy policy of the app, shown on the consent screen
+	PrivacyPolicyURL *string `form:"privacyPolicyUrl,omitempty" json:"privacyPolicyUrl,omitempty" yaml:"privacyPolicyUrl,omitempty" xml:"privacyPolicyUrl,omitempty"`
+	// Rate limit and quota policy for the app
+	RateLimit *RateLimit `form:"rateLimit,omitempty" json:"rateLimit,omitempty" yaml:"rateLimit,omitempty" xml:"rateLimit,omitempty"`
+	// Whether the app is exempt from the reaping job
+	ReapingExempt *bool `form:"reapingExempt,omitempty" json:"reapingExempt,omitempty" yaml:"reapingExempt,omitempty" xml:"reapingExempt,omitempty"`
+	// Time when app is registered
+	RegisteredAt int `form:"registeredAt" json:"registeredAt" yaml:"registeredAt" xml:"registeredAt"`
+	// Lifecycle status of the app
+	Status *string `form:"status,omitempty" json:"status,omitempty" yaml:"status,omitempty" xml:"status,omitempty"`
+	// Time of the last change of the lifecycle status
+	StatusChangedAt *int `form:"statusChangedAt,omitempty" json:"statusChangedAt,omitempty" yaml:"statusChangedAt,omitempty" xml:"statusChangedAt,omitempty"`
+	// Email address for support requests, shown on the consent screen
+	SupportEmail *string `form:"supportEmail,omitempty" json:"supportEmail,omitempty" yaml:"supportEmail,omitempty" xml:"supportEmail,omitempty"`
+	// Link to the terms of service of the app, shown on the consent screen
+	TermsOfServiceURL *string `form:"termsOfServiceUrl,omitempty" json:"termsOfServiceUrl,omitempty" yaml:"termsOfServiceUrl,omitempty" xml:"termsOfServiceUrl,omitempty"`
+	// Time of the last successful domain verification
+	VerifiedAt *int `form:"verifiedAt,omitempty" json:"verifiedAt,omitempty" yaml:"verifiedAt,omitempty" xml:"verifiedAt,omitempty"`
+}
+
+// Validate validates the AppsAdmin media type instance.
+func (mt *AppsAdmin) Validate() (err error) {
+	if mt.ID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "id"))
+	}
+	if mt.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "name"))
+	}
+	if mt.Description == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "description"))
+	}
+	if mt.Domain == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "domain"))
+	}
+	if mt.Owner == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "owner"))
+	}
+
+	if len(mt.Contacts) > 10 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.contacts`, mt.Contacts, len(mt.Contacts), 10, false))
+	}
+	if utf8.RuneCountInString(mt.Description) > 300 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.description`, mt.Description, utf8.RuneCountInString(mt.Description), 300, false))
+	}
+	if utf8.RuneCountInString(mt.Name) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.name`, mt.Name, utf8.RuneCountInString(mt.Name), 50, false))
+	}
+	if len(mt.Origins) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.origins`, mt.Origins, len(mt.Origins), 20, false))
+	}
+	if mt.RateLimit != nil {
+		if err2 := mt.RateLimit.Validate(); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if mt.Status != nil {
+		if !(*mt.Status == "active" || *mt.Status == "flagged" || *mt.Status == "suspended" || *mt.Status == "deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.status`, *mt.Status, []interface{}{"active", "flagged", "suspended", "deleted"}))
+		}
+	}
+	return
+}
+
+// apps media type (withSecret view)
+//
+// Identifier: application/vnd.goa.apps+json; view=withSecret
+type AppsWithSecret struct {
+	// Annotations of the app, free-form metadata that is not used to select apps
+	Annotations map[string]string `form:"annotations,omitempty" json:"annotations,omitempty" yaml:"annotations,omitempty" xml:"annotations,omitempty"`
+	// Email addresses of the people responsible for the app
+	Contacts []string `form:"contacts,omitempty" json:"contacts,omitempty" yaml:"contacts,omitempty" xml:"contacts,omitempty"`
+	// Description of the app
+	Description string `form:"description" json:"description" yaml:"description" xml:"description"`
+	// App domain
+	Domain string `form:"domain" json:"domain" yaml:"domain" xml:"domain"`
+	// Whether the owner has proven control over the app domain
+	DomainVerified *bool `form:"domainVerified,omitempty" json:"domainVerified,omitempty" yaml:"domainVerified,omitempty" xml:"domainVerified,omitempty"`
+	// Unique app ID
+	ID string `form:"id" json:"id" yaml:"id" xml:"id"`
+	// Labels of the app, used to select apps, like env: prod
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" yaml:"labels,omitempty" xml:"labels,omitempty"`
+	// Link to the normalized thumbnail of the logo
+	LogoThumbnailURL *string `form:"logoThumbnailUrl,omitempty" json:"logoThumbnailUrl,omitempty" yaml:"logoThumbnailUrl,omitempty" xml:"logoThumbnailUrl,omitempty"`
+	// Link to the logo of the app
+	LogoURL *string `form:"logoUrl,omitempty" json:"logoUrl,omitempty" yaml:"logoUrl,omitempty" xml:"logoUrl,omitempty"`
+	// Name of the app
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// Allowed origins of the app, like https://app.example.com or https://*.example.com
+	Origins []string `form:"origins,omitempty" json:"origins,omitempty" yaml:"origins,omitempty" xml:"origins,omitempty"`
+	// User ID
+	Owner string `form:"owner" json:"owner" yaml:"owner" xml:"owner"`
+	// Link to the privacy policy of the app, shown on the consent screen
+	PrivacyPolicyURL *string `form:"privacyPolicyUrl,omitempty" json:"privacyPolicyUrl,omitempty" yaml:"privacyPolicyUrl,omitempty" xml:"privacyPolicyUrl,omitempty"`
+	// Rate limit and quota policy for the app
+	RateLimit *RateLimit `form:"rateLimit,omitempty" json:"rateLimit,omitempty" yaml:"rateLimit,omitempty" xml:"rateLimit,omitempty"`
+	// Whether the app is exempt from the reaping job
+	ReapingExempt *bool `form:"reapingExempt,omitempty" json:"reapingExempt,omitempty" yaml:"reapingExempt,omitempty" xml:"reapingExempt,omitempty"`
+	// Time when app is registered
+	RegisteredAt int `form:"registeredAt" json:"registeredAt" yaml:"registeredAt" xml:"registeredAt"`
+	// Client secret
+	Secret *string `form:"secret,omitempty" json:"secret,omitempty" yaml:"secret,omitempty" xml:"secret,omitempty"`
+	// Lifecycle status of the app
+	Status *string `form:"status,omitempty" json:"status,omitempty" yaml:"status,omitempty" xml:"status,omitempty"`
+	// Email address for support requests, shown on the consent screen
+	SupportEmail *string `form:"supportEmail,omitempty" json:"supportEmail,omitempty" yaml:"supportEmail,omitempty" xml:"supportEmail,omitempty"`
+	// Link to the terms of service of the app, shown on the consent screen
+	TermsOfServiceURL *string `form:"termsOfServiceUrl,omitempty" json:"termsOfServiceUrl,omitempty" yaml:"termsOfServiceUrl,omitempty" xml:"termsOfServiceUrl,omitempty"`
+	// Time of the last successful domain verification
+	VerifiedAt *int `form:"verifiedAt,omitempty" json:"verifiedAt,omitempty" yaml:"verifiedAt,omitempty" xml:"verifiedAt,omitempty"`
+}
+
+// Validate validates the AppsWithSecret media type instance.
+func (mt *AppsWithSecret) Validate() (err error) {
+	if mt.ID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "id"))
+	}
+	if mt.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "name"))
+	}
+	if mt.Description == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "description"))
+	}
+	if mt.Domain == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "domain"))
+	}
+	if mt.Owner == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "owner"))
+	}
+
+	if len(mt.Contacts) > 10 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.contacts`, mt.Contacts, len(mt.Contacts), 10, false))
+	}
+	if utf8.RuneCountInString(mt.Description) > 300 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.description`, mt.Description, utf8.RuneCountInString(mt.Description), 300, false))
+	}
+	if utf8.RuneCountInString(mt.Name) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.name`, mt.Name, utf8.RuneCountInString(mt.Name), 50, false))
+	}
+	if len(mt.Origins) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.origins`, mt.Origins, len(mt.Origins), 20, false))
+	}
+	if mt.RateLimit != nil {
+		if err2 := mt.RateLimit.Validate(); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if mt.Status != nil {
+		if !(*mt.Status == "active" || *mt.Status == "flagged" || *mt.Status == "suspended" || *mt.Status == "deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.status`, *mt.Status, []interface{}{"active", "flagged", "suspended", "deleted"}))
+		}
+	}
+	return
+}
+
+// AppsCollection is the media type for an array of Apps (default view)
+//
+// Identifier: application/vnd.goa.apps+json; type=collection; view=default
+type AppsCollection []*Apps
+
+// Validate validates the AppsCollection media type instance.
+func (mt AppsCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// AppsAdminCollection is the media type for an array of Apps (admin view)
+//
+// Identifier: application/vnd.goa.apps+json; type=collection; view=admin
+type AppsAdminCollection []*AppsAdmin
+
+// Validate validates the AppsAdminCollection media type instance.
+func (mt AppsAdminCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// AppsWithSecretCollection is the media type for an array of Apps (withSecret view)
+//
+// Identifier: application/vnd.goa.apps+json; type=collection; view=withSecret
+type AppsWithSecretCollection []*AppsWithSecret
+
+// Validate validates the AppsWithSecretCollection media type instance.
+func (mt AppsWithSecretCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// bulk-item media type (default view)
+//
+// Identifier: application/vnd.goa.bulk.item+json; view=default
+type BulkItem struct {
+	// Why the operation failed for the app
+	Error *string `form:"error,omitempty" json:"error,omitempty" yaml:"error,omitempty" xml:"error,omitempty"`
+	// App ID
+	ID string `form:"id" json:"id" yaml:"id" xml:"id"`
+	// Outcome of the operation for the app
+	Result string `form:"result" json:"result" yaml:"result" xml:"result"`
+	// Lifecycle status of the app after the operation
+	Status *string `form:"status,omitempty" json:"status,omitempty" yaml:"status,omitempty" xml:"status,omitempty"`
+}
+
+// Validate validates the BulkItem media type instance.
+func (mt *BulkItem) Validate() (err error) {
+	if mt.ID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "id"))
+	}
+	if mt.Result == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "result"))
+	}
+	if !(mt.Result == "applied" || mt.Result == "planned" || mt.Result == "unchanged" || mt.Result == "failed") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.result`, mt.Result, []interface{}{"applied", "planned", "unchanged", "failed"}))
+	}
+	return
+}
+
+// bulk-result media type (default view)
+//
+// Identifier: application/vnd.goa.bulk.result+json; view=default
+type BulkResult struct {
+	// Whether the operation was only planned, without changing the apps
+	DryRun bool `form:"dryRun" json:"dryRun" yaml:"dryRun" xml:"dryRun"`
+	// Number of apps for which the operation failed
+	Failed int `form:"failed" json:"failed" yaml:"failed" xml:"failed"`
+	// Results for the selected apps
+	Items []*BulkItem `form:"items" json:"items" yaml:"items" xml:"items"`
+	// Operation applied to the apps
+	Operation string `form:"operation" json:"operation" yaml:"operation" xml:"operation"`
+	// Number of apps that were changed, or would be changed in a dry run
+	Succeeded int `form:"succeeded" json:"succeeded" yaml:"succeeded" xml:"succeeded"`
+	// Number of selected apps
+	Total int `form:"total" json:"total" yaml:"total" xml:"total"`
+}
+
+// Validate validates the BulkResult media type instance.
+func (mt *BulkResult) Validate() (err error) {
+	if mt.Operation == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "operation"))
+	}
+	if mt.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "items"))
+	}
+	for _, e := range mt.Items {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// daily-usage media type (default view)
+//
+// Identifier: application/vnd.goa.daily.usage+json; view=default
+type DailyUsage struct {
+	// The day (YYYY-MM-DD)
+	Day string `form:"day" json:"day" yaml:"day" xml:"day"`
+	// Number of failed verifications
+	Failure int `form:"failure" json:"failure" yaml:"failure" xml:"failure"`
+	// Number of successful verifications
+	Success int `form:"success" json:"success" yaml:"success" xml:"success"`
+}
+
+// Validate validates the DailyUsage media type instance.
+func (mt *DailyUsage) Validate() (err error) {
+	if mt.Day == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "day"))
+	}
+	return
+}
+
+// domain-verification media type (default view)
+//
+// Identifier: application/vnd.goa.domain.verification+json; view=default
+type DomainVerification struct {
+	// App ID
+	AppID string `form:"appId" json:"appId" yaml:"appId" xml:"appId"`
+	// Time of the last check
+	CheckedAt *int `form:"checkedAt,omitempty" json:"checkedAt,omitempty" yaml:"checkedAt,omitempty" xml:"checkedAt,omitempty"`
+	// Name of the DNS TXT record for the dns method
+	DNSRecordName string `form:"dnsRecordName" json:"dnsRecordName" yaml:"dnsRecordName" xml:"dnsRecordName"`
+	// Value of the DNS TXT record for the dns method
+	DNSRecordValue string `form:"dnsRecordValue" json:"dnsRecordValue" yaml:"dnsRecordValue" xml:"dnsRecordValue"`
+	// App domain
+	Domain string `form:"domain" json:"domain" yaml:"domain" xml:"domain"`
+	// Reason why the last check failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" yaml:"error,omitempty" xml:"error,omitempty"`
+	// URL of the file with the token for the http method
+	HTTPURL string `form:"httpUrl" json:"httpUrl" yaml:"httpUrl" xml:"httpUrl"`
+	// Method of the last successful verification (dns or http)
+	Method *string `form:"method,omitempty" json:"method,omitempty" yaml:"method,omitempty" xml:"method,omitempty"`
+	// Verification token
+	Token string `form:"token" json:"token" yaml:"token" xml:"token"`
+	// Whether the domain is verified
+	Verified bool `form:"verified" json:"verified" yaml:"verified" xml:"verified"`
+	// Time of the last successful verification
+	VerifiedAt *int `form:"verifiedAt,omitempty" json:"verifiedAt,omitempty" yaml:"verifiedAt,omitempty" xml:"verifiedAt,omitempty"`
+}
+
+// Validate validates the DomainVerification media type instance.
+func (mt *DomainVerification) Validate() (err error) {
+	if mt.AppID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "appId"))
+	}
+	if mt.Domain == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "domain"))
+	}
+	if mt.Token == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "token"))
+	}
+	if mt.DNSRecordName == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "dnsRecordName"))
+	}
+	if mt.DNSRecordValue == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "dnsRecordValue"))
+	}
+	if mt.HTTPURL == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "httpUrl"))
+	}
+	return
+}
+
+// origin-check media type (default view)
+//
+// Identifier: application/vnd.goa.origin.check+json; view=default
+type OriginCheck struct {
+	// Whether the origin is allowed for the app
+	Allowed bool `form:"allowed" json:"allowed" yaml:"allowed" xml:"allowed"`
+	// App ID
+	AppID string `form:"appId" json:"appId" yaml:"appId" xml:"appId"`
+	// The checked origin
+	Origin string `form:"origin" json:"origin" yaml:"origin" xml:"origin"`
+}
+
+// Validate validates the OriginCheck media type instance.
+func (mt *OriginCheck) Validate() (err error) {
+	if mt.AppID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "appId"))
+	}
+	if mt.Origin == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "origin"))
+	}
+	return
+}
+
+// public-app media type (default view)
+//
+// Identifier: application/vnd.goa.public.app+json; view=default
+type PublicApp struct {
+	// Email addresses of the people responsible for the app
+	Contacts []string `form:"contacts,omitempty" json:"contacts,omitempty" yaml:"contacts,omitempty" xml:"contacts,omitempty"`
+	// Description of the app
+	Description string `form:"description" json:"description" yaml:"description" xml:"description"`
+	// App domain
+	Domain string `form:"domain" json:"domain" yaml:"domain" xml:"domain"`
+	// Whether the owner has proven control over the app domain
+	DomainVerified *bool `form:"domainVerified,omitempty" json:"domainVerified,omitempty" yaml:"domainVerified,omitempty" xml:"domainVerified,omitempty"`
+	// Unique app ID
+	ID string `form:"id" json:"id" yaml:"id" xml:"id"`
+	// Link to the normalized thumbnail of the logo
+	LogoThumbnailURL *string `form:"logoThumbnailUrl,omitempty" json:"logoThumbnailUrl,omitempty" yaml:"logoThumbnailUrl,omitempty" xml:"logoThumbnailUrl,omitempty"`
+	// Link to the logo of the app
+	LogoURL *string `form:"logoUrl,omitempty" json:"logoUrl,omitempty" yaml:"logoUrl,omitempty" xml:"logoUrl,omitempty"`
+	// Name of the app
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// Link to the privacy policy of the app, shown on the consent screen
+	PrivacyPolicyURL *string `form:"privacyPolicyUrl,omitempty" json:"privacyPolicyUrl,omitempty" yaml:"privacyPolicyUrl,omitempty" xml:"privacyPolicyUrl,omitempty"`
+	// Email address for support requests, shown on the consent screen
+	SupportEmail *string `form:"supportEmail,omitempty" json:"supportEmail,omitempty" yaml:"supportEmail,omitempty" xml:"supportEmail,omitempty"`
+	// Link to the terms of service of the app, shown on the consent screen
+	TermsOfServiceURL *string `form:"termsOfServiceUrl,omitempty" json:"termsOfServiceUrl,omitempty" yaml:"termsOfServiceUrl,omitempty" xml:"termsOfServiceUrl,omitempty"`
+}
+
+// Validate validates the PublicApp media type instance.
+func (mt *PublicApp) Validate() (err error) {
+	if mt.ID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "id"))
+	}
+	if mt.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "name"))
+	}
+	if mt.Description == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "description"))
+	}
+	if mt.Domain == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "domain"))
+	}
+
+	if len(mt.Contacts) > 10 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.contacts`, mt.Contacts, len(mt.Contacts), 10, false))
+	}
 	if utf8.RuneCountInString(mt.Description) > 300 {
 		err = goa.MergeErrors(err, goa.InvalidLengthError(`response.description`, mt.Description, utf8.RuneCountInString(mt.Description), 300, false))
 	}
@@ -60,6 +638,112 @@ func (mt *Apps) Validate() (err error) {
 	return
 }
 
+// rate-limit media type (default view)
+//
+// Identifier: application/vnd.goa.rate.limit+json; view=default
+type RateLimit struct {
+	// Maximal number of requests allowed in a single burst
+	Burst int `form:"burst" json:"burst" yaml:"burst" xml:"burst"`
+	// Maximal number of requests per calendar month
+	MonthlyQuota int `form:"monthlyQuota" json:"monthlyQuota" yaml:"monthlyQuota" xml:"monthlyQuota"`
+	// Maximal number of requests per day
+	RequestsPerDay int `form:"requestsPerDay" json:"requestsPerDay" yaml:"requestsPerDay" xml:"requestsPerDay"`
+	// Maximal number of requests per minute
+	RequestsPerMinute int `form:"requestsPerMinute" json:"requestsPerMinute" yaml:"requestsPerMinute" xml:"requestsPerMinute"`
+	// Maximal number of requests per second
+	RequestsPerSecond int `form:"requestsPerSecond" json:"requestsPerSecond" yaml:"requestsPerSecond" xml:"requestsPerSecond"`
+}
+
+// Validate validates the RateLimit media type instance.
+func (mt *RateLimit) Validate() (err error) {
+	if mt.Burst < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError(`response.burst`, mt.Burst, 0, true))
+	}
+	if mt.MonthlyQuota < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError(`response.monthlyQuota`, mt.MonthlyQuota, 0, true))
+	}
+	if mt.RequestsPerDay < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError(`response.requestsPerDay`, mt.RequestsPerDay, 0, true))
+	}
+	if mt.RequestsPerMinute < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError(`response.requestsPerMinute`, mt.RequestsPerMinute, 0, true))
+	}
+	if mt.RequestsPerSecond < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError(`response.requestsPerSecond`, mt.RequestsPerSecond, 0, true))
+	}
+	return
+}
+
+// reaping-candidate media type (default view)
+//
+// Identifier: application/vnd.goa.reaping.candidate+json; view=default
+type ReapingCandidate struct {
+	// Action of the next run of the reaping job
+	Action string `form:"action" json:"action" yaml:"action" xml:"action"`
+	// App ID
+	AppID string `form:"appId" json:"appId" yaml:"appId" xml:"appId"`
+	// Number of days since the last activity
+	IdleDays int `form:"idleDays" json:"idleDays" yaml:"idleDays" xml:"idleDays"`
+	// Time of the last use, registration or restore of the app
+	LastActivityAt int `form:"lastActivityAt" json:"lastActivityAt" yaml:"lastActivityAt" xml:"lastActivityAt"`
+	// Name of the app
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// User ID of the owner
+	Owner string `form:"owner" json:"owner" yaml:"owner" xml:"owner"`
+	// Current lifecycle status of the app
+	Status string `form:"status" json:"status" yaml:"status" xml:"status"`
+}
+
+// Validate validates the ReapingCandidate media type instance.
+func (mt *ReapingCandidate) Validate() (err error) {
+	if mt.AppID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "appId"))
+	}
+	if mt.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "name"))
+	}
+	if mt.Owner == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "owner"))
+	}
+	if mt.Status == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "status"))
+	}
+	if mt.Action == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "action"))
+	}
+	if !(mt.Action == "none" || mt.Action == "flag" || mt.Action == "suspend" || mt.Action == "delete") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`response.action`, mt.Action, []interface{}{"none", "flag", "suspend", "delete"}))
+	}
+	return
+}
+
+// reaping-report media type (default view)
+//
+// Identifier: application/vnd.goa.reaping.report+json; view=default
+type ReapingReport struct {
+	// Unused apps that are not exempt from reaping
+	Candidates []*ReapingCandidate `form:"candidates" json:"candidates" yaml:"candidates" xml:"candidates"`
+	// Whether the reaping job only reports and does not change the apps
+	DryRun bool `form:"dryRun" json:"dryRun" yaml:"dryRun" xml:"dryRun"`
+	// Time when the report was generated
+	GeneratedAt int `form:"generatedAt" json:"generatedAt" yaml:"generatedAt" xml:"generatedAt"`
+}
+
+// Validate validates the ReapingReport media type instance.
+func (mt *ReapingReport) Validate() (err error) {
+	if mt.Candidates == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "candidates"))
+	}
+	for _, e := range mt.Candidates {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
 // reg-apps media type (default view)
 //
 // Identifier: application/vnd.goa.reg.apps+json; view=default
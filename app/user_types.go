@@ -65,14 +65,117 @@ func (ut *AppCredentialsPayload) Validate() (err error) {
 	return
 }
 
+// Filter that selects apps
+type appFilterPayload struct {
+	// Only the apps with the domain
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty" yaml:"domain,omitempty" xml:"domain,omitempty"`
+	// Only the apps of the user
+	Owner *string `form:"owner,omitempty" json:"owner,omitempty" yaml:"owner,omitempty" xml:"owner,omitempty"`
+	// Only the apps registered on or after the day (YYYY-MM-DD)
+	RegisteredFrom *string `form:"registeredFrom,omitempty" json:"registeredFrom,omitempty" yaml:"registeredFrom,omitempty" xml:"registeredFrom,omitempty"`
+	// Only the apps registered on or before the day (YYYY-MM-DD)
+	RegisteredTo *string `form:"registeredTo,omitempty" json:"registeredTo,omitempty" yaml:"registeredTo,omitempty" xml:"registeredTo,omitempty"`
+	// Only the apps with the lifecycle status
+	Status *string `form:"status,omitempty" json:"status,omitempty" yaml:"status,omitempty" xml:"status,omitempty"`
+}
+
+// Validate validates the appFilterPayload type instance.
+func (ut *appFilterPayload) Validate() (err error) {
+	if ut.RegisteredFrom != nil {
+		if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *ut.RegisteredFrom); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.registeredFrom`, *ut.RegisteredFrom, `^\d{4}-\d{2}-\d{2}$`))
+		}
+	}
+	if ut.RegisteredTo != nil {
+		if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *ut.RegisteredTo); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.registeredTo`, *ut.RegisteredTo, `^\d{4}-\d{2}-\d{2}$`))
+		}
+	}
+	if ut.Status != nil {
+		if !(*ut.Status == "active" || *ut.Status == "flagged" || *ut.Status == "suspended" || *ut.Status == "deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`request.status`, *ut.Status, []interface{}{"active", "flagged", "suspended", "deleted"}))
+		}
+	}
+	return
+}
+
+// Publicize creates AppFilterPayload from appFilterPayload
+func (ut *appFilterPayload) Publicize() *AppFilterPayload {
+	var pub AppFilterPayload
+	if ut.Domain != nil {
+		pub.Domain = ut.Domain
+	}
+	if ut.Owner != nil {
+		pub.Owner = ut.Owner
+	}
+	if ut.RegisteredFrom != nil {
+		pub.RegisteredFrom = ut.RegisteredFrom
+	}
+	if ut.RegisteredTo != nil {
+		pub.RegisteredTo = ut.RegisteredTo
+	}
+	if ut.Status != nil {
+		pub.Status = ut.Status
+	}
+	return &pub
+}
+
+// Filter that selects apps
+type AppFilterPayload struct {
+	// Only the apps with the domain
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty" yaml:"domain,omitempty" xml:"domain,omitempty"`
+	// Only the apps of the user
+	Owner *string `form:"owner,omitempty" json:"owner,omitempty" yaml:"owner,omitempty" xml:"owner,omitempty"`
+	// Only the apps registered on or after the day (YYYY-MM-DD)
+	RegisteredFrom *string `form:"registeredFrom,omitempty" json:"registeredFrom,omitempty" yaml:"registeredFrom,omitempty" xml:"registeredFrom,omitempty"`
+	// Only the apps registered on or before the day (YYYY-MM-DD)
+	RegisteredTo *string `form:"registeredTo,omitempty" json:"registeredTo,omitempty" yaml:"registeredTo,omitempty" xml:"registeredTo,omitempty"`
+	// Only the apps with the lifecycle status
+	Status *string `form:"status,omitempty" json:"status,omitempty" yaml:"status,omitempty" xml:"status,omitempty"`
+}
+
+// Validate validates the AppFilterPayload type instance.
+func (ut *AppFilterPayload) Validate() (err error) {
+	if ut.RegisteredFrom != nil {
+		if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *ut.RegisteredFrom); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`type.registeredFrom`, *ut.RegisteredFrom, `^\d{4}-\d{2}-\d{2}$`))
+		}
+	}
+	if ut.RegisteredTo != nil {
+		if ok := goa.ValidatePattern(`^\d{4}-\d{2}-\d{2}$`, *ut.RegisteredTo); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`type.registeredTo`, *ut.RegisteredTo, `^\d{4}-\d{2}-\d{2}$`))
+		}
+	}
+	if ut.Status != nil {
+		if !(*ut.Status == "active" || *ut.Status == "flagged" || *ut.Status == "suspended" || *ut.Status == "deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`type.status`, *ut.Status, []interface{}{"active", "flagged", "suspended", "deleted"}))
+		}
+	}
+	return
+}
+
 // Payload for the client apps
 type appPayload struct {
+	// Annotations of the app, free-form metadata that is not used to select apps
+	Annotations map[string]string `form:"annotations,omitempty" json:"annotations,omitempty" yaml:"annotations,omitempty" xml:"annotations,omitempty"`
+	// Email addresses of the people responsible for the app
+	Contacts []string `form:"contacts,omitempty" json:"contacts,omitempty" yaml:"contacts,omitempty" xml:"contacts,omitempty"`
 	// Description of the app
 	Description *string `form:"description,omitempty" json:"description,omitempty" yaml:"description,omitempty" xml:"description,omitempty"`
 	// App domain
 	Domain *string `form:"domain,omitempty" json:"domain,omitempty" yaml:"domain,omitempty" xml:"domain,omitempty"`
+	// Labels of the app, used to select apps, like env: prod
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" yaml:"labels,omitempty" xml:"labels,omitempty"`
 	// Name of the app
 	Name *string `form:"name,omitempty" json:"name,omitempty" yaml:"name,omitempty" xml:"name,omitempty"`
+	// Allowed origins of the app, like https://app.example.com or https://*.example.com
+	Origins []string `form:"origins,omitempty" json:"origins,omitempty" yaml:"origins,omitempty" xml:"origins,omitempty"`
+	// Link to the privacy policy of the app, shown on the consent screen
+	PrivacyPolicyURL *string `form:"privacyPolicyUrl,omitempty" json:"privacyPolicyUrl,omitempty" yaml:"privacyPolicyUrl,omitempty" xml:"privacyPolicyUrl,omitempty"`
+	// Email address for support requests, shown on the consent screen
+	SupportEmail *string `form:"supportEmail,omitempty" json:"supportEmail,omitempty" yaml:"supportEmail,omitempty" xml:"supportEmail,omitempty"`
+	// Link to the terms of service of the app, shown on the consent screen
+	TermsOfServiceURL *string `form:"termsOfServiceUrl,omitempty" json:"termsOfServiceUrl,omitempty" yaml:"termsOfServiceUrl,omitempty" xml:"termsOfServiceUrl,omitempty"`
 }
 
 // Validate validates the appPayload type instance.
@@ -80,6 +183,9 @@ func (ut *appPayload) Validate() (err error) {
 	if ut.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "name"))
 	}
+	if len(ut.Contacts) > 10 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`request.contacts`, ut.Contacts, len(ut.Contacts), 10, false))
+	}
 	if ut.Description != nil {
 		if utf8.RuneCountInString(*ut.Description) > 300 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.description`, *ut.Description, utf8.RuneCountInString(*ut.Description), 300, false))
@@ -90,32 +196,76 @@ func (ut *appPayload) Validate() (err error) {
 			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.name`, *ut.Name, utf8.RuneCountInString(*ut.Name), 50, false))
 		}
 	}
+	if len(ut.Origins) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`request.origins`, ut.Origins, len(ut.Origins), 20, false))
+	}
 	return
 }
 
 // Publicize creates AppPayload from appPayload
 func (ut *appPayload) Publicize() *AppPayload {
 	var pub AppPayload
+	if ut.Annotations != nil {
+		pub.Annotations = make(map[string]string, len(ut.Annotations))
+		for k2, v2 := range ut.Annotations {
+			pub.Annotations[k2] = v2
+		}
+	}
+	if ut.Contacts != nil {
+		pub.Contacts = ut.Contacts
+	}
 	if ut.Description != nil {
 		pub.Description = ut.Description
 	}
 	if ut.Domain != nil {
 		pub.Domain = ut.Domain
 	}
+	if ut.Labels != nil {
+		pub.Labels = make(map[string]string, len(ut.Labels))
+		for k2, v2 := range ut.Labels {
+			pub.Labels[k2] = v2
+		}
+	}
 	if ut.Name != nil {
 		pub.Name = *ut.Name
 	}
+	if ut.Origins != nil {
+		pub.Origins = ut.Origins
+	}
+	if ut.PrivacyPolicyURL != nil {
+		pub.PrivacyPolicyURL = ut.PrivacyPolicyURL
+	}
+	if ut.SupportEmail != nil {
+		pub.SupportEmail = ut.SupportEmail
+	}
+	if ut.TermsOfServiceURL != nil {
+		pub.TermsOfServiceURL = ut.TermsOfServiceURL
+	}
 	return &pub
 }
 
 // Payload for the client apps
 type AppPayload struct {
+	// Annotations of the app, free-form metadata that is not used to select apps
+	Annotations map[string]string `form:"annotations,omitempty" json:"annotations,omitempty" yaml:"annotations,omitempty" xml:"annotations,omitempty"`
+	// Email addresses of the people responsible for the app
+	Contacts []string `form:"contacts,omitempty" json:"contacts,omitempty" yaml:"contacts,omitempty" xml:"contacts,omitempty"`
 	// Description of the app
 	Description *string `form:"description,omitempty" json:"description,omitempty" yaml:"description,omitempty" xml:"description,omitempty"`
 	// App domain
 	Domain *string `form:"domain,omitempty" json:"domain,omitempty" yaml:"domain,omitempty" xml:"domain,omitempty"`
+	// Labels of the app, used to select apps, like env: prod
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" yaml:"labels,omitempty" xml:"labels,omitempty"`
 	// Name of the app
 	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// Allowed origins of the app, like https://app.example.com or https://*.example.com
+	Origins []string `form:"origins,omitempty" json:"origins,omitempty" yaml:"origins,omitempty" xml:"origins,omitempty"`
+	// Link to the privacy policy of the app, shown on the consent screen
+	PrivacyPolicyURL *string `form:"privacyPolicyUrl,omitempty" json:"privacyPolicyUrl,omitempty" yaml:"privacyPolicyUrl,omitempty" xml:"privacyPolicyUrl,omitempty"`
+	// Email address for support requests, shown on the consent screen
+	SupportEmail *string `form:"supportEmail,omitempty" json:"supportEmail,omitempty" yaml:"supportEmail,omitempty" xml:"supportEmail,omitempty"`
+	// Link to the terms of service of the app, shown on the consent screen
+	TermsOfServiceURL *string `form:"termsOfServiceUrl,omitempty" json:"termsOfServiceUrl,omitempty" yaml:"termsOfServiceUrl,omitempty" xml:"termsOfServiceUrl,omitempty"`
 }
 
 // Validate validates the AppPayload type instance.
@@ -123,6 +273,9 @@ func (ut *AppPayload) Validate() (err error) {
 	if ut.Name == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "name"))
 	}
+	if len(ut.Contacts) > 10 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.contacts`, ut.Contacts, len(ut.Contacts), 10, false))
+	}
 	if ut.Description != nil {
 		if utf8.RuneCountInString(*ut.Description) > 300 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError(`type.description`, *ut.Description, utf8.RuneCountInString(*ut.Description), 300, false))
@@ -131,5 +284,252 @@ func (ut *AppPayload) Validate() (err error) {
 	if utf8.RuneCountInString(ut.Name) > 50 {
 		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.name`, ut.Name, utf8.RuneCountInString(ut.Name), 50, false))
 	}
+	if len(ut.Origins) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.origins`, ut.Origins, len(ut.Origins), 20, false))
+	}
+	return
+}
+
+// Maximal number of apps for a user
+type appQuotaPayload struct {
+	// Maximal number of apps the user can register. 0 means no limit.
+	MaxApps *int `form:"maxApps,omitempty" json:"maxApps,omitempty" yaml:"maxApps,omitempty" xml:"maxApps,omitempty"`
+}
+
+// Validate validates the appQuotaPayload type instance.
+func (ut *appQuotaPayload) Validate() (err error) {
+	if ut.MaxApps == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "maxApps"))
+	}
+	if ut.MaxApps != nil {
+		if *ut.MaxApps < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`request.maxApps`, *ut.MaxApps, 0, true))
+		}
+	}
+	return
+}
+
+// Publicize creates AppQuotaPayload from appQuotaPayload
+func (ut *appQuotaPayload) Publicize() *AppQuotaPayload {
+	var pub AppQuotaPayload
+	if ut.MaxApps != nil {
+		pub.MaxApps = *ut.MaxApps
+	}
+	return &pub
+}
+
+// Maximal number of apps for a user
+type AppQuotaPayload struct {
+	// Maximal number of apps the user can register. 0 means no limit.
+	MaxApps int `form:"maxApps" json:"maxApps" yaml:"maxApps" xml:"maxApps"`
+}
+
+// Validate validates the AppQuotaPayload type instance.
+func (ut *AppQuotaPayload) Validate() (err error) {
+	if ut.MaxApps < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError(`type.maxApps`, ut.MaxApps, 0, true))
+	}
+	return
+}
+
+// Operation applied to many apps at once
+type bulkPayload struct {
+	// Only report what the operation would do, without changing the apps
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty" yaml:"dryRun,omitempty" xml:"dryRun,omitempty"`
+	// Filter that selects the apps, used instead of the IDs
+	Filter *appFilterPayload `form:"filter,omitempty" json:"filter,omitempty" yaml:"filter,omitempty" xml:"filter,omitempty"`
+	// IDs of the apps
+	Ids []string `form:"ids,omitempty" json:"ids,omitempty" yaml:"ids,omitempty" xml:"ids,omitempty"`
+	// Labels to add or change, for the set-labels operation
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" yaml:"labels,omitempty" xml:"labels,omitempty"`
+	// Operation applied to every selected app
+	Operation *string `form:"operation,omitempty" json:"operation,omitempty" yaml:"operation,omitempty" xml:"operation,omitempty"`
+	// Keys of the labels to remove, for the set-labels operation
+	RemoveLabels []string `form:"removeLabels,omitempty" json:"removeLabels,omitempty" yaml:"removeLabels,omitempty" xml:"removeLabels,omitempty"`
+}
+
+// Validate validates the bulkPayload type instance.
+func (ut *bulkPayload) Validate() (err error) {
+	if ut.Operation == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "operation"))
+	}
+	if ut.Filter != nil {
+		if err2 := ut.Filter.Validate(); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if ut.Operation != nil {
+		if !(*ut.Operation == "suspend" || *ut.Operation == "reactivate" || *ut.Operation == "delete" || *ut.Operation == "rotate-secret" || *ut.Operation == "set-labels") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError(`request.operation`, *ut.Operation, []interface{}{"suspend", "reactivate", "delete", "rotate-secret", "set-labels"}))
+		}
+	}
+	return
+}
+
+// Publicize creates BulkPayload from bulkPayload
+func (ut *bulkPayload) Publicize() *BulkPayload {
+	var pub BulkPayload
+	if ut.DryRun != nil {
+		pub.DryRun = ut.DryRun
+	}
+	if ut.Filter != nil {
+		pub.Filter = ut.Filter.Publicize()
+	}
+	if ut.Ids != nil {
+		pub.Ids = ut.Ids
+	}
+	if ut.Labels != nil {
+		pub.Labels = make(map[string]string, len(ut.Labels))
+		for k2, v2 := range ut.Labels {
+			pub.Labels[k2] = v2
+		}
+	}
+	if ut.Operation != nil {
+		pub.Operation = *ut.Operation
+	}
+	if ut.RemoveLabels != nil {
+		pub.RemoveLabels = ut.RemoveLabels
+	}
+	return &pub
+}
+
+// Operation applied to many apps at once
+type BulkPayload struct {
+	// Only report what the operation would do, without changing the apps
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty" yaml:"dryRun,omitempty" xml:"dryRun,omitempty"`
+	// Filter that selects the apps, used instead of the IDs
+	Filter *AppFilterPayload `form:"filter,omitempty" json:"filter,omitempty" yaml:"filter,omitempty" xml:"filter,omitempty"`
+	// IDs of the apps
+	Ids []string `form:"ids,omitempty" json:"ids,omitempty" yaml:"ids,omitempty" xml:"ids,omitempty"`
+	// Labels to add or change, for the set-labels operation
+	Labels map[string]string `form:"labels,omitempty" json:"labels,omitempty" yaml:"labels,omitempty" xml:"labels,omitempty"`
+	// Operation applied to every selected app
+	Operation string `form:"operation" json:"operation" yaml:"operation" xml:"operation"`
+	// Keys of the labels to remove, for the set-labels operation
+	RemoveLabels []string `form:"removeLabels,omitempty" json:"removeLabels,omitempty" yaml:"removeLabels,omitempty" xml:"removeLabels,omitempty"`
+}
+
+// Validate validates the BulkPayload type instance.
+func (ut *BulkPayload) Validate() (err error) {
+	if ut.Operation == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "operation"))
+	}
+	if ut.Filter != nil {
+		if err2 := ut.Filter.Validate(); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if !(ut.Operation == "suspend" || ut.Operation == "reactivate" || ut.Operation == "delete" || ut.Operation == "rotate-secret" || ut.Operation == "set-labels") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError(`type.operation`, ut.Operation, []interface{}{"suspend", "reactivate", "delete", "rotate-secret", "set-labels"}))
+	}
+	return
+}
+
+// Rate limit and quota policy for an app
+type rateLimitPayload struct {
+	// Maximal number of requests allowed in a single burst
+	Burst *int `form:"burst,omitempty" json:"burst,omitempty" yaml:"burst,omitempty" xml:"burst,omitempty"`
+	// Maximal number of requests per calendar month
+	MonthlyQuota *int `form:"monthlyQuota,omitempty" json:"monthlyQuota,omitempty" yaml:"monthlyQuota,omitempty" xml:"monthlyQuota,omitempty"`
+	// Maximal number of requests per day
+	RequestsPerDay *int `form:"requestsPerDay,omitempty" json:"requestsPerDay,omitempty" yaml:"requestsPerDay,omitempty" xml:"requestsPerDay,omitempty"`
+	// Maximal number of requests per minute
+	RequestsPerMinute *int `form:"requestsPerMinute,omitempty" json:"requestsPerMinute,omitempty" yaml:"requestsPerMinute,omitempty" xml:"requestsPerMinute,omitempty"`
+	// Maximal number of requests per second
+	RequestsPerSecond *int `form:"requestsPerSecond,omitempty" json:"requestsPerSecond,omitempty" yaml:"requestsPerSecond,omitempty" xml:"requestsPerSecond,omitempty"`
+}
+
+// Validate validates the rateLimitPayload type instance.
+func (ut *rateLimitPayload) Validate() (err error) {
+	if ut.Burst != nil {
+		if *ut.Burst < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`request.burst`, *ut.Burst, 0, true))
+		}
+	}
+	if ut.MonthlyQuota != nil {
+		if *ut.MonthlyQuota < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`request.monthlyQuota`, *ut.MonthlyQuota, 0, true))
+		}
+	}
+	if ut.RequestsPerDay != nil {
+		if *ut.RequestsPerDay < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`request.requestsPerDay`, *ut.RequestsPerDay, 0, true))
+		}
+	}
+	if ut.RequestsPerMinute != nil {
+		if *ut.RequestsPerMinute < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`request.requestsPerMinute`, *ut.RequestsPerMinute, 0, true))
+		}
+	}
+	if ut.RequestsPerSecond != nil {
+		if *ut.RequestsPerSecond < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`request.requestsPerSecond`, *ut.RequestsPerSecond, 0, true))
+		}
+	}
+	return
+}
+
+// Publicize creates RateLimitPayload from rateLimitPayload
+func (ut *rateLimitPayload) Publicize() *RateLimitPayload {
+	var pub RateLimitPayload
+	if ut.Burst != nil {
+		pub.Burst = ut.Burst
+	}
+	if ut.MonthlyQuota != nil {
+		pub.MonthlyQuota = ut.MonthlyQuota
+	}
+	if ut.RequestsPerDay != nil {
+		pub.RequestsPerDay = ut.RequestsPerDay
+	}
+	if ut.RequestsPerMinute != nil {
+		pub.RequestsPerMinute = ut.RequestsPerMinute
+	}
+	if ut.RequestsPerSecond != nil {
+		pub.RequestsPerSecond = ut.RequestsPerSecond
+	}
+	return &pub
+}
+
+// Rate limit and quota policy for an app
+type RateLimitPayload struct {
+	// Maximal number of requests allowed in a single burst
+	Burst *int `form:"burst,omitempty" json:"burst,omitempty" yaml:"burst,omitempty" xml:"burst,omitempty"`
+	// Maximal number of requests per calendar month
+	MonthlyQuota *int `form:"monthlyQuota,omitempty" json:"monthlyQuota,omitempty" yaml:"monthlyQuota,omitempty" xml:"monthlyQuota,omitempty"`
+	// Maximal number of requests per day
+	RequestsPerDay *int `form:"requestsPerDay,omitempty" json:"requestsPerDay,omitempty" yaml:"requestsPerDay,omitempty" xml:"requestsPerDay,omitempty"`
+	// Maximal number of requests per minute
+	RequestsPerMinute *int `form:"requestsPerMinute,omitempty" json:"requestsPerMinute,omitempty" yaml:"requestsPerMinute,omitempty" xml:"requestsPerMinute,omitempty"`
+	// Maximal number of requests per second
+	RequestsPerSecond *int `form:"requestsPerSecond,omitempty" json:"requestsPerSecond,omitempty" yaml:"requestsPerSecond,omitempty" xml:"requestsPerSecond,omitempty"`
+}
+
+// Validate validates the RateLimitPayload type instance.
+func (ut *RateLimitPayload) Validate() (err error) {
+	if ut.Burst != nil {
+		if *ut.Burst < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`type.burst`, *ut.Burst, 0, true))
+		}
+	}
+	if ut.MonthlyQuota != nil {
+		if *ut.MonthlyQuota < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`type.monthlyQuota`, *ut.MonthlyQuota, 0, true))
+		}
+	}
+	if ut.RequestsPerDay != nil {
+		if *ut.RequestsPerDay < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`type.requestsPerDay`, *ut.RequestsPerDay, 0, true))
+		}
+	}
+	if ut.RequestsPerMinute != nil {
+		if *ut.RequestsPerMinute < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`type.requestsPerMinute`, *ut.RequestsPerMinute, 0, true))
+		}
+	}
+	if ut.RequestsPerSecond != nil {
+		if *ut.RequestsPerSecond < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError(`type.requestsPerSecond`, *ut.RequestsPerSecond, 0, true))
+		}
+	}
 	return
 }
@@ -1,11 +1,34 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/Microkubes/backends"
 	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/Microkubes/microservice-apps-management/blob"
+	"github.com/Microkubes/microservice-apps-management/bulk"
 	"github.com/Microkubes/microservice-apps-management/db"
+	"github.com/Microkubes/microservice-apps-management/idempotency"
+	"github.com/Microkubes/microservice-apps-management/labels"
+	"github.com/Microkubes/microservice-apps-management/logo"
+	"github.com/Microkubes/microservice-apps-management/metrics"
+	"github.com/Microkubes/microservice-apps-management/origins"
+	"github.com/Microkubes/microservice-apps-management/reaper"
+	"github.com/Microkubes/microservice-apps-management/usage"
+	"github.com/Microkubes/microservice-apps-management/verification"
 	"github.com/Microkubes/microservice-security/auth"
 	"github.com/keitaroinc/goa"
 )
@@ -14,19 +37,38 @@ import (
 type AppsController struct {
 	*goa.Controller
 	Repository db.AppsManagementStore
+	// Config is the configuration of the controller. Use SetConfig to change it while the controller serves requests.
+	Config *AppsConfig
+	// Usage records the verifications of the apps. Usage is not recorded if not set.
+	Usage *usage.Meter
+	// Reaper reports the unused apps. The candidates endpoint fails if not set.
+	Reaper *reaper.Reaper
+	// DomainVerifier checks the domain verification tokens. The check endpoint fails if not set.
+	DomainVerifier *verification.Verifier
+	// Logos stores the app logos and their thumbnails. The logo endpoints fail if not set.
+	Logos blob.Store
+	// Bulk applies the bulk operations. The bulk endpoint fails if not set.
+	Bulk *bulk.Executor
+	// Idempotency keeps the responses of the POST requests sent with an Idempotency-Key header. The keys are ignored if not set.
+	Idempotency idempotency.Store
+	// Metrics counts the verifications of the apps. The verifications are not counted if not set.
+	Metrics *metrics.Metrics
+
+	configMutex sync.RWMutex
 }
 
 // NewAppsController creates a apps controller.
-func NewAppsController(service *goa.Service, repository db.AppsManagementStore) *AppsController {
+func NewAppsController(service *goa.Service, repository db.AppsManagementStore, config *AppsConfig) *AppsController {
 	return &AppsController{
 		Controller: service.NewController("AppsController"),
 		Repository: repository,
+		Config:     config,
 	}
 }
 
 // Get returns an app by its ID.
 func (c *AppsController) Get(ctx *app.GetAppsContext) error {
-	res, err := c.Repository.GetApp(ctx.AppID)
+	res, err := c.Repository.GetApp(ctx.Context, ctx.AppID)
 
 	if err != nil {
 		if backends.IsErrNotFound(err) {
@@ -41,6 +83,24 @@ func (c *AppsController) Get(ctx *app.GetAppsContext) error {
 	return ctx.OK(res)
 }
 
+// GetPublic returns the display-safe fields of an app for consent screens. It does not require
+// authentication, so it must never return the owner or the secret of the app.
+func (c *AppsController) GetPublic(ctx *app.GetPublicAppsContext) error {
+	clientApp, err := c.Repository.GetClientApp(ctx.Context, ctx.AppID)
+	if err != nil {
+		if backends.IsErrNotFound(err) || backends.IsErrInvalidInput(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if appsConfig := c.config(); appsConfig != nil {
+		ctx.ResponseData.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(time.Duration(appsConfig.PublicView.CacheMaxAge).Seconds())))
+	}
+
+	return ctx.OK(clientApp.ToPublicMedia())
+}
+
 // GetMyApps returns a paginated list of all apps for the current user.
 func (c *AppsController) GetMyApps(ctx *app.GetMyAppsAppsContext) error {
 	var authObj *auth.Auth
@@ -54,7 +114,12 @@ func (c *AppsController) GetMyApps(ctx *app.GetMyAppsAppsContext) error {
 
 	userID := authObj.UserID
 
-	res, err := c.Repository.GetMyApps(userID)
+	selector, err := parseSelector(ctx.Selector)
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	res, err := c.Repository.GetMyApps(ctx.Context, userID, selector)
 
 	if err != nil {
 		if backends.IsErrNotFound(err) {
@@ -63,12 +128,17 @@ func (c *AppsController) GetMyApps(ctx *app.GetMyAppsAppsContext) error {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	return ctx.OK(res)
+	return ctx.OK(db.ToMediaCollection(res))
 }
 
 // GetUserApps returns a paginated list of apps for a particular user. Used by system admin users.
 func (c *AppsController) GetUserApps(ctx *app.GetUserAppsAppsContext) error {
-	res, err := c.Repository.GetUserApps(ctx.UserID)
+	selector, err := parseSelector(ctx.Selector)
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	res, err := c.Repository.GetUserApps(ctx.Context, ctx.UserID, selector)
 
 	if err != nil {
 		if backends.IsErrNotFound(err) {
@@ -77,7 +147,7 @@ func (c *AppsController) GetUserApps(ctx *app.GetUserAppsAppsContext) error {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	return ctx.OK(res)
+	return ctx.OKAdmin(db.ToAdminMediaCollection(res))
 }
 
 // RegisterApp registers new app.
@@ -93,21 +163,50 @@ func (c *AppsController) RegisterApp(ctx *app.RegisterAppAppsContext) error {
 
 	userID := authObj.UserID
 
-	res, err := c.Repository.RegisterApp(ctx.Payload, userID)
+	idempotent, record, err := c.beginIdempotent(ctx, ctx.IdempotencyKey, "registerApp", ctx.Payload)
+	if err != nil {
+		if isIdempotencyConflict(err) {
+			return ctx.Conflict(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if record != nil {
+		res := &app.RegApps{}
+		if err := replayIdempotent(ctx.ResponseData, record, res); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.Created(res)
+	}
+	defer c.releaseIdempotent(idempotent)
+
+	maxApps, _, err := c.maxApps(ctx, userID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res, err := c.Repository.RegisterApp(ctx.Context, ctx.Payload, userID, maxApps)
 
 	if err != nil {
+		if db.IsErrQuotaExceeded(err) {
+			return ctx.Forbidden(err)
+		}
+		if backends.IsErrAlreadyExists(err) {
+			return ctx.Conflict(err)
+		}
 		if backends.IsErrInvalidInput(err) {
 			return ctx.BadRequest(goa.ErrBadRequest(err))
 		}
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
+	c.completeIdempotent(ctx, idempotent, http.StatusCreated, res)
+
 	return ctx.Created(res)
 }
 
 // DeleteApp deletes an app by its id.
 func (c *AppsController) DeleteApp(ctx *app.DeleteAppAppsContext) error {
-	err := c.Repository.DeleteApp(ctx.AppID)
+	err := c.Repository.DeleteApp(ctx.Context, ctx.AppID)
 	if err != nil {
 		if backends.IsErrNotFound(err) {
 			return ctx.NotFound(goa.ErrNotFound(err))
@@ -118,17 +217,20 @@ func (c *AppsController) DeleteApp(ctx *app.DeleteAppAppsContext) error {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	return ctx.OK([]byte("Application deleted successfully "))
+	return ctx.NoContent()
 }
 
 // UpdateApp updates an app by its id.
 func (c *AppsController) UpdateApp(ctx *app.UpdateAppAppsContext) error {
-	res, err := c.Repository.UpdateApp(ctx.Payload, ctx.AppID)
+	res, err := c.Repository.UpdateApp(ctx.Context, ctx.Payload, ctx.AppID)
 
 	if err != nil {
 		if backends.IsErrNotFound(err) {
 			return ctx.NotFound(goa.ErrNotFound(err))
 		}
+		if backends.IsErrAlreadyExists(err) {
+			return ctx.Conflict(err)
+		}
 		if backends.IsErrInvalidInput(err) {
 			return ctx.BadRequest(goa.ErrBadRequest(err))
 		}
@@ -140,7 +242,7 @@ func (c *AppsController) UpdateApp(ctx *app.UpdateAppAppsContext) error {
 
 // RegenerateClientSecret regenerates the client secret for an app.
 func (c *AppsController) RegenerateClientSecret(ctx *app.RegenerateClientSecretAppsContext) error {
-	res, err := c.Repository.RegenerateSecret(ctx.AppID)
+	res, err := c.Repository.RegenerateSecret(ctx.Context, ctx.AppID)
 
 	if err != nil {
 		if backends.IsErrNotFound(err) {
@@ -152,25 +254,991 @@ func (c *AppsController) RegenerateClientSecret(ctx *app.RegenerateClientSecretA
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	return ctx.OK(res)
+	return ctx.OKWithSecret(res.ToMediaWithSecret())
 }
 
 // VerifyApp check if an app with the supplied credentials exists.
 func (c *AppsController) VerifyApp(ctx *app.VerifyAppAppsContext) error {
-	clientApp, err := c.Repository.FindApp(ctx.Payload.ID, ctx.Payload.Secret)
-	if err != nil {
+	clientApp, err := c.Repository.FindApp(ctx.Context, ctx.Payload.ID, ctx.Payload.Secret)
+	if err != nil && !backends.IsErrNotFound(err) {
 		return ctx.InternalServerError(err)
 	}
 	if clientApp == nil {
+		if err != nil {
+			// unknown IDs are only counted in the metrics, so that the callers cannot create usage records for any ID
+			c.countVerification(false)
+		} else {
+			c.recordUsage(ctx.Payload.ID, ctx.RequestData, false)
+		}
 		return ctx.NotFound(fmt.Errorf("not-found"))
 	}
+	c.recordUsage(clientApp.ID, ctx.RequestData, true)
+
+	res := clientApp.ToMedia()
+	res.RateLimit = c.effectiveRateLimit(clientApp.RateLimit)
+
+	return ctx.OK(res)
+}
+
+// GetUsage returns the daily verification counts and the last use of an app.
+// Defaults to the last 30 days if the period is not given.
+func (c *AppsController) GetUsage(ctx *app.GetUsageAppsContext) error {
+	to := time.Now().UTC()
+	if ctx.To != nil {
+		parsed, err := time.Parse(db.DayFormat, *ctx.To)
+		if err != nil {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		to = parsed
+	}
+	from := to.AddDate(0, 0, -29)
+	if ctx.From != nil {
+		parsed, err := time.Parse(db.DayFormat, *ctx.From)
+		if err != nil {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		from = parsed
+	}
+
+	res, err := c.Repository.GetAppUsage(ctx.Context, ctx.AppID, from, to)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(res)
+}
+
+// GetRateLimit returns the rate limit and quota policy that applies to an app.
+func (c *AppsController) GetRateLimit(ctx *app.GetRateLimitAppsContext) error {
+	res, err := c.Repository.GetApp(ctx.Context, ctx.AppID)
+
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if res.RateLimit != nil {
+		return ctx.OK(res.RateLimit)
+	}
+
+	return ctx.OK(c.effectiveRateLimit(nil))
+}
+
+// SetRateLimit sets the rate limit and quota policy for an app.
+func (c *AppsController) SetRateLimit(ctx *app.SetRateLimitAppsContext) error {
+	res, err := c.Repository.SetRateLimit(ctx.Context, ctx.AppID, ctx.Payload)
+
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(res)
+}
+
+// DeleteRateLimit removes the rate limit and quota policy of an app, so the default policy applies to it.
+func (c *AppsController) DeleteRateLimit(ctx *app.DeleteRateLimitAppsContext) error {
+	err := c.Repository.DeleteRateLimit(ctx.Context, ctx.AppID)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.NoContent()
+}
+
+// IssueDomainVerification starts the verification of the app domain and returns where to publish the token.
+func (c *AppsController) IssueDomainVerification(ctx *app.IssueDomainVerificationAppsContext) error {
+	clientApp, err := c.Repository.GetClientApp(ctx.Context, ctx.AppID)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if !canManage(ctx, clientApp) {
+		return ctx.NotFound(goa.ErrNotFound("app not found"))
+	}
+
+	idempotent, record, err := c.beginIdempotent(ctx, ctx.IdempotencyKey, "issueDomainVerification", ctx.AppID)
+	if err != nil {
+		if isIdempotencyConflict(err) {
+			return ctx.Conflict(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if record != nil {
+		res := &app.DomainVerification{}
+		if err := replayIdempotent(ctx.ResponseData, record, res); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.OK(res)
+	}
+	defer c.releaseIdempotent(idempotent)
+
+	clientApp, err = c.Repository.IssueVerificationToken(ctx.Context, ctx.AppID)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res, err := domainVerificationMedia(clientApp)
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	c.completeIdempotent(ctx, idempotent, http.StatusOK, res)
+
+	return ctx.OK(res)
+}
+
+// CheckDomainVerification checks the published verification token and marks the app domain as verified.
+func (c *AppsController) CheckDomainVerification(ctx *app.CheckDomainVerificationAppsContext) error {
+	if c.DomainVerifier == nil {
+		return ctx.InternalServerError(goa.ErrInternal("domain verification is not configured"))
+	}
+
+	clientApp, err := c.Repository.GetClientApp(ctx.Context, ctx.AppID)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if !canManage(ctx, clientApp) {
+		return ctx.NotFound(goa.ErrNotFound("app not found"))
+	}
+	if clientApp.VerificationToken == "" {
+		return ctx.BadRequest(goa.ErrBadRequest("the domain verification has not been started"))
+	}
+
+	checkCtx := context.Context(ctx)
+	if appsConfig := c.config(); appsConfig != nil && appsConfig.DomainVerification.Timeout > 0 {
+		var cancel context.CancelFunc
+		checkCtx, cancel = context.WithTimeout(ctx, time.Duration(appsConfig.DomainVerification.Timeout))
+		defer cancel()
+	}
+
+	result := &db.VerificationResult{
+		Method:    ctx.Method,
+		Verified:  true,
+		CheckedAt: time.Now().Unix(),
+	}
+	if err := c.DomainVerifier.Check(checkCtx, clientApp.Domain, clientApp.VerificationToken, ctx.Method); err != nil {
+		result.Verified = false
+		result.Error = err.Error()
+		result.Failures = clientApp.VerificationFailures + 1
+	}
+
+	clientApp, err = c.Repository.SaveVerificationResult(ctx.Context, ctx.AppID, result)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res, err := domainVerificationMedia(clientApp)
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	return ctx.OK(res)
+}
+
+// CheckOrigin checks if the origin is allowed for the app. Suspended and deleted apps allow no origins.
+func (c *AppsController) CheckOrigin(ctx *app.CheckOriginAppsContext) error {
+	if err := origins.Validate(ctx.Origin); err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	clientApp, err := c.Repository.GetClientApp(ctx.Context, ctx.AppID)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(&app.OriginCheck{
+		AppID:   ctx.AppID,
+		Origin:  ctx.Origin,
+		Allowed: clientApp.CanVerify() && origins.Allowed(clientApp.Origins, ctx.Origin),
+	})
+}
+
+// SearchApps searches the apps by words in their name, description, domain and labels. Admin only.
+func (c *AppsController) SearchApps(ctx *app.SearchAppsAppsContext) error {
+	filter, err := parseAppFilter(ctx.Status, ctx.Owner, nil, ctx.RegisteredFrom, ctx.RegisteredTo)
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+	query := &db.SearchQuery{
+		AppFilter: *filter,
+		Limit:     ctx.Limit,
+	}
+	if ctx.Q != nil {
+		query.Text = *ctx.Q
+	}
+
+	res, err := c.Repository.SearchApps(ctx.Context, query)
+	if err != nil {
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OKAdmin(db.ToAdminMediaCollection(res))
+}
+
+// ListApps lists the apps of all users, page by page. Admin only.
+func (c *AppsController) ListApps(ctx *app.ListAppsAppsContext) error {
+	filter, err := parseAppFilter(ctx.Status, ctx.Owner, ctx.Domain, ctx.RegisteredFrom, ctx.RegisteredTo)
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	res, err := c.Repository.ListApps(ctx.Context, filter, &db.Page{Number: ctx.Page, Size: ctx.PageSize})
+	if err != nil {
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
 
-	return ctx.OK(&app.Apps{
-		ID:           clientApp.ID,
-		Description:  clientApp.Description,
-		Domain:       clientApp.Domain,
-		Name:         clientApp.Name,
-		Owner:        clientApp.Owner,
-		RegisteredAt: int(clientApp.RegisteredAt),
+	return ctx.OK(&app.AppPage{
+		Apps:     db.ToAdminMediaCollection(res.Apps),
+		Page:     ctx.Page,
+		PageSize: ctx.PageSize,
+		Total:    res.Total,
 	})
 }
+
+// ExportApps exports all apps that satisfy the filter as a CSV or a JSON file. Admin only.
+func (c *AppsController) ExportApps(ctx *app.ExportAppsAppsContext) error {
+	filter, err := parseAppFilter(ctx.Status, ctx.Owner, ctx.Domain, ctx.RegisteredFrom, ctx.RegisteredTo)
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	res, err := c.Repository.ListApps(ctx.Context, filter, nil)
+	if err != nil {
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	var data []byte
+	contentType := "text/csv"
+	if ctx.Format == "json" {
+		contentType = "application/json"
+		data, err = json.Marshal(db.ToAdminMediaCollection(res.Apps))
+	} else {
+		data, err = exportCSV(res.Apps)
+	}
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	header := ctx.ResponseData.Header()
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"apps.%s\"", ctx.Format))
+	return ctx.OK(data)
+}
+
+// BulkApps applies an operation to many apps at once and reports the outcome for every app. A request sent
+// with an Idempotency-Key header is executed once, and its retries get the original result. Admin only.
+func (c *AppsController) BulkApps(ctx *app.BulkAppsAppsContext) error {
+	if c.Bulk == nil {
+		return ctx.InternalServerError(goa.ErrInternal("bulk operations are not configured"))
+	}
+
+	req := &bulk.Request{
+		Operation:    ctx.Payload.Operation,
+		IDs:          ctx.Payload.Ids,
+		Labels:       ctx.Payload.Labels,
+		RemoveLabels: ctx.Payload.RemoveLabels,
+	}
+	if ctx.Payload.DryRun != nil {
+		req.DryRun = *ctx.Payload.DryRun
+	}
+	if f := ctx.Payload.Filter; f != nil {
+		filter, err := parseAppFilter(f.Status, f.Owner, f.Domain, f.RegisteredFrom, f.RegisteredTo)
+		if err != nil {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		req.Filter = filter
+	}
+
+	idempotent, record, err := c.beginIdempotent(ctx, ctx.IdempotencyKey, "bulkApps", ctx.Payload)
+	if err != nil {
+		if isIdempotencyConflict(err) {
+			return ctx.Conflict(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if record != nil {
+		res := &app.BulkResult{}
+		if err := replayIdempotent(ctx.ResponseData, record, res); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.OK(res)
+	}
+	defer c.releaseIdempotent(idempotent)
+
+	result, err := c.Bulk.Run(ctx.Context, req)
+	if err != nil {
+		if _, ok := err.(*bulk.Error); ok {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := result.ToMedia()
+	c.completeIdempotent(ctx, idempotent, http.StatusOK, res)
+
+	return ctx.OK(res)
+}
+
+// UploadLogo validates the uploaded logo of an app, stores it together with its thumbnail and links it from the app.
+func (c *AppsController) UploadLogo(ctx *app.UploadLogoAppsContext) error {
+	appsConfig := c.config()
+	if c.Logos == nil || appsConfig == nil {
+		return ctx.InternalServerError(goa.ErrInternal("logos are not configured"))
+	}
+	limits := appsConfig.Logos.Limits()
+
+	clientApp, err := c.Repository.GetClientApp(ctx.Context, ctx.AppID)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if !canManage(ctx, clientApp) {
+		return ctx.NotFound(goa.ErrNotFound("app not found"))
+	}
+
+	data, err := readLogoFile(ctx.RequestData.Request, ctx.ResponseData, limits.MaxBytes)
+	if err != nil {
+		if err == errLogoTooLarge {
+			return ctx.RequestEntityTooLarge(goa.ErrRequestBodyTooLarge(err))
+		}
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	processed, err := logo.Process(data, limits)
+	if err != nil {
+		if _, ok := err.(*logo.Error); ok {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if err := c.Logos.Put(logoKey(ctx.AppID, logoOriginal), data); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if err := c.Logos.Put(logoKey(ctx.AppID, logoThumbnail), processed.Thumbnail); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	clientApp, err = c.Repository.SetAppLogo(ctx.Context, ctx.AppID, &db.AppLogo{
+		ContentType: processed.ContentType,
+		Size:        len(data),
+		Width:       processed.Width,
+		Height:      processed.Height,
+		Hash:        processed.Hash,
+	})
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(clientApp.ToMedia())
+}
+
+// GetLogo serves the logo of an app or its thumbnail, with cache headers. The logos are public, like the
+// public view of the app that links them.
+func (c *AppsController) GetLogo(ctx *app.GetLogoAppsContext) error {
+	appsConfig := c.config()
+	if c.Logos == nil || appsConfig == nil {
+		return ctx.InternalServerError(goa.ErrInternal("logos are not configured"))
+	}
+
+	clientApp, err := c.Repository.GetClientApp(ctx.Context, ctx.AppID)
+	if err != nil {
+		if backends.IsErrNotFound(err) || backends.IsErrInvalidInput(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if clientApp.Logo == nil {
+		return ctx.NotFound(goa.ErrNotFound("the app has no logo"))
+	}
+
+	etag := clientApp.Logo.ETag(ctx.Size)
+	header := ctx.ResponseData.Header()
+	header.Set("ETag", etag)
+	header.Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(time.Duration(appsConfig.Logos.CacheMaxAge).Seconds())))
+	if ctx.IfNoneMatch != nil && *ctx.IfNoneMatch == etag {
+		return ctx.NotModified()
+	}
+
+	data, err := c.Logos.Get(logoKey(ctx.AppID, ctx.Size))
+	if err != nil {
+		if err == blob.ErrNotFound {
+			return ctx.NotFound(goa.ErrNotFound("the app has no logo"))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	contentType := clientApp.Logo.ContentType
+	if ctx.Size == logoThumbnail {
+		contentType = logo.ThumbnailContentType
+	}
+	header.Set("Content-Type", contentType)
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	return ctx.OK(data)
+}
+
+// DeleteLogo removes the logo of an app.
+func (c *AppsController) DeleteLogo(ctx *app.DeleteLogoAppsContext) error {
+	if c.Logos == nil {
+		return ctx.InternalServerError(goa.ErrInternal("logos are not configured"))
+	}
+
+	clientApp, err := c.Repository.GetClientApp(ctx.Context, ctx.AppID)
+	if err != nil {
+		if backends.IsErrNotFound(err) || backends.IsErrInvalidInput(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if !canManage(ctx, clientApp) {
+		return ctx.NotFound(goa.ErrNotFound("app not found"))
+	}
+
+	clientApp, err = c.Repository.SetAppLogo(ctx.Context, ctx.AppID, nil)
+	if err != nil {
+		if backends.IsErrNotFound(err) || backends.IsErrInvalidInput(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	for _, size := range []string{logoOriginal, logoThumbnail} {
+		if err := c.Logos.Delete(logoKey(ctx.AppID, size)); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+	}
+
+	return ctx.OK(clientApp.ToMedia())
+}
+
+// GetReapingCandidates returns the unused apps and what the next run of the reaping job would do with them.
+func (c *AppsController) GetReapingCandidates(ctx *app.GetReapingCandidatesAppsContext) error {
+	if c.Reaper == nil {
+		return ctx.InternalServerError(goa.ErrInternal("reaping is not configured"))
+	}
+
+	report, err := c.Reaper.Plan(ctx.Context)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(report.ToMedia())
+}
+
+// ExemptFromReaping exempts an app from the reaping job and restores it if it was suspended or deleted.
+func (c *AppsController) ExemptFromReaping(ctx *app.ExemptFromReapingAppsContext) error {
+	res, err := c.Repository.SetReapingExempt(ctx.Context, ctx.AppID, true)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(res)
+}
+
+// RemoveReapingExemption removes the reaping exemption of an app.
+func (c *AppsController) RemoveReapingExemption(ctx *app.RemoveReapingExemptionAppsContext) error {
+	res, err := c.Repository.SetReapingExempt(ctx.Context, ctx.AppID, false)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(res)
+}
+
+// GetUserQuota returns the maximal and the current number of apps for a user.
+func (c *AppsController) GetUserQuota(ctx *app.GetUserQuotaAppsContext) error {
+	res, err := c.userQuota(ctx, ctx.UserID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(res)
+}
+
+// SetUserQuota overrides the maximal number of apps for a user.
+func (c *AppsController) SetUserQuota(ctx *app.SetUserQuotaAppsContext) error {
+	if _, err := c.Repository.SetUserQuota(ctx.Context, ctx.UserID, ctx.Payload.MaxApps); err != nil {
+		if backends.IsErrInvalidInput(err) {
+			return ctx.BadRequest(goa.ErrBadRequest(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res, err := c.userQuota(ctx, ctx.UserID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(res)
+}
+
+// DeleteUserQuota removes the override of the maximal number of apps for a user, so the default applies.
+func (c *AppsController) DeleteUserQuota(ctx *app.DeleteUserQuotaAppsContext) error {
+	if err := c.Repository.DeleteUserQuota(ctx.Context, ctx.UserID); err != nil {
+		if backends.IsErrNotFound(err) {
+			return ctx.NotFound(goa.ErrNotFound(err))
+		}
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res, err := c.userQuota(ctx, ctx.UserID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(res)
+}
+
+// userQuota returns the maximal and the current number of apps for a user.
+func (c *AppsController) userQuota(ctx context.Context, userID string) (*app.AppQuota, error) {
+	maxApps, override, err := c.maxApps(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	used, err := c.Repository.CountUserApps(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &app.AppQuota{
+		UserID:   userID,
+		MaxApps:  maxApps,
+		Used:     used,
+		Override: override,
+	}, nil
+}
+
+// maxApps returns the maximal number of apps for a user and whether it has been set for that
+// user, instead of the configured default.
+func (c *AppsController) maxApps(ctx context.Context, userID string) (int, bool, error) {
+	quota, err := c.Repository.GetUserQuota(ctx, userID)
+	if err == nil {
+		return quota.MaxApps, true, nil
+	}
+	if !backends.IsErrNotFound(err) {
+		return 0, false, err
+	}
+	if appsConfig := c.config(); appsConfig != nil {
+		return appsConfig.AppQuotas.DefaultMaxApps, false, nil
+	}
+	return 0, false, nil
+}
+
+// Logo sizes, as in the size parameter of the getLogo action.
+const (
+	logoOriginal  = "original"
+	logoThumbnail = "thumbnail"
+)
+
+// multipartOverhead is the space allowed for the multipart boundaries and headers around the logo file.
+const multipartOverhead = 64 * 1024
+
+var errLogoTooLarge = fmt.Errorf("the logo is too large")
+
+// logoKey returns the blob key of the logo image of the given size.
+func logoKey(appID, size string) string {
+	return appID + "/logo-" + size
+}
+
+// readLogoFile reads the "logo" file of a multipart/form-data request. The request body is limited, so
+// a large upload is rejected without reading all of it.
+func readLogoFile(req *http.Request, rw http.ResponseWriter, maxBytes int) ([]byte, error) {
+	req.Body = http.MaxBytesReader(rw, req.Body, int64(maxBytes)+multipartOverhead)
+	file, _, err := req.FormFile("logo")
+	if err != nil {
+		if strings.Contains(err.Error(), "request body too large") {
+			return nil, errLogoTooLarge
+		}
+		return nil, fmt.Errorf("expected the logo as the \"logo\" file of a multipart/form-data request: %s", err)
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(file, int64(maxBytes)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxBytes {
+		return nil, errLogoTooLarge
+	}
+	return data, nil
+}
+
+// parseSelector parses the optional label selector of a listing request.
+func parseSelector(selector *string) (labels.Selector, error) {
+	if selector == nil {
+		return labels.Selector{}, nil
+	}
+	return labels.Parse(*selector)
+}
+
+// parseAppFilter builds the filter of a listing from the query parameters. The registration dates
+// are days, and the period includes the whole last day.
+func parseAppFilter(status, owner, domain, registeredFrom, registeredTo *string) (*db.AppFilter, error) {
+	filter := &db.AppFilter{}
+	if status != nil {
+		filter.Status = *status
+	}
+	if owner != nil {
+		filter.Owner = *owner
+	}
+	if domain != nil {
+		filter.Domain = *domain
+	}
+	if registeredFrom != nil {
+		from, err := time.Parse(db.DayFormat, *registeredFrom)
+		if err != nil {
+			return nil, err
+		}
+		filter.RegisteredFrom = from.Unix()
+	}
+	if registeredTo != nil {
+		to, err := time.Parse(db.DayFormat, *registeredTo)
+		if err != nil {
+			return nil, err
+		}
+		filter.RegisteredTo = to.AddDate(0, 0, 1).Unix() - 1
+	}
+	return filter, nil
+}
+
+// exportCSV writes the apps as CSV, one app per row after the header. The secret is never exported.
+func exportCSV(clientApps []*db.ClientApp) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	w.Write([]string{"id", "name", "owner", "domain", "status", "domainVerified", "registeredAt", "lastUsedAt", "labels"})
+	for _, clientApp := range clientApps {
+		lastUsedAt := ""
+		if clientApp.LastUsedAt > 0 {
+			lastUsedAt = time.Unix(clientApp.LastUsedAt, 0).UTC().Format(time.RFC3339)
+		}
+		w.Write([]string{
+			clientApp.ID,
+			clientApp.Name,
+			clientApp.Owner,
+			clientApp.Domain,
+			clientApp.CurrentStatus(),
+			strconv.FormatBool(clientApp.DomainVerified),
+			time.Unix(clientApp.RegisteredAt, 0).UTC().Format(time.RFC3339),
+			lastUsedAt,
+			formatLabels(clientApp.Labels),
+		})
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// formatLabels formats the labels as a comma-separated list of key=value pairs, sorted by key.
+func formatLabels(appLabels map[string]string) string {
+	pairs := []string{}
+	for key, value := range appLabels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// idempotencyKey scopes the idempotency key sent by the client to the user and the action, so that
+// different users and actions never share a key.
+func idempotencyKey(ctx context.Context, action, key string) string {
+	userID := ""
+	if auth.HasAuth(ctx) {
+		userID = auth.GetAuth(ctx).UserID
+	}
+	return userID + "/" + action + "/" + key
+}
+
+// idempotencyWriteTimeout bounds the writes of the idempotency records after a request has been processed.
+// They do not run within the context of the request, so that a client that disconnects does not prevent the
+// result from being saved.
+const idempotencyWriteTimeout = 5 * time.Second
+
+// idempotentRequest is a request sent with an idempotency key, which holds the lease on its scoped key.
+type idempotentRequest struct {
+	key   string
+	lease string
+}
+
+// beginIdempotent reserves the idempotency key sent in the header of the request. It returns the request that
+// holds the lease on the key, which is nil if the request has no key, and the record of the original request
+// if the request is a retry. The request is identified by the action and the given values, like the payload.
+func (c *AppsController) beginIdempotent(ctx context.Context, header *string, action string, request ...interface{}) (*idempotentRequest, *idempotency.Record, error) {
+	if header == nil || c.Idempotency == nil {
+		return nil, nil, nil
+	}
+
+	key := idempotencyKey(ctx, action, *header)
+	fingerprint, err := idempotency.Fingerprint(append([]interface{}{action}, request...)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	record, lease, err := c.Idempotency.Begin(ctx, key, fingerprint)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &idempotentRequest{key: key, lease: lease}, record, nil
+}
+
+// completeIdempotent saves the response of the request that reserved the idempotency key. The request has
+// been processed at this point, so a failure to save the response is only logged, and a retry is executed again.
+func (c *AppsController) completeIdempotent(ctx context.Context, req *idempotentRequest, status int, res interface{}) {
+	if req == nil {
+		return
+	}
+	body, err := json.Marshal(res)
+	if err == nil {
+		writeCtx, cancel := context.WithTimeout(context.Background(), idempotencyWriteTimeout)
+		defer cancel()
+		err = c.Idempotency.Complete(writeCtx, req.key, req.lease, &idempotency.Record{Status: status, Body: body})
+	}
+	if err != nil {
+		goa.LogError(ctx, "idempotency", "key", req.key, "err", err)
+	}
+}
+
+// releaseIdempotent frees the idempotency key of a request that has not completed, so that it can be retried.
+func (c *AppsController) releaseIdempotent(req *idempotentRequest) {
+	if req == nil {
+		return
+	}
+	writeCtx, cancel := context.WithTimeout(context.Background(), idempotencyWriteTimeout)
+	defer cancel()
+	c.Idempotency.Release(writeCtx, req.key, req.lease)
+}
+
+// replayIdempotent decodes the original response of a retried request into res and marks the response as replayed.
+func replayIdempotent(rw *goa.ResponseData, record *idempotency.Record, res interface{}) error {
+	if err := json.Unmarshal(record.Body, res); err != nil {
+		return err
+	}
+	rw.Header().Set("Idempotent-Replayed", "true")
+	return nil
+}
+
+// isIdempotencyConflict checks if the idempotency key cannot be used for the request.
+func isIdempotencyConflict(err error) bool {
+	return err == idempotency.ErrMismatch || err == idempotency.ErrInProgress
+}
+
+// SetConfig replaces the configuration of the controller while it serves requests, when the configuration
+// is reloaded.
+func (c *AppsController) SetConfig(config *AppsConfig) {
+	c.configMutex.Lock()
+	defer c.configMutex.Unlock()
+	c.Config = config
+}
+
+// config returns the current configuration of the controller.
+func (c *AppsController) config() *AppsConfig {
+	c.configMutex.RLock()
+	defer c.configMutex.RUnlock()
+	return c.Config
+}
+
+// effectiveRateLimit returns the media for the given app policy, falling back to the
+// configured default policy. If neither is set, an empty policy (no limits) is returned.
+func (c *AppsController) effectiveRateLimit(policy *db.RateLimitPolicy) *app.RateLimit {
+	if policy != nil {
+		return policy.ToMedia()
+	}
+	if appsConfig := c.config(); appsConfig != nil && appsConfig.RateLimits.Default != nil {
+		return appsConfig.RateLimits.Default.ToMedia()
+	}
+	return &app.RateLimit{}
+}
+
+// recordUsage records a verification of the app, if usage metering or metrics are enabled.
+func (c *AppsController) recordUsage(appID string, req *goa.RequestData, success bool) {
+	c.countVerification(success)
+	if c.Usage == nil {
+		return
+	}
+	var trustedProxies []*net.IPNet
+	if appsConfig := c.config(); appsConfig != nil {
+		trustedProxies = appsConfig.Usage.TrustedProxyNets()
+	}
+	c.Usage.Record(appID, clientIP(req, trustedProxies), success)
+}
+
+// countVerification counts a verification in the metrics, if they are enabled.
+func (c *AppsController) countVerification(success bool) {
+	if c.Metrics != nil {
+		c.Metrics.RecordVerification(success)
+	}
+}
+
+// canManage checks whether the user of the request owns the app or is an admin. The other users get the same
+// response as for an unknown app, so that they cannot find out which apps exist.
+func canManage(ctx context.Context, clientApp *db.ClientApp) bool {
+	if !auth.HasAuth(ctx) {
+		return false
+	}
+	authObj := auth.GetAuth(ctx)
+	if authObj.UserID == clientApp.Owner {
+		return true
+	}
+	for _, role := range authObj.Roles {
+		if role == "admin" || role == "system" {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the IP address of the client. X-Forwarded-For is read only if the request comes from one of
+// the trusted proxies, from the last address, which the closest proxy added, to the first address that is not a
+// trusted proxy. The addresses before it are set by the client and cannot be trusted.
+func clientIP(req *goa.RequestData, trustedProxies []*net.IPNet) string {
+	if req == nil || req.Request == nil {
+		return ""
+	}
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		ip = req.RemoteAddr
+	}
+	if !isTrustedProxy(ip, trustedProxies) {
+		return ip
+	}
+	hops := strings.Split(strings.Join(req.Header["X-Forwarded-For"], ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !isTrustedProxy(hop, trustedProxies) {
+			break
+		}
+	}
+	return ip
+}
+
+// isTrustedProxy checks whether the IP address is in one of the ranges of the trusted proxies.
+func isTrustedProxy(ip string, trustedProxies []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// domainVerificationMedia returns the domain verification status of the app and where to publish the token.
+func domainVerificationMedia(clientApp *db.ClientApp) (*app.DomainVerification, error) {
+	instructions, err := verification.GetInstructions(clientApp.Domain, clientApp.VerificationToken)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &app.DomainVerification{
+		AppID:          clientApp.ID,
+		Domain:         clientApp.Domain,
+		Verified:       clientApp.DomainVerified,
+		Token:          clientApp.VerificationToken,
+		DNSRecordName:  instructions.DNSRecordName,
+		DNSRecordValue: instructions.DNSRecordValue,
+		HTTPURL:        instructions.HTTPURL,
+	}
+	if clientApp.VerifiedAt > 0 {
+		verifiedAt := int(clientApp.VerifiedAt)
+		res.VerifiedAt = &verifiedAt
+	}
+	if clientApp.VerificationMethod != "" {
+		res.Method = &clientApp.VerificationMethod
+	}
+	if clientApp.VerificationCheckedAt > 0 {
+		checkedAt := int(clientApp.VerificationCheckedAt)
+		res.CheckedAt = &checkedAt
+	}
+	if clientApp.VerificationError != "" {
+		res.Error = &clientApp.VerificationError
+	}
+
+	return res, nil
+}
@@ -1,24 +1,47 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Microkubes/microservice-apps-management/app"
 	"github.com/Microkubes/microservice-apps-management/app/test"
+	"github.com/Microkubes/microservice-apps-management/blob"
+	"github.com/Microkubes/microservice-apps-management/bulk"
 	"github.com/Microkubes/microservice-apps-management/db"
+	"github.com/Microkubes/microservice-apps-management/idempotency"
+	"github.com/Microkubes/microservice-apps-management/reaper"
+	"github.com/Microkubes/microservice-apps-management/usage"
+	"github.com/Microkubes/microservice-apps-management/verification"
 	"github.com/Microkubes/microservice-security/auth"
 	"github.com/keitaroinc/goa"
+	"github.com/keitaroinc/goa/goatest"
 )
 
 var (
 	service       = goa.New("apps-test")
 	database      = db.New()
-	ctrl          = NewAppsController(service, database)
+	appsConfig    = &AppsConfig{RateLimits: RateLimitsConfig{Default: &db.RateLimitPolicy{RequestsPerSecond: 10, Burst: 20}}, AppQuotas: AppQuotasConfig{DefaultMaxApps: 20}}
+	ctrl          = NewAppsController(service, database, appsConfig)
 	ID            = "5975c461f9f8eb02aae053f3"
 	notFoundID    = "rrr5c461f9f8eb02aae05zzz"
 	badReqID      = "bad-request-error"
 	errInternalID = "internal-error"
+	ownerID       = "ada5c461f9f8eb02aae05zzz"
 	name          = "app-name"
 	desc          = "Some description"
 	domain        = "example.com"
@@ -62,49 +85,221 @@ func TestGetAppsBadRequest(t *testing.T) {
 func TestGetMyAppsAppsOK(t *testing.T) {
 	authObj := &auth.Auth{UserID: ID}
 	ctx = auth.SetAuth(ctx, authObj)
-	test.GetMyAppsAppsOK(t, ctx, service, ctrl)
+	test.GetMyAppsAppsOK(t, ctx, service, ctrl, nil)
 }
 
 func TestGetMyAppsAppsNotFound(t *testing.T) {
 	authObj := &auth.Auth{UserID: notFoundID}
 	ctx = auth.SetAuth(ctx, authObj)
-	test.GetMyAppsAppsNotFound(t, ctx, service, ctrl)
+	test.GetMyAppsAppsNotFound(t, ctx, service, ctrl, nil)
 }
 
 func TestGetMyAppsAppsInternalServerError(t *testing.T) {
 	authObj := &auth.Auth{UserID: errInternalID}
 	ctx = auth.SetAuth(ctx, authObj)
-	test.GetMyAppsAppsInternalServerError(t, ctx, service, ctrl)
+	test.GetMyAppsAppsInternalServerError(t, ctx, service, ctrl, nil)
 }
 
 func TestGetUserAppsAppsOK(t *testing.T) {
-	test.GetUserAppsAppsOK(t, ctx, service, ctrl, ID)
+	test.GetUserAppsAppsOKAdmin(t, ctx, service, ctrl, ID, nil)
 }
 
 func TestGetUserAppsAppsNotFound(t *testing.T) {
-	test.GetUserAppsAppsNotFound(t, ctx, service, ctrl, notFoundID)
+	test.GetUserAppsAppsNotFound(t, ctx, service, ctrl, notFoundID, nil)
 }
 
 func TestGetUserAppsAppsInternalServerError(t *testing.T) {
-	test.GetUserAppsAppsInternalServerError(t, ctx, service, ctrl, errInternalID)
+	test.GetUserAppsAppsInternalServerError(t, ctx, service, ctrl, errInternalID, nil)
+}
+
+func TestGetUserAppsAppsOKWithSelector(t *testing.T) {
+	labelsCtrl := NewAppsController(service, db.New(), appsConfig)
+	selector := "env=prod,team in (payments,risk),!deprecated"
+	test.GetUserAppsAppsOKAdmin(t, ctx, service, labelsCtrl, ID, &selector)
+}
+
+func TestGetUserAppsAppsNotFoundWithSelector(t *testing.T) {
+	labelsCtrl := NewAppsController(service, db.New(), appsConfig)
+	selector := "env!=prod"
+	test.GetUserAppsAppsNotFound(t, ctx, service, labelsCtrl, ID, &selector)
+}
+
+func TestGetUserAppsAppsBadRequest(t *testing.T) {
+	selector := "team in payments"
+	test.GetUserAppsAppsBadRequest(t, ctx, service, ctrl, ID, &selector)
+}
+
+func TestGetMyAppsAppsBadRequest(t *testing.T) {
+	authObj := &auth.Auth{UserID: ID}
+	ctx = auth.SetAuth(ctx, authObj)
+	selector := "env=prod,"
+	test.GetMyAppsAppsBadRequest(t, ctx, service, ctrl, &selector)
+}
+
+func TestRegisterAppAppsBadRequestInvalidLabels(t *testing.T) {
+	authObj := &auth.Auth{UserID: ID}
+	ctx = auth.SetAuth(ctx, authObj)
+	test.RegisterAppAppsBadRequest(t, ctx, service, ctrl, nil, &app.AppPayload{
+		Name:        "app-with-labels",
+		Description: &desc,
+		Domain:      &domain,
+		Labels:      map[string]string{"env": "not a valid value"},
+	})
 }
 
 func TestRegisterAppAppsCreated(t *testing.T) {
 	authObj := &auth.Auth{UserID: ID}
 	ctx = auth.SetAuth(ctx, authObj)
-	test.RegisterAppAppsCreated(t, ctx, service, ctrl, client)
+	test.RegisterAppAppsCreated(t, ctx, service, ctrl, nil, client)
 }
 
 func TestRegisterAppAppsBadRequest(t *testing.T) {
 	authObj := &auth.Auth{UserID: badReqID}
 	ctx = auth.SetAuth(ctx, authObj)
-	test.RegisterAppAppsBadRequest(t, ctx, service, ctrl, client)
+	test.RegisterAppAppsBadRequest(t, ctx, service, ctrl, nil, client)
 }
 
 func TestRegisterAppAppsInternalServerError(t *testing.T) {
 	authObj := &auth.Auth{UserID: errInternalID}
 	ctx = auth.SetAuth(ctx, authObj)
-	test.RegisterAppAppsInternalServerError(t, ctx, service, ctrl, client)
+	test.RegisterAppAppsInternalServerError(t, ctx, service, ctrl, nil, client)
+}
+
+func TestRegisterAppAppsForbidden(t *testing.T) {
+	quotaUserID := "quota-user"
+	test.SetUserQuotaAppsOK(t, ctx, service, ctrl, quotaUserID, &app.AppQuotaPayload{MaxApps: 1})
+
+	authObj := &auth.Auth{UserID: quotaUserID}
+	ctx = auth.SetAuth(ctx, authObj)
+	_, err := test.RegisterAppAppsForbidden(t, ctx, service, ctrl, nil, client)
+
+	if err == nil {
+		t.Fatal("Nil error")
+	}
+}
+
+func TestRegisterAppAppsIdempotencyKey(t *testing.T) {
+	store := db.New()
+	registerCtrl := NewAppsController(service, store, appsConfig)
+	registerCtrl.Idempotency = store.IdempotencyStore(time.Hour, time.Minute)
+
+	userID := "idempotent-user"
+	test.SetUserQuotaAppsOK(t, ctx, service, registerCtrl, userID, &app.AppQuotaPayload{MaxApps: 2})
+	ctx = auth.SetAuth(ctx, &auth.Auth{UserID: userID})
+
+	key := "registration-1"
+	_, res := test.RegisterAppAppsCreated(t, ctx, service, registerCtrl, &key, client)
+
+	// the quota is used up, so only a replay can succeed
+	rw, replayed := test.RegisterAppAppsCreated(t, ctx, service, registerCtrl, &key, client)
+	if rw.Header().Get("Idempotent-Replayed") != "true" || replayed.ID != res.ID || replayed.Secret != res.Secret {
+		t.Fatalf("Expected the original registration with the secret, got %+v", replayed)
+	}
+	test.RegisterAppAppsForbidden(t, ctx, service, registerCtrl, nil, client)
+
+	other := *client
+	other.Name = "other name"
+	test.RegisterAppAppsConflict(t, ctx, service, registerCtrl, &key, &other)
+}
+
+// detachedIdempotencyStore is an idempotency.Store whose writes fail if they run within a canceled context.
+type detachedIdempotencyStore struct {
+	idempotency.Store
+	completed bool
+}
+
+func (s *detachedIdempotencyStore) Complete(ctx context.Context, key, lease string, record *idempotency.Record) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.completed = true
+	return s.Store.Complete(ctx, key, lease, record)
+}
+
+func TestRegisterAppAppsIdempotencyKeyDisconnected(t *testing.T) {
+	store := &detachedIdempotencyStore{Store: idempotency.NewMemoryStore(time.Hour)}
+	registerCtrl := NewAppsController(service, db.New(), appsConfig)
+	registerCtrl.Idempotency = store
+
+	requestCtx, cancel := context.WithCancel(auth.SetAuth(context.Background(), &auth.Auth{UserID: "disconnected-user"}))
+	cancel()
+
+	key := "registration-1"
+	test.RegisterAppAppsCreated(t, requestCtx, service, registerCtrl, &key, client)
+	if !store.completed {
+		t.Fatal("Expected the response to be saved after the client disconnected")
+	}
+
+	failingCtrl := NewAppsController(service, db.New(), appsConfig)
+	failingCtrl.Idempotency = &detachedIdempotencyStore{Store: failingCompleteStore{idempotency.NewMemoryStore(time.Hour)}}
+	test.RegisterAppAppsCreated(t, requestCtx, service, failingCtrl, &key, client)
+}
+
+// failingCompleteStore is an idempotency.Store that cannot save the responses.
+type failingCompleteStore struct {
+	idempotency.Store
+}
+
+func (s failingCompleteStore) Complete(ctx context.Context, key, lease string, record *idempotency.Record) error {
+	return fmt.Errorf("store failure")
+}
+
+func TestRegisterAppAppsConflict(t *testing.T) {
+	authObj := &auth.Auth{UserID: ID}
+	ctx = auth.SetAuth(ctx, authObj)
+	test.RegisterAppAppsConflict(t, ctx, service, ctrl, nil, &app.AppPayload{
+		Name:        "  Conflicting   NAME ",
+		Description: &desc,
+		Domain:      &domain,
+	})
+}
+
+func TestGetUserQuotaAppsOK(t *testing.T) {
+	_, quota := test.GetUserQuotaAppsOK(t, ctx, service, ctrl, "default-quota-user")
+
+	if quota.Override {
+		t.Error("Expected the default quota")
+	}
+	if quota.MaxApps != appsConfig.AppQuotas.DefaultMaxApps {
+		t.Errorf("Expected %d apps, got %d", appsConfig.AppQuotas.DefaultMaxApps, quota.MaxApps)
+	}
+}
+
+func TestGetUserQuotaAppsInternalServerError(t *testing.T) {
+	test.GetUserQuotaAppsInternalServerError(t, ctx, service, ctrl, errInternalID)
+}
+
+func TestSetUserQuotaAppsOK(t *testing.T) {
+	_, quota := test.SetUserQuotaAppsOK(t, ctx, service, ctrl, "override-user", &app.AppQuotaPayload{MaxApps: 50})
+
+	if !quota.Override || quota.MaxApps != 50 {
+		t.Errorf("Expected an override of 50 apps, got %+v", quota)
+	}
+}
+
+func TestSetUserQuotaAppsBadRequest(t *testing.T) {
+	test.SetUserQuotaAppsBadRequest(t, ctx, service, ctrl, badReqID, &app.AppQuotaPayload{MaxApps: 50})
+}
+
+func TestSetUserQuotaAppsInternalServerError(t *testing.T) {
+	test.SetUserQuotaAppsInternalServerError(t, ctx, service, ctrl, errInternalID, &app.AppQuotaPayload{MaxApps: 50})
+}
+
+func TestDeleteUserQuotaAppsOK(t *testing.T) {
+	test.SetUserQuotaAppsOK(t, ctx, service, ctrl, "removed-override-user", &app.AppQuotaPayload{MaxApps: 50})
+	_, quota := test.DeleteUserQuotaAppsOK(t, ctx, service, ctrl, "removed-override-user")
+
+	if quota.Override {
+		t.Errorf("Expected the default quota after removing the override, got %+v", quota)
+	}
+}
+
+func TestDeleteUserQuotaAppsNotFound(t *testing.T) {
+	test.DeleteUserQuotaAppsNotFound(t, ctx, service, ctrl, "no-override-user")
+}
+
+func TestDeleteUserQuotaAppsInternalServerError(t *testing.T) {
+	test.DeleteUserQuotaAppsInternalServerError(t, ctx, service, ctrl, errInternalID)
 }
 
 func TestUpdateAppAppsOK(t *testing.T) {
@@ -123,8 +318,19 @@ func TestUpdateAppAppsBadRequest(t *testing.T) {
 	test.UpdateAppAppsBadRequest(t, ctx, service, ctrl, badReqID, client)
 }
 
+func TestUpdateAppAppsConflict(t *testing.T) {
+	test.UpdateAppAppsConflict(t, ctx, service, ctrl, ID, &app.AppPayload{
+		Name:        "conflicting name",
+		Description: &desc,
+		Domain:      &domain,
+	})
+}
+
 func TestRegenerateClientSecretAppsOK(t *testing.T) {
-	test.RegenerateClientSecretAppsOK(t, ctx, service, ctrl, ID)
+	_, res := test.RegenerateClientSecretAppsOKWithSecret(t, ctx, service, ctrl, ID)
+	if res.Secret == nil || *res.Secret == "" {
+		t.Fatal("Expected the regenerated secret")
+	}
 }
 
 func TestRegenerateClientSecretAppsNotFound(t *testing.T) {
@@ -139,8 +345,728 @@ func TestRegenerateClientSecretAppsBadRequest(t *testing.T) {
 	test.RegenerateClientSecretAppsBadRequest(t, ctx, service, ctrl, badReqID)
 }
 
-func TestDeleteAppAppsOK(t *testing.T) {
-	test.DeleteAppAppsOK(t, ctx, service, ctrl, ID)
+func TestGetUsageAppsOK(t *testing.T) {
+	from := "2020-03-01"
+	to := "2020-03-07"
+	_, appUsage := test.GetUsageAppsOK(t, ctx, service, ctrl, ID, &from, &to)
+
+	if appUsage == nil {
+		t.Fatal("Nil app usage")
+	}
+
+	if len(appUsage.Daily) != 7 {
+		t.Fatalf("Expected 7 days of usage, got %d", len(appUsage.Daily))
+	}
+
+	if appUsage.Daily[0].Day != from || appUsage.Daily[6].Day != to {
+		t.Errorf("Invalid usage period: %s - %s", appUsage.Daily[0].Day, appUsage.Daily[6].Day)
+	}
+}
+
+func TestGetUsageAppsDefaultPeriod(t *testing.T) {
+	_, appUsage := test.GetUsageAppsOK(t, ctx, service, ctrl, ID, nil, nil)
+
+	if len(appUsage.Daily) != 30 {
+		t.Fatalf("Expected 30 days of usage, got %d", len(appUsage.Daily))
+	}
+}
+
+func TestGetUsageAppsNotFound(t *testing.T) {
+	test.GetUsageAppsNotFound(t, ctx, service, ctrl, notFoundID, nil, nil)
+}
+
+func TestGetUsageAppsInternalServerError(t *testing.T) {
+	test.GetUsageAppsInternalServerError(t, ctx, service, ctrl, errInternalID, nil, nil)
+}
+
+func TestGetUsageAppsBadRequest(t *testing.T) {
+	from := "2020-03-07"
+	to := "2020-03-01"
+	test.GetUsageAppsBadRequest(t, ctx, service, ctrl, ID, &from, &to)
+}
+
+type usageRecorder struct {
+	records []*db.UsageRecord
+}
+
+func (r *usageRecorder) RecordUsage(ctx context.Context, record *db.UsageRecord) error {
+	r.records = append(r.records, record)
+	return nil
+}
+
+func TestVerifyAppAppsNotFoundUsage(t *testing.T) {
+	recorder := &usageRecorder{}
+	ctrl.Usage = usage.NewMeter(recorder, time.Hour)
+	defer func() { ctrl.Usage = nil }()
+
+	test.VerifyAppAppsNotFound(t, ctx, service, ctrl, &app.AppCredentialsPayload{ID: notFoundID, Secret: "secret"})
+	test.VerifyAppAppsNotFound(t, ctx, service, ctrl, &app.AppCredentialsPayload{ID: ID, Secret: "wrong-secret"})
+	if err := ctrl.Usage.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(recorder.records) != 1 || recorder.records[0].AppID != ID || recorder.records[0].Failure != 1 {
+		t.Fatalf("Expected one failed verification of the known app, got %+v", recorder.records)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	_, gateway, _ := net.ParseCIDR("10.0.0.0/8")
+	trustedProxies := []*net.IPNet{gateway}
+
+	for _, tc := range []struct {
+		remoteAddr     string
+		forwardedFor   []string
+		trustedProxies []*net.IPNet
+		expected       string
+	}{
+		{"192.0.2.1:4000", nil, trustedProxies, "192.0.2.1"},
+		{"192.0.2.1:4000", []string{"198.51.100.7"}, nil, "192.0.2.1"},
+		{"192.0.2.1:4000", []string{"198.51.100.7"}, trustedProxies, "192.0.2.1"},
+		{"10.0.0.5:4000", []string{"198.51.100.7"}, nil, "10.0.0.5"},
+		{"10.0.0.5:4000", nil, trustedProxies, "10.0.0.5"},
+		{"10.0.0.5:4000", []string{"198.51.100.7"}, trustedProxies, "198.51.100.7"},
+		{"10.0.0.5:4000", []string{"203.0.113.9, 198.51.100.7"}, trustedProxies, "198.51.100.7"},
+		{"10.0.0.5:4000", []string{"203.0.113.9", "198.51.100.7, 10.0.0.2"}, trustedProxies, "198.51.100.7"},
+		{"10.0.0.5:4000", []string{"10.1.1.1, 10.0.0.2"}, trustedProxies, "10.1.1.1"},
+		{"10.0.0.5:4000", []string{"not-an-ip, 10.0.0.2"}, trustedProxies, "10.0.0.2"},
+	} {
+		req := httptest.NewRequest("POST", "/apps/verify", nil)
+		req.RemoteAddr = tc.remoteAddr
+		for _, value := range tc.forwardedFor {
+			req.Header.Add("X-Forwarded-For", value)
+		}
+		if ip := clientIP(&goa.RequestData{Request: req}, tc.trustedProxies); ip != tc.expected {
+			t.Errorf("Expected %s from %s with X-Forwarded-For %v, got %s", tc.expected, tc.remoteAddr, tc.forwardedFor, ip)
+		}
+	}
+}
+
+func TestGetRateLimitAppsOK(t *testing.T) {
+	_, rateLimit := test.GetRateLimitAppsOK(t, ctx, service, ctrl, ID)
+
+	if rateLimit == nil {
+		t.Fatal("Nil rate limit")
+	}
+
+	if rateLimit.RequestsPerSecond != 10 || rateLimit.Burst != 20 {
+		t.Errorf("Expected the default rate limit policy, got %+v", rateLimit)
+	}
+}
+
+func TestGetRateLimitAppsNotFound(t *testing.T) {
+	test.GetRateLimitAppsNotFound(t, ctx, service, ctrl, notFoundID)
+}
+
+func TestGetRateLimitAppsInternalServerError(t *testing.T) {
+	test.GetRateLimitAppsInternalServerError(t, ctx, service, ctrl, errInternalID)
+}
+
+func TestGetRateLimitAppsBadRequest(t *testing.T) {
+	test.GetRateLimitAppsBadRequest(t, ctx, service, ctrl, badReqID)
+}
+
+func TestSetRateLimitAppsOK(t *testing.T) {
+	perMinute := 100
+	_, rateLimit := test.SetRateLimitAppsOK(t, ctx, service, ctrl, ID, &app.RateLimitPayload{RequestsPerMinute: &perMinute})
+
+	if rateLimit == nil {
+		t.Fatal("Nil rate limit")
+	}
+
+	if rateLimit.RequestsPerMinute != 100 {
+		t.Errorf("Expected 100 requests per minute, got %d", rateLimit.RequestsPerMinute)
+	}
+	if rateLimit.RequestsPerSecond != 0 {
+		t.Errorf("Expected no limit per second, got %d", rateLimit.RequestsPerSecond)
+	}
+}
+
+func TestSetRateLimitAppsNotFound(t *testing.T) {
+	test.SetRateLimitAppsNotFound(t, ctx, service, ctrl, notFoundID, &app.RateLimitPayload{})
+}
+
+func TestSetRateLimitAppsInternalServerError(t *testing.T) {
+	test.SetRateLimitAppsInternalServerError(t, ctx, service, ctrl, errInternalID, &app.RateLimitPayload{})
+}
+
+func TestSetRateLimitAppsBadRequest(t *testing.T) {
+	test.SetRateLimitAppsBadRequest(t, ctx, service, ctrl, badReqID, &app.RateLimitPayload{})
+}
+
+func TestDeleteRateLimitAppsNoContent(t *testing.T) {
+	test.DeleteRateLimitAppsNoContent(t, ctx, service, ctrl, ID)
+}
+
+func TestDeleteRateLimitAppsNotFound(t *testing.T) {
+	test.DeleteRateLimitAppsNotFound(t, ctx, service, ctrl, notFoundID)
+}
+
+func TestDeleteRateLimitAppsInternalServerError(t *testing.T) {
+	test.DeleteRateLimitAppsInternalServerError(t, ctx, service, ctrl, errInternalID)
+}
+
+func TestDeleteRateLimitAppsBadRequest(t *testing.T) {
+	test.DeleteRateLimitAppsBadRequest(t, ctx, service, ctrl, badReqID)
+}
+
+type txtResolver map[string][]string
+
+func (r txtResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	if records, ok := r[name]; ok {
+		return records, nil
+	}
+	return nil, fmt.Errorf("no such host")
+}
+
+type fileFetcher map[string]string
+
+func (f fileFetcher) Fetch(ctx context.Context, url string) ([]byte, error) {
+	if content, ok := f[url]; ok {
+		return []byte(content), nil
+	}
+	return nil, fmt.Errorf("404 not found")
+}
+
+func newVerifyingController() *AppsController {
+	verifyingCtrl := NewAppsController(service, database, appsConfig)
+	verifyingCtrl.DomainVerifier = verification.NewVerifier(
+		txtResolver{"_apps-verification.example.com": {"apps-verification=verification-token"}},
+		fileFetcher{},
+	)
+	return verifyingCtrl
+}
+
+func TestIssueDomainVerificationAppsOK(t *testing.T) {
+	ownerCtx := auth.SetAuth(ctx, &auth.Auth{UserID: ownerID})
+	_, res := test.IssueDomainVerificationAppsOK(t, ownerCtx, service, ctrl, ID, nil)
+
+	if res.Verified {
+		t.Error("Expected the domain not to be verified")
+	}
+	if res.DNSRecordName != "_apps-verification.example.com" || res.DNSRecordValue != "apps-verification=verification-token" {
+		t.Errorf("Invalid DNS instructions: %s %s", res.DNSRecordName, res.DNSRecordValue)
+	}
+	if res.HTTPURL != "https://example.com/.well-known/apps-verification.txt" {
+		t.Errorf("Invalid HTTP URL: %s", res.HTTPURL)
+	}
+}
+
+func TestIssueDomainVerificationAppsNotFound(t *testing.T) {
+	test.IssueDomainVerificationAppsNotFound(t, ctx, service, ctrl, notFoundID, nil)
+}
+
+func TestIssueDomainVerificationAppsInternalServerError(t *testing.T) {
+	test.IssueDomainVerificationAppsInternalServerError(t, ctx, service, ctrl, errInternalID, nil)
+}
+
+func TestIssueDomainVerificationAppsBadRequest(t *testing.T) {
+	test.IssueDomainVerificationAppsBadRequest(t, ctx, service, ctrl, badReqID, nil)
+}
+
+func TestCheckDomainVerificationAppsOK(t *testing.T) {
+	ownerCtx := auth.SetAuth(ctx, &auth.Auth{UserID: ownerID})
+	_, res := test.CheckDomainVerificationAppsOK(t, ownerCtx, service, newVerifyingController(), ID, "dns")
+
+	if !res.Verified || res.VerifiedAt == nil || res.Method == nil || *res.Method != "dns" {
+		t.Errorf("Expected the domain to be verified with dns, got %+v", res)
+	}
+}
+
+func TestCheckDomainVerificationAppsOKFailedCheck(t *testing.T) {
+	ownerCtx := auth.SetAuth(ctx, &auth.Auth{UserID: ownerID})
+	_, res := test.CheckDomainVerificationAppsOK(t, ownerCtx, service, newVerifyingController(), ID, "http")
+
+	if res.Verified || res.Error == nil {
+		t.Errorf("Expected the http check to fail, got %+v", res)
+	}
+}
+
+func TestCheckDomainVerificationAppsNotFound(t *testing.T) {
+	test.CheckDomainVerificationAppsNotFound(t, ctx, service, newVerifyingController(), notFoundID, "dns")
+}
+
+func TestCheckDomainVerificationAppsInternalServerError(t *testing.T) {
+	test.CheckDomainVerificationAppsInternalServerError(t, ctx, service, ctrl, ID, "dns")
+}
+
+func TestCheckDomainVerificationAppsBadRequest(t *testing.T) {
+	test.CheckDomainVerificationAppsBadRequest(t, ctx, service, newVerifyingController(), badReqID, "dns")
+}
+
+func TestDomainVerificationAppsNotOwner(t *testing.T) {
+	store := db.New()
+	verifyingCtrl := newVerifyingController()
+	verifyingCtrl.Repository = store
+	otherCtx := auth.SetAuth(ctx, &auth.Auth{UserID: "other-user"})
+
+	test.IssueDomainVerificationAppsNotFound(t, otherCtx, service, verifyingCtrl, ID, nil)
+	test.CheckDomainVerificationAppsNotFound(t, otherCtx, service, verifyingCtrl, ID, "http")
+
+	clientApp, err := store.GetClientApp(ctx, ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clientApp.DomainVerified || clientApp.VerificationFailures != 0 || clientApp.VerificationCheckedAt != 0 {
+		t.Errorf("Expected the app to be unchanged, got %+v", clientApp)
+	}
+}
+
+func TestGetReapingCandidatesAppsOK(t *testing.T) {
+	reaperCtrl := NewAppsController(service, database, appsConfig)
+	reaperCtrl.Reaper = reaper.New(database, reaper.Policy{FlagAfterDays: 30, DryRun: true})
+
+	_, report := test.GetReapingCandidatesAppsOK(t, ctx, service, reaperCtrl)
+
+	if !report.DryRun {
+		t.Error("Expected a dry-run report")
+	}
+	var candidate *app.ReapingCandidate
+	for _, c := range report.Candidates {
+		if c.AppID == ID {
+			candidate = c
+		}
+	}
+	if candidate == nil {
+		t.Fatalf("Expected app %s to be a candidate", ID)
+	}
+	if candidate.Action != "flag" {
+		t.Errorf("Expected flag action, got %s", candidate.Action)
+	}
+}
+
+func TestGetReapingCandidatesAppsInternalServerError(t *testing.T) {
+	test.GetReapingCandidatesAppsInternalServerError(t, ctx, service, ctrl)
+}
+
+func TestExemptFromReapingAppsOK(t *testing.T) {
+	_, clientApp := test.ExemptFromReapingAppsOK(t, ctx, service, ctrl, ID)
+
+	if clientApp.ReapingExempt == nil || !*clientApp.ReapingExempt {
+		t.Errorf("Expected the app to be exempt, got %+v", clientApp)
+	}
+}
+
+func TestExemptFromReapingAppsNotFound(t *testing.T) {
+	test.ExemptFromReapingAppsNotFound(t, ctx, service, ctrl, notFoundID)
+}
+
+func TestExemptFromReapingAppsInternalServerError(t *testing.T) {
+	test.ExemptFromReapingAppsInternalServerError(t, ctx, service, ctrl, errInternalID)
+}
+
+func TestExemptFromReapingAppsBadRequest(t *testing.T) {
+	test.ExemptFromReapingAppsBadRequest(t, ctx, service, ctrl, badReqID)
+}
+
+func TestRemoveReapingExemptionAppsOK(t *testing.T) {
+	_, clientApp := test.RemoveReapingExemptionAppsOK(t, ctx, service, ctrl, ID)
+
+	if clientApp.ReapingExempt == nil || *clientApp.ReapingExempt {
+		t.Errorf("Expected the app not to be exempt, got %+v", clientApp)
+	}
+}
+
+func TestRemoveReapingExemptionAppsNotFound(t *testing.T) {
+	test.RemoveReapingExemptionAppsNotFound(t, ctx, service, ctrl, notFoundID)
+}
+
+func TestRemoveReapingExemptionAppsInternalServerError(t *testing.T) {
+	test.RemoveReapingExemptionAppsInternalServerError(t, ctx, service, ctrl, errInternalID)
+}
+
+func TestRemoveReapingExemptionAppsBadRequest(t *testing.T) {
+	test.RemoveReapingExemptionAppsBadRequest(t, ctx, service, ctrl, badReqID)
+}
+
+func TestCheckOriginAppsOK(t *testing.T) {
+	originsCtrl := NewAppsController(service, db.New(), appsConfig)
+
+	_, res := test.CheckOriginAppsOK(t, ctx, service, originsCtrl, ID, "https://app.example.com")
+	if !res.Allowed {
+		t.Error("Expected the origin to be allowed")
+	}
+
+	_, res = test.CheckOriginAppsOK(t, ctx, service, originsCtrl, ID, "https://app.example.org")
+	if res.Allowed {
+		t.Error("Expected the origin not to be allowed")
+	}
+}
+
+func TestCheckOriginAppsNotFound(t *testing.T) {
+	test.CheckOriginAppsNotFound(t, ctx, service, ctrl, notFoundID, "https://app.example.com")
+}
+
+func TestCheckOriginAppsBadRequest(t *testing.T) {
+	test.CheckOriginAppsBadRequest(t, ctx, service, ctrl, ID, "app.example.com/path")
+	test.CheckOriginAppsBadRequest(t, ctx, service, ctrl, badReqID, "https://app.example.com")
+}
+
+func TestCheckOriginAppsInternalServerError(t *testing.T) {
+	test.CheckOriginAppsInternalServerError(t, ctx, service, ctrl, errInternalID, "https://app.example.com")
+}
+
+func TestRegisterAppAppsBadRequestInvalidOrigin(t *testing.T) {
+	authObj := &auth.Auth{UserID: ID}
+	ctx = auth.SetAuth(ctx, authObj)
+	test.RegisterAppAppsBadRequest(t, ctx, service, ctrl, nil, &app.AppPayload{
+		Name:        "app-with-origins",
+		Description: &desc,
+		Domain:      &domain,
+		Origins:     []string{"ftp://example.com"},
+	})
+}
+
+func TestSearchAppsAppsOK(t *testing.T) {
+	searchCtrl := NewAppsController(service, db.New(), appsConfig)
+
+	q := "PAYMENTS unknown"
+	_, res := test.SearchAppsAppsOKAdmin(t, ctx, service, searchCtrl, 50, nil, &q, nil, nil, nil)
+	if len(res) != 1 || res[0].ID != ID {
+		t.Fatalf("Expected the app with the payments label, got %+v", res)
+	}
+
+	q = "nothing-matches"
+	_, res = test.SearchAppsAppsOKAdmin(t, ctx, service, searchCtrl, 50, nil, &q, nil, nil, nil)
+	if len(res) != 0 {
+		t.Fatalf("Expected no apps, got %+v", res)
+	}
+
+	registeredFrom := "2017-09-19"
+	_, res = test.SearchAppsAppsOKAdmin(t, ctx, service, searchCtrl, 50, nil, nil, &registeredFrom, nil, nil)
+	if len(res) != 0 {
+		t.Fatalf("Expected no apps registered after %s, got %+v", registeredFrom, res)
+	}
+
+	registeredTo := "2017-09-18"
+	status := "active"
+	_, res = test.SearchAppsAppsOKAdmin(t, ctx, service, searchCtrl, 50, nil, nil, nil, &registeredTo, &status)
+	if len(res) != 1 {
+		t.Fatalf("Expected the app registered on %s, got %+v", registeredTo, res)
+	}
+}
+
+func TestSearchAppsAppsInternalServerError(t *testing.T) {
+	test.SearchAppsAppsInternalServerError(t, ctx, service, ctrl, 50, &errInternalID, nil, nil, nil, nil)
+}
+
+func TestListAppsAppsOK(t *testing.T) {
+	listCtrl := NewAppsController(service, db.New(), appsConfig)
+
+	_, res := test.ListAppsAppsOK(t, ctx, service, listCtrl, nil, nil, 1, 50, nil, nil, nil)
+	if res.Total != 1 || len(res.Apps) != 1 || res.Apps[0].ID != ID {
+		t.Fatalf("Expected a page with the app, got %+v", res)
+	}
+	if res.Page != 1 || res.PageSize != 50 {
+		t.Fatalf("Expected page 1 of size 50, got page %d of size %d", res.Page, res.PageSize)
+	}
+
+	_, res = test.ListAppsAppsOK(t, ctx, service, listCtrl, nil, nil, 2, 50, nil, nil, nil)
+	if res.Total != 1 || len(res.Apps) != 0 {
+		t.Fatalf("Expected an empty second page, got %+v", res)
+	}
+
+	domain := "other.example.com"
+	_, res = test.ListAppsAppsOK(t, ctx, service, listCtrl, &domain, nil, 1, 50, nil, nil, nil)
+	if res.Total != 0 {
+		t.Fatalf("Expected no apps with the domain %s, got %+v", domain, res)
+	}
+}
+
+func TestListAppsAppsBadRequest(t *testing.T) {
+	registeredFrom := "19-09-2017"
+	test.ListAppsAppsBadRequest(t, ctx, service, ctrl, nil, nil, 1, 50, &registeredFrom, nil, nil)
+}
+
+func TestListAppsAppsInternalServerError(t *testing.T) {
+	test.ListAppsAppsInternalServerError(t, ctx, service, ctrl, nil, &errInternalID, 1, 50, nil, nil, nil)
+}
+
+func TestExportAppsAppsOK(t *testing.T) {
+	exportCtrl := NewAppsController(service, db.New(), appsConfig)
+
+	rw := test.ExportAppsAppsOK(t, ctx, service, exportCtrl, nil, "csv", nil, nil, nil, nil)
+	if ct := rw.Header().Get("Content-Type"); ct != "text/csv" {
+		t.Fatalf("Expected a CSV file, got %q", ct)
+	}
+	body := rw.(*httptest.ResponseRecorder).Body.String()
+	if !strings.HasPrefix(body, "id,name,owner,domain,status,") || !strings.Contains(body, ID) {
+		t.Fatalf("Expected the header and the app in the CSV file, got %q", body)
+	}
+
+	rw = test.ExportAppsAppsOK(t, ctx, service, exportCtrl, nil, "json", nil, nil, nil, nil)
+	var apps []*app.AppsAdmin
+	if err := json.Unmarshal(rw.(*httptest.ResponseRecorder).Body.Bytes(), &apps); err != nil {
+		t.Fatal(err)
+	}
+	if len(apps) != 1 || apps[0].ID != ID {
+		t.Fatalf("Expected the app in the JSON file, got %+v", apps)
+	}
+	if strings.Contains(rw.(*httptest.ResponseRecorder).Body.String(), "secret") {
+		t.Fatal("Expected no secret in the export")
+	}
+}
+
+func TestExportAppsAppsInternalServerError(t *testing.T) {
+	test.ExportAppsAppsInternalServerError(t, ctx, service, ctrl, nil, "csv", &errInternalID, nil, nil, nil)
+}
+
+func newBulkController() *AppsController {
+	store := db.New()
+	bulkCtrl := NewAppsController(service, store, appsConfig)
+	bulkCtrl.Bulk = bulk.New(store, 10)
+	bulkCtrl.Idempotency = idempotency.NewMemoryStore(time.Hour)
+	return bulkCtrl
+}
+
+func TestBulkAppsAppsOK(t *testing.T) {
+	dryRun := true
+	payload := &app.BulkPayload{Operation: "suspend", Ids: []string{ID, "unknown"}, DryRun: &dryRun}
+	_, res := test.BulkAppsAppsOK(t, ctx, service, newBulkController(), nil, payload)
+
+	if !res.DryRun || res.Total != 2 || res.Succeeded != 1 || res.Failed != 1 {
+		t.Fatalf("Expected one planned and one failed app, got %+v", res)
+	}
+}
+
+func TestBulkAppsAppsIdempotencyKey(t *testing.T) {
+	bulkCtrl := newBulkController()
+	key := "incident-42"
+	payload := &app.BulkPayload{Operation: "rotate-secret", Ids: []string{ID}}
+
+	rw, res := test.BulkAppsAppsOK(t, ctx, service, bulkCtrl, &key, payload)
+	if res.Succeeded != 1 || rw.Header().Get("Idempotent-Replayed") != "" {
+		t.Fatalf("Expected the secret to be rotated, got %+v", res)
+	}
+
+	rw, replayed := test.BulkAppsAppsOK(t, ctx, service, bulkCtrl, &key, payload)
+	if rw.Header().Get("Idempotent-Replayed") != "true" || replayed.Succeeded != 1 || replayed.Items[0].ID != ID {
+		t.Fatalf("Expected the original result to be replayed, got %+v", replayed)
+	}
+
+	test.BulkAppsAppsConflict(t, ctx, service, bulkCtrl, &key, &app.BulkPayload{Operation: "suspend", Ids: []string{ID}})
+}
+
+func TestBulkAppsAppsBadRequest(t *testing.T) {
+	test.BulkAppsAppsBadRequest(t, ctx, service, newBulkController(), nil, &app.BulkPayload{Operation: "suspend"})
+}
+
+func TestBulkAppsAppsInternalServerError(t *testing.T) {
+	payload := &app.BulkPayload{Operation: "suspend", Filter: &app.AppFilterPayload{Owner: &errInternalID}}
+	test.BulkAppsAppsInternalServerError(t, ctx, service, newBulkController(), nil, payload)
+}
+
+// uploadLogo calls UploadLogo with the data as the "logo" file of a multipart/form-data request and returns
+// the status code and the app from the response. The generated test helpers cannot send a request body for
+// actions without a payload.
+func uploadLogo(t *testing.T, ctx context.Context, logoCtrl *AppsController, appID string, data []byte) (int, *app.Apps) {
+	var resp interface{}
+	var respSetter goatest.ResponseSetterFunc = func(r interface{}) { resp = r }
+	service.Encoder = goa.NewHTTPEncoder()
+	service.Encoder.Register(func(io.Writer) goa.Encoder { return respSetter }, "*/*")
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("logo", "logo.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(data)
+	writer.Close()
+
+	req := httptest.NewRequest("PUT", "/apps/"+appID+"/logo", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rw := httptest.NewRecorder()
+	goaCtx := goa.NewContext(goa.WithAction(ctx, "AppsTest"), rw, req, url.Values{"appId": []string{appID}})
+	uploadCtx, err := app.NewUploadLogoAppsContext(goaCtx, req, service)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := logoCtrl.UploadLogo(uploadCtx); err != nil {
+		t.Fatal(err)
+	}
+	res, _ := resp.(*app.Apps)
+	return rw.Code, res
+}
+
+func newLogoController(t *testing.T) (*AppsController, func()) {
+	dir, err := ioutil.TempDir("", "logos")
+	if err != nil {
+		t.Fatal(err)
+	}
+	logoConfig := *appsConfig
+	logoConfig.Logos = LogosConfig{MaxBytes: 64 * 1024, MinSize: 16, MaxSize: 512, ThumbnailSize: 32, CacheMaxAge: Duration(time.Hour)}
+	logoCtrl := NewAppsController(service, db.New(), &logoConfig)
+	logoCtrl.Logos = &blob.FileStore{Dir: dir}
+	return logoCtrl, func() { os.RemoveAll(dir) }
+}
+
+func logoPNG(t *testing.T, size int) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, size, size))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestUploadLogoAppsOK(t *testing.T) {
+	logoCtrl, cleanup := newLogoController(t)
+	defer cleanup()
+	ownerCtx := auth.SetAuth(ctx, &auth.Auth{UserID: ownerID})
+
+	code, res := uploadLogo(t, ownerCtx, logoCtrl, ID, logoPNG(t, 64))
+	if code != 200 {
+		t.Fatalf("Expected 200, got %d", code)
+	}
+	if res == nil || res.LogoURL == nil || res.LogoThumbnailURL == nil {
+		t.Fatal("Expected links to the logo")
+	}
+
+	logoRw := test.GetLogoAppsOK(t, ownerCtx, service, logoCtrl, ID, "thumbnail", nil)
+	if logoRw.Header().Get("Content-Type") != "image/png" || logoRw.Header().Get("Cache-Control") != "public, max-age=3600" {
+		t.Errorf("Invalid headers: %v", logoRw.Header())
+	}
+
+	etag := logoRw.Header().Get("ETag")
+	test.GetLogoAppsNotModified(t, ownerCtx, service, logoCtrl, ID, "thumbnail", &etag)
+
+	test.DeleteLogoAppsOK(t, ownerCtx, service, logoCtrl, ID)
+	test.GetLogoAppsNotFound(t, ownerCtx, service, logoCtrl, ID, "original", nil)
+}
+
+func TestUploadLogoAppsBadRequest(t *testing.T) {
+	logoCtrl, cleanup := newLogoController(t)
+	defer cleanup()
+	ownerCtx := auth.SetAuth(ctx, &auth.Auth{UserID: ownerID})
+
+	if code, _ := uploadLogo(t, ownerCtx, logoCtrl, ID, []byte("<svg></svg>")); code != 400 {
+		t.Errorf("Expected 400 for an unsupported type, got %d", code)
+	}
+	if code, _ := uploadLogo(t, ownerCtx, logoCtrl, ID, logoPNG(t, 8)); code != 400 {
+		t.Errorf("Expected 400 for a too small logo, got %d", code)
+	}
+	test.UploadLogoAppsBadRequest(t, ownerCtx, service, logoCtrl, ID)
+}
+
+func TestUploadLogoAppsRequestEntityTooLarge(t *testing.T) {
+	logoCtrl, cleanup := newLogoController(t)
+	defer cleanup()
+	ownerCtx := auth.SetAuth(ctx, &auth.Auth{UserID: ownerID})
+
+	if code, _ := uploadLogo(t, ownerCtx, logoCtrl, ID, make([]byte, 65*1024)); code != 413 {
+		t.Errorf("Expected 413, got %d", code)
+	}
+}
+
+func TestUploadLogoAppsNotFound(t *testing.T) {
+	logoCtrl, cleanup := newLogoController(t)
+	defer cleanup()
+	ownerCtx := auth.SetAuth(ctx, &auth.Auth{UserID: ownerID})
+
+	test.UploadLogoAppsNotFound(t, ownerCtx, service, logoCtrl, notFoundID)
+}
+
+func TestUploadLogoAppsInternalServerError(t *testing.T) {
+	test.UploadLogoAppsInternalServerError(t, ctx, service, ctrl, ID)
+}
+
+func TestLogoAppsNotOwner(t *testing.T) {
+	logoCtrl, cleanup := newLogoController(t)
+	defer cleanup()
+	ownerCtx := auth.SetAuth(ctx, &auth.Auth{UserID: ownerID})
+	otherCtx := auth.SetAuth(ctx, &auth.Auth{UserID: "other-user"})
+	adminCtx := auth.SetAuth(ctx, &auth.Auth{UserID: "admin-user", Roles: []string{"admin"}})
+
+	if code, _ := uploadLogo(t, otherCtx, logoCtrl, ID, logoPNG(t, 64)); code != 404 {
+		t.Fatalf("Expected 404 for another user, got %d", code)
+	}
+	if code, _ := uploadLogo(t, ownerCtx, logoCtrl, ID, logoPNG(t, 64)); code != 200 {
+		t.Fatalf("Expected 200 for the owner, got %d", code)
+	}
+
+	test.DeleteLogoAppsNotFound(t, otherCtx, service, logoCtrl, ID)
+	test.GetLogoAppsOK(t, otherCtx, service, logoCtrl, ID, "original", nil)
+	test.DeleteLogoAppsOK(t, adminCtx, service, logoCtrl, ID)
+}
+
+func TestGetPublicAppsOK(t *testing.T) {
+	publicConfig := *appsConfig
+	publicConfig.PublicView = PublicViewConfig{CacheMaxAge: Duration(5 * time.Minute)}
+	publicCtrl := NewAppsController(service, db.New(), &publicConfig)
+
+	privacy := "https://example.com/privacy"
+	support := "support@example.com"
+	test.UpdateAppAppsOK(t, ctx, service, publicCtrl, ID, &app.AppPayload{
+		Name:             "app-name",
+		Description:      &desc,
+		Domain:           &domain,
+		PrivacyPolicyURL: &privacy,
+		SupportEmail:     &support,
+		Contacts:         []string{"ada@example.com"},
+	})
+
+	rw, res := test.GetPublicAppsOK(t, ctx, service, publicCtrl, ID)
+	if res.PrivacyPolicyURL == nil || *res.PrivacyPolicyURL != privacy || res.SupportEmail == nil || *res.SupportEmail != support {
+		t.Errorf("Invalid consent screen fields: %+v", res)
+	}
+	if rw.Header().Get("Cache-Control") != "public, max-age=300" {
+		t.Errorf("Invalid Cache-Control header: %q", rw.Header().Get("Cache-Control"))
+	}
+
+	body, err := json.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(body), "owner") || strings.Contains(string(body), "secret") {
+		t.Errorf("The public view must not expose the owner or the secret: %s", body)
+	}
+}
+
+func TestGetPublicAppsNotFound(t *testing.T) {
+	test.GetPublicAppsNotFound(t, ctx, service, ctrl, notFoundID)
+}
+
+func TestGetPublicAppsInternalServerError(t *testing.T) {
+	test.GetPublicAppsInternalServerError(t, ctx, service, ctrl, errInternalID)
+}
+
+func TestUpdateAppAppsBadRequestInvalidConsentInfo(t *testing.T) {
+	privacy := "javascript:alert(1)"
+	test.UpdateAppAppsBadRequest(t, ctx, service, ctrl, ID, &app.AppPayload{
+		Name:             "app-name",
+		Description:      &desc,
+		Domain:           &domain,
+		PrivacyPolicyURL: &privacy,
+	})
+	test.UpdateAppAppsBadRequest(t, ctx, service, ctrl, ID, &app.AppPayload{
+		Name:        "app-name",
+		Description: &desc,
+		Domain:      &domain,
+		Contacts:    []string{"not an email"},
+	})
+}
+
+func TestListingsWithoutSecret(t *testing.T) {
+	ctx = auth.SetAuth(ctx, &auth.Auth{UserID: ID})
+	_, myApps := test.GetMyAppsAppsOK(t, ctx, service, ctrl, nil)
+	_, userApps := test.GetUserAppsAppsOKAdmin(t, ctx, service, ctrl, ID, nil)
+
+	for _, res := range []interface{}{myApps, userApps} {
+		body, err := json.Marshal(res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(body), "secret") {
+			t.Errorf("Expected the apps without the secret, got %s", body)
+		}
+	}
+	if len(userApps) != 1 || userApps[0].Owner == "" {
+		t.Errorf("Expected the admin view of the apps, got %+v", userApps)
+	}
+}
+
+func TestDeleteAppAppsNoContent(t *testing.T) {
+	test.DeleteAppAppsNoContent(t, ctx, service, ctrl, ID)
 }
 
 func TestDeleteAppAppsNotFound(t *testing.T) {
@@ -0,0 +1,101 @@
+// Package blob stores binary objects, like the app logos, by key.
+package blob
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// ErrNotFound is returned when there is no object with the key.
+var ErrNotFound = errors.New("blob not found")
+
+// keyPattern restricts the keys to names that are safe to use as paths.
+var keyPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+(/[A-Za-z0-9_-][A-Za-z0-9_.-]*)*$`)
+
+// Store stores binary objects by key. The keys are slash separated names of letters, digits, '-', '_'
+// and '.', like app-id/logo.png. The first name cannot contain '.' and no name can start with '.'.
+type Store interface {
+	// Put stores the object under the key, replacing the existing one.
+	Put(key string, data []byte) error
+	// Get returns the object stored under the key, or ErrNotFound.
+	Get(key string) ([]byte, error)
+	// Delete deletes the object stored under the key. Deleting a missing object is not an error.
+	Delete(key string) error
+}
+
+// FileStore stores the objects as files in a local directory.
+type FileStore struct {
+	Dir string
+}
+
+// NewFileStore creates a FileStore in the directory. The directory is created if it does not exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FileStore{Dir: dir}, nil
+}
+
+// Put writes the object to a temporary file and renames it, so readers never see a partial object.
+func (s *FileStore) Put(key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get reads the object from its file.
+func (s *FileStore) Get(key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+// Delete removes the file of the object.
+func (s *FileStore) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// path returns the path of the file for the key.
+func (s *FileStore) path(key string) (string, error) {
+	if !keyPattern.MatchString(key) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.Dir, filepath.FromSlash(key)), nil
+}
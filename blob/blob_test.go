@@ -0,0 +1,51 @@
+package blob
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blob-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileStore(dir + "/logos")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Put("app-1/logo.png", []byte("first")); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("app-1/logo.png", []byte("second")); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := store.Get("app-1/logo.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "second" {
+		t.Fatalf("Expected the replaced object, got %q", data)
+	}
+
+	if err := store.Delete("app-1/logo.png"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get("app-1/logo.png"); err != ErrNotFound {
+		t.Fatalf("Expected ErrNotFound, got %v", err)
+	}
+	if err := store.Delete("app-1/logo.png"); err != nil {
+		t.Fatalf("Expected no error for a missing object, got %v", err)
+	}
+
+	for _, key := range []string{"../outside", "/absolute", "app-1/../../outside", ""} {
+		if err := store.Put(key, []byte("data")); err == nil {
+			t.Errorf("Expected error for key %q", key)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+// Package bulk applies an operation to many apps at once, selected by their IDs or by a filter,
+// and reports the outcome for every app.
+package bulk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Microkubes/backends"
+	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/Microkubes/microservice-apps-management/db"
+	"github.com/Microkubes/microservice-apps-management/labels"
+)
+
+// Operations that can be applied to many apps at once.
+const (
+	// OperationSuspend suspends the apps, so they can no longer be verified.
+	OperationSuspend = "suspend"
+	// OperationReactivate makes the apps active again.
+	OperationReactivate = "reactivate"
+	// OperationDelete soft-deletes the apps. They can be reactivated by selecting them with a filter by status.
+	OperationDelete = "delete"
+	// OperationRotateSecret replaces the secrets of the apps. The new secrets are not returned.
+	OperationRotateSecret = "rotate-secret"
+	// OperationSetLabels adds, changes and removes labels of the apps.
+	OperationSetLabels = "set-labels"
+)
+
+// Outcomes of an operation for a single app.
+const (
+	// ResultApplied means the app has been changed.
+	ResultApplied = "applied"
+	// ResultPlanned means the app would be changed, but it is a dry run.
+	ResultPlanned = "planned"
+	// ResultUnchanged means the app already is in the requested state.
+	ResultUnchanged = "unchanged"
+	// ResultFailed means the app could not be found or changed.
+	ResultFailed = "failed"
+)
+
+// Store gives access to the apps. Implemented by db.AppsManagementStore.
+type Store interface {
+	GetClientApp(ctx context.Context, appID string) (*db.ClientApp, error)
+	ListApps(ctx context.Context, filter *db.AppFilter, page *db.Page) (*db.AppPage, error)
+	SetAppStatus(ctx context.Context, appID, status string) error
+	RegenerateSecret(ctx context.Context, appID string) (*db.ClientApp, error)
+	SetAppLabels(ctx context.Context, appID string, set map[string]string, remove []string) (*db.ClientApp, error)
+}
+
+// Error is returned when the bulk request is not acceptable, like when it selects too many apps.
+type Error struct {
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func invalid(format string, args ...interface{}) error {
+	return &Error{Message: fmt.Sprintf(format, args...)}
+}
+
+// Request is an operation applied to the apps selected by their IDs or by a filter.
+type Request struct {
+	Operation string
+	// IDs selects the apps by their IDs. Exactly one of IDs and Filter must be set.
+	IDs []string
+	// Filter selects the apps that satisfy it.
+	Filter *db.AppFilter
+	// Labels are added or changed by the set-labels operation.
+	Labels map[string]string
+	// RemoveLabels are the keys of the labels removed by the set-labels operation.
+	RemoveLabels []string
+	// DryRun only reports what the operation would do.
+	DryRun bool
+}
+
+// Item is the outcome of the operation for a single app.
+type Item struct {
+	AppID  string
+	Result string
+	Status string
+	Error  string
+}
+
+// ToMedia converts the item to its media type representation.
+func (i *Item) ToMedia() *app.BulkItem {
+	item := &app.BulkItem{
+		ID:     i.AppID,
+		Result: i.Result,
+	}
+	if i.Status != "" {
+		item.Status = &i.Status
+	}
+	if i.Error != "" {
+		item.Error = &i.Error
+	}
+	return item
+}
+
+// Result holds the outcome of a bulk operation for all selected apps.
+type Result struct {
+	Operation string
+	DryRun    bool
+	Items     []*Item
+}
+
+// Count returns the number of items with the given outcome.
+func (r *Result) Count(result string) int {
+	count := 0
+	for _, item := range r.Items {
+		if item.Result == result {
+			count++
+		}
+	}
+	return count
+}
+
+// ToMedia converts the result to its media type representation.
+func (r *Result) ToMedia() *app.BulkResult {
+	items := []*app.BulkItem{}
+	for _, item := range r.Items {
+		items = append(items, item.ToMedia())
+	}
+	return &app.BulkResult{
+		Operation: r.Operation,
+		DryRun:    r.DryRun,
+		Total:     len(r.Items),
+		Succeeded: r.Count(ResultApplied) + r.Count(ResultPlanned),
+		Failed:    r.Count(ResultFailed),
+		Items:     items,
+	}
+}
+
+// Executor runs the bulk operations.
+type Executor struct {
+	store   Store
+	maxApps int
+}
+
+// New creates a new Executor for the apps in the store. A single request may select at most maxApps apps.
+func New(store Store, maxApps int) *Executor {
+	return &Executor{
+		store:   store,
+		maxApps: maxApps,
+	}
+}
+
+// Run applies the operation to the selected apps and returns the outcome for every app. The
+// operation continues after a failure, so a failed app does not stop the other apps from being
+// changed. Returns an *Error if the request is not acceptable, and the error of the store if the
+// apps cannot be selected. The apps that are not changed yet when the context is canceled fail.
+func (e *Executor) Run(ctx context.Context, req *Request) (*Result, error) {
+	if err := e.validate(req); err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Operation: req.Operation,
+		DryRun:    req.DryRun,
+		Items:     []*Item{},
+	}
+
+	clientApps, failed, err := e.selectApps(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	result.Items = append(result.Items, failed...)
+
+	for _, clientApp := range clientApps {
+		result.Items = append(result.Items, e.apply(ctx, req, clientApp))
+	}
+
+	return result, nil
+}
+
+// validate checks the request before any app is selected.
+func (e *Executor) validate(req *Request) error {
+	switch req.Operation {
+	case OperationSuspend, OperationReactivate, OperationDelete, OperationRotateSecret:
+		if len(req.Labels) > 0 || len(req.RemoveLabels) > 0 {
+			return invalid("labels can only be changed by the %s operation", OperationSetLabels)
+		}
+	case OperationSetLabels:
+		if len(req.Labels) == 0 && len(req.RemoveLabels) == 0 {
+			return invalid("the %s operation needs labels to set or to remove", OperationSetLabels)
+		}
+		if err := labels.Validate(req.Labels); err != nil {
+			return invalid("%s", err)
+		}
+		for _, key := range req.RemoveLabels {
+			if err := labels.ValidateKey(key); err != nil {
+				return invalid("%s", err)
+			}
+		}
+	default:
+		return invalid("unknown operation %q", req.Operation)
+	}
+
+	if (len(req.IDs) == 0) == (req.Filter == nil) {
+		return invalid("select the apps either by their IDs or by a filter")
+	}
+	if len(req.IDs) > e.maxApps {
+		return invalid("%d apps selected, at most %d apps can be changed at once", len(req.IDs), e.maxApps)
+	}
+	return nil
+}
+
+// selectApps returns the selected apps, and a failed item for every ID that cannot be found.
+func (e *Executor) selectApps(ctx context.Context, req *Request) ([]*db.ClientApp, []*Item, error) {
+	if req.Filter != nil {
+		// a single app over the limit is enough to reject the request, so no more apps are read
+		page, err := e.store.ListApps(ctx, req.Filter, &db.Page{Number: 1, Size: e.maxApps + 1})
+		if err != nil {
+			return nil, nil, err
+		}
+		if page.Total > e.maxApps {
+			return nil, nil, invalid("the filter selects %d apps, at most %d apps can be changed at once", page.Total, e.maxApps)
+		}
+		return page.Apps, nil, nil
+	}
+
+	clientApps := []*db.ClientApp{}
+	failed := []*Item{}
+	seen := map[string]bool{}
+	for _, appID := range req.IDs {
+		if seen[appID] {
+			continue
+		}
+		seen[appID] = true
+
+		clientApp, err := e.store.GetClientApp(ctx, appID)
+		if err != nil {
+			failed = append(failed, failedItem(appID, err))
+			continue
+		}
+		clientApps = append(clientApps, clientApp)
+	}
+	return clientApps, failed, nil
+}
+
+// apply applies the operation to a single app, unless it is a dry run.
+func (e *Executor) apply(ctx context.Context, req *Request, clientApp *db.ClientApp) *Item {
+	item := &Item{
+		AppID:  clientApp.ID,
+		Status: clientApp.CurrentStatus(),
+	}
+
+	status := targetStatus(req.Operation)
+	if status != "" && status == item.Status {
+		item.Result = ResultUnchanged
+		return item
+	}
+	if req.Operation == OperationSetLabels && labelsUnchanged(clientApp.Labels, req.Labels, req.RemoveLabels) {
+		item.Result = ResultUnchanged
+		return item
+	}
+	if status != "" {
+		item.Status = status
+	}
+	if req.DryRun {
+		item.Result = ResultPlanned
+		return item
+	}
+
+	var err error
+	switch req.Operation {
+	case OperationRotateSecret:
+		_, err = e.store.RegenerateSecret(ctx, clientApp.ID)
+	case OperationSetLabels:
+		_, err = e.store.SetAppLabels(ctx, clientApp.ID, req.Labels, req.RemoveLabels)
+	default:
+		err = e.store.SetAppStatus(ctx, clientApp.ID, status)
+	}
+	if err != nil {
+		return failedItem(clientApp.ID, err)
+	}
+
+	item.Result = ResultApplied
+	return item
+}
+
+// targetStatus returns the status that the operation sets, or an empty string if the operation does not change the status.
+func targetStatus(operation string) string {
+	switch operation {
+	case OperationSuspend:
+		return db.StatusSuspended
+	case OperationReactivate:
+		return db.StatusActive
+	case OperationDelete:
+		return db.StatusDeleted
+	}
+	return ""
+}
+
+// labelsUnchanged checks if setting and removing the labels leaves the current labels as they are.
+func labelsUnchanged(current, set map[string]string, remove []string) bool {
+	for _, key := range remove {
+		if _, ok := set[key]; ok {
+			continue
+		}
+		if _, ok := current[key]; ok {
+			return false
+		}
+	}
+	for key, value := range set {
+		if existing, ok := current[key]; !ok || existing != value {
+			return false
+		}
+	}
+	return true
+}
+
+// failedItem returns the item of an app for which the operation failed.
+func failedItem(appID string, err error) *Item {
+	message := err.Error()
+	if backends.IsErrNotFound(err) {
+		message = "app not found"
+	}
+	return &Item{
+		AppID:  appID,
+		Result: ResultFailed,
+		Error:  message,
+	}
+}
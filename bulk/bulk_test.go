@@ -0,0 +1,185 @@
+package bulk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Microkubes/backends"
+	"github.com/Microkubes/microservice-apps-management/db"
+)
+
+type storeMock struct {
+	apps    []*db.ClientApp
+	changed map[string]string
+	listed  int
+}
+
+func (s *storeMock) GetClientApp(ctx context.Context, appID string) (*db.ClientApp, error) {
+	for _, clientApp := range s.apps {
+		if clientApp.ID == appID && clientApp.CurrentStatus() != db.StatusDeleted {
+			return clientApp, nil
+		}
+	}
+	return nil, backends.ErrNotFound("app not found")
+}
+
+func (s *storeMock) ListApps(ctx context.Context, filter *db.AppFilter, page *db.Page) (*db.AppPage, error) {
+	clientApps := []*db.ClientApp{}
+	for _, clientApp := range s.apps {
+		if filter.Matches(clientApp) {
+			clientApps = append(clientApps, clientApp)
+		}
+	}
+	total := len(clientApps)
+	if page != nil && page.Size > 0 && len(clientApps) > page.Size {
+		clientApps = clientApps[:page.Size]
+	}
+	s.listed += len(clientApps)
+	return &db.AppPage{Apps: clientApps, Total: total}, nil
+}
+
+func (s *storeMock) SetAppStatus(ctx context.Context, appID, status string) error {
+	if appID == "broken" {
+		return backends.ErrBackendError("backend error")
+	}
+	s.changed[appID] = status
+	return nil
+}
+
+func (s *storeMock) RegenerateSecret(ctx context.Context, appID string) (*db.ClientApp, error) {
+	s.changed[appID] = "secret"
+	return s.GetClientApp(ctx, appID)
+}
+
+func (s *storeMock) SetAppLabels(ctx context.Context, appID string, set map[string]string, remove []string) (*db.ClientApp, error) {
+	s.changed[appID] = "labels"
+	return s.GetClientApp(ctx, appID)
+}
+
+func newStore() *storeMock {
+	return &storeMock{
+		apps: []*db.ClientApp{
+			{ID: "active", Owner: "owner-1", Labels: map[string]string{"team": "payments"}},
+			{ID: "suspended", Owner: "owner-1", Status: db.StatusSuspended},
+			{ID: "broken", Owner: "owner-2"},
+			{ID: "deleted", Owner: "owner-1", Status: db.StatusDeleted},
+		},
+		changed: map[string]string{},
+	}
+}
+
+func itemsByID(result *Result) map[string]*Item {
+	items := map[string]*Item{}
+	for _, item := range result.Items {
+		items[item.AppID] = item
+	}
+	return items
+}
+
+func TestRunByIDs(t *testing.T) {
+	store := newStore()
+	result, err := New(store, 10).Run(context.Background(), &Request{
+		Operation: OperationSuspend,
+		IDs:       []string{"active", "suspended", "broken", "unknown", "active"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := itemsByID(result)
+	if len(result.Items) != 4 {
+		t.Fatalf("Expected an item for every distinct ID, got %d", len(result.Items))
+	}
+	if items["active"].Result != ResultApplied || items["active"].Status != db.StatusSuspended {
+		t.Errorf("Expected the active app to be suspended, got %+v", items["active"])
+	}
+	if items["suspended"].Result != ResultUnchanged {
+		t.Errorf("Expected the suspended app to be unchanged, got %+v", items["suspended"])
+	}
+	if items["broken"].Result != ResultFailed || items["unknown"].Result != ResultFailed || items["unknown"].Error != "app not found" {
+		t.Errorf("Expected the broken and the unknown app to fail, got %+v and %+v", items["broken"], items["unknown"])
+	}
+	if len(store.changed) != 1 || store.changed["active"] != db.StatusSuspended {
+		t.Errorf("Expected only the active app to be changed, got %v", store.changed)
+	}
+
+	media := result.ToMedia()
+	if media.Total != 4 || media.Succeeded != 1 || media.Failed != 2 {
+		t.Errorf("Invalid counts: %+v", media)
+	}
+}
+
+func TestRunByFilterDryRun(t *testing.T) {
+	store := newStore()
+	result, err := New(store, 10).Run(context.Background(), &Request{
+		Operation: OperationReactivate,
+		Filter:    &db.AppFilter{Status: db.StatusDeleted},
+		DryRun:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Items) != 1 || result.Items[0].AppID != "deleted" || result.Items[0].Result != ResultPlanned {
+		t.Fatalf("Expected the deleted app to be planned for reactivation, got %+v", result.Items)
+	}
+	if len(store.changed) != 0 {
+		t.Errorf("Expected no changes in a dry run, got %v", store.changed)
+	}
+}
+
+func TestRunSetLabels(t *testing.T) {
+	store := newStore()
+	result, err := New(store, 10).Run(context.Background(), &Request{
+		Operation: OperationSetLabels,
+		IDs:       []string{"active", "suspended"},
+		Labels:    map[string]string{"team": "payments"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := itemsByID(result)
+	if items["active"].Result != ResultUnchanged || items["suspended"].Result != ResultApplied {
+		t.Fatalf("Expected only the app without the label to change, got %+v and %+v", items["active"], items["suspended"])
+	}
+}
+
+func TestRunInvalid(t *testing.T) {
+	requests := map[string]*Request{
+		"unknown operation":       {Operation: "archive", IDs: []string{"active"}},
+		"no apps selected":        {Operation: OperationSuspend},
+		"IDs and filter":          {Operation: OperationSuspend, IDs: []string{"active"}, Filter: &db.AppFilter{}},
+		"labels for suspend":      {Operation: OperationSuspend, IDs: []string{"active"}, Labels: map[string]string{"a": "b"}},
+		"no labels":               {Operation: OperationSetLabels, IDs: []string{"active"}},
+		"invalid label":           {Operation: OperationSetLabels, IDs: []string{"active"}, Labels: map[string]string{"-a": "b"}},
+		"too many IDs":            {Operation: OperationSuspend, IDs: []string{"a", "b", "c"}},
+		"filter selects too many": {Operation: OperationSuspend, Filter: &db.AppFilter{}},
+	}
+
+	for name, req := range requests {
+		if _, err := New(newStore(), 2).Run(context.Background(), req); err == nil {
+			t.Errorf("%s: expected an error", name)
+		} else if _, ok := err.(*Error); !ok {
+			t.Errorf("%s: expected a *bulk.Error, got %v", name, err)
+		}
+	}
+}
+
+func TestRunByFilterTooManyApps(t *testing.T) {
+	store := newStore()
+	for _, appID := range []string{"a", "b", "c", "d", "e"} {
+		store.apps = append(store.apps, &db.ClientApp{ID: appID, Owner: "owner-3"})
+	}
+
+	_, err := New(store, 2).Run(context.Background(), &Request{Operation: OperationSuspend, Filter: &db.AppFilter{}})
+	if _, ok := err.(*Error); !ok {
+		t.Fatalf("Expected a *bulk.Error, got %v", err)
+	}
+	if store.listed != 3 {
+		t.Errorf("Expected only one app over the limit to be read, got %d apps", store.listed)
+	}
+	if len(store.changed) != 0 {
+		t.Errorf("Expected no app to be changed, got %v", store.changed)
+	}
+}
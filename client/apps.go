@@ -16,56 +16,504 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
+// BulkAppsAppsPath computes a request path to the bulkApps action of apps.
+func BulkAppsAppsPath() string {
+
+	return fmt.Sprintf("/apps/bulk")
+}
+
+// Apply an operation to many apps at once, selected by their IDs or by a filter. Admin only.
+func (c *Client) BulkAppsApps(ctx context.Context, path string, payload *BulkPayload, idempotencyKey *string, contentType string) (*http.Response, error) {
+	req, err := c.NewBulkAppsAppsRequest(ctx, path, payload, idempotencyKey, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewBulkAppsAppsRequest create the request corresponding to the bulkApps action endpoint of the apps resource.
+func (c *Client) NewBulkAppsAppsRequest(ctx context.Context, path string, payload *BulkPayload, idempotencyKey *string, contentType string) (*http.Request, error) {
+	var body bytes.Buffer
+	if contentType == "" {
+		contentType = "*/*" // Use default encoder
+	}
+	err := c.Encoder.Encode(payload, &body, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode body: %s", err)
+	}
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("POST", u.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	header := req.Header
+	if contentType == "*/*" {
+		header.Set("Content-Type", "application/json")
+	} else {
+		header.Set("Content-Type", contentType)
+	}
+	if idempotencyKey != nil {
+
+		header.Set("Idempotency-Key", *idempotencyKey)
+	}
+	return req, nil
+}
+
+// CheckDomainVerificationAppsPath computes a request path to the checkDomainVerification action of apps.
+func CheckDomainVerificationAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/domain-verification", param0)
+}
+
+// Check the published verification token and mark the app domain as verified
+func (c *Client) CheckDomainVerificationApps(ctx context.Context, path string, method *string) (*http.Response, error) {
+	req, err := c.NewCheckDomainVerificationAppsRequest(ctx, path, method)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewCheckDomainVerificationAppsRequest create the request corresponding to the checkDomainVerification action endpoint of the apps resource.
+func (c *Client) NewCheckDomainVerificationAppsRequest(ctx context.Context, path string, method *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if method != nil {
+		values.Set("method", *method)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// CheckOriginAppsPath computes a request path to the checkOrigin action of apps.
+func CheckOriginAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/origins/check", param0)
+}
+
+// Check if an origin is allowed for an app, for CORS decisions
+func (c *Client) CheckOriginApps(ctx context.Context, path string, origin string) (*http.Response, error) {
+	req, err := c.NewCheckOriginAppsRequest(ctx, path, origin)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewCheckOriginAppsRequest create the request corresponding to the checkOrigin action endpoint of the apps resource.
+func (c *Client) NewCheckOriginAppsRequest(ctx context.Context, path string, origin string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	values.Set("origin", origin)
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
 // DeleteAppAppsPath computes a request path to the deleteApp action of apps.
 func DeleteAppAppsPath(appID string) string {
 	param0 := appID
 
-	return fmt.Sprintf("/apps/%s", param0)
+	return fmt.Sprintf("/apps/%s", param0)
+}
+
+// Delete an app
+func (c *Client) DeleteAppApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewDeleteAppAppsRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewDeleteAppAppsRequest create the request corresponding to the deleteApp action endpoint of the apps resource.
+func (c *Client) NewDeleteAppAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DeleteLogoAppsPath computes a request path to the deleteLogo action of apps.
+func DeleteLogoAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/logo", param0)
+}
+
+// Delete the logo of an app
+func (c *Client) DeleteLogoApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewDeleteLogoAppsRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewDeleteLogoAppsRequest create the request corresponding to the deleteLogo action endpoint of the apps resource.
+func (c *Client) NewDeleteLogoAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DeleteRateLimitAppsPath computes a request path to the deleteRateLimit action of apps.
+func DeleteRateLimitAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/rate-limit", param0)
+}
+
+// Remove the rate limit and quota policy of an app, so the default policy applies. Admin only.
+func (c *Client) DeleteRateLimitApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewDeleteRateLimitAppsRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewDeleteRateLimitAppsRequest create the request corresponding to the deleteRateLimit action endpoint of the apps resource.
+func (c *Client) NewDeleteRateLimitAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DeleteUserQuotaAppsPath computes a request path to the deleteUserQuota action of apps.
+func DeleteUserQuotaAppsPath(userID string) string {
+	param0 := userID
+
+	return fmt.Sprintf("/apps/users/%s/quota", param0)
+}
+
+// Remove the override of the maximal number of apps for a user, so the default applies. Admin only.
+func (c *Client) DeleteUserQuotaApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewDeleteUserQuotaAppsRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewDeleteUserQuotaAppsRequest create the request corresponding to the deleteUserQuota action endpoint of the apps resource.
+func (c *Client) NewDeleteUserQuotaAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// ExemptFromReapingAppsPath computes a request path to the exemptFromReaping action of apps.
+func ExemptFromReapingAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/reaping-exemption", param0)
+}
+
+// Exempt an app from the reaping job and restore it if it was suspended or deleted. Admin only.
+func (c *Client) ExemptFromReapingApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewExemptFromReapingAppsRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewExemptFromReapingAppsRequest create the request corresponding to the exemptFromReaping action endpoint of the apps resource.
+func (c *Client) NewExemptFromReapingAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// ExportAppsAppsPath computes a request path to the exportApps action of apps.
+func ExportAppsAppsPath() string {
+
+	return fmt.Sprintf("/apps/all/export")
+}
+
+// Export all apps of all users that match the filters as a CSV or a JSON file. Admin only.
+func (c *Client) ExportAppsApps(ctx context.Context, path string, domain *string, format *string, owner *string, registeredFrom *string, registeredTo *string, status *string) (*http.Response, error) {
+	req, err := c.NewExportAppsAppsRequest(ctx, path, domain, format, owner, registeredFrom, registeredTo, status)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewExportAppsAppsRequest create the request corresponding to the exportApps action endpoint of the apps resource.
+func (c *Client) NewExportAppsAppsRequest(ctx context.Context, path string, domain *string, format *string, owner *string, registeredFrom *string, registeredTo *string, status *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if domain != nil {
+		values.Set("domain", *domain)
+	}
+	if format != nil {
+		values.Set("format", *format)
+	}
+	if owner != nil {
+		values.Set("owner", *owner)
+	}
+	if registeredFrom != nil {
+		values.Set("registeredFrom", *registeredFrom)
+	}
+	if registeredTo != nil {
+		values.Set("registeredTo", *registeredTo)
+	}
+	if status != nil {
+		values.Set("status", *status)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// GetAppsPath computes a request path to the get action of apps.
+func GetAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s", param0)
+}
+
+// Get app by id
+func (c *Client) GetApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewGetAppsRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewGetAppsRequest create the request corresponding to the get action endpoint of the apps resource.
+func (c *Client) NewGetAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// GetLogoAppsPath computes a request path to the getLogo action of apps.
+func GetLogoAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/logo", param0)
+}
+
+// Get the logo of an app or its thumbnail
+func (c *Client) GetLogoApps(ctx context.Context, path string, size *string, ifNoneMatch *string) (*http.Response, error) {
+	req, err := c.NewGetLogoAppsRequest(ctx, path, size, ifNoneMatch)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewGetLogoAppsRequest create the request corresponding to the getLogo action endpoint of the apps resource.
+func (c *Client) NewGetLogoAppsRequest(ctx context.Context, path string, size *string, ifNoneMatch *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if size != nil {
+		values.Set("size", *size)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	header := req.Header
+	if ifNoneMatch != nil {
+
+		header.Set("If-None-Match", *ifNoneMatch)
+	}
+	return req, nil
+}
+
+// GetMyAppsAppsPath computes a request path to the getMyApps action of apps.
+func GetMyAppsAppsPath() string {
+
+	return fmt.Sprintf("/apps/my")
+}
+
+// Get all user's apps
+func (c *Client) GetMyAppsApps(ctx context.Context, path string, selector *string) (*http.Response, error) {
+	req, err := c.NewGetMyAppsAppsRequest(ctx, path, selector)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewGetMyAppsAppsRequest create the request corresponding to the getMyApps action endpoint of the apps resource.
+func (c *Client) NewGetMyAppsAppsRequest(ctx context.Context, path string, selector *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if selector != nil {
+		values.Set("selector", *selector)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// GetPublicAppsPath computes a request path to the getPublic action of apps.
+func GetPublicAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/public", param0)
+}
+
+// Get the display-safe fields of an app for consent screens. Does not require authentication.
+func (c *Client) GetPublicApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewGetPublicAppsRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewGetPublicAppsRequest create the request corresponding to the getPublic action endpoint of the apps resource.
+func (c *Client) NewGetPublicAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// GetRateLimitAppsPath computes a request path to the getRateLimit action of apps.
+func GetRateLimitAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/rate-limit", param0)
 }
 
-// Delete an app
-func (c *Client) DeleteAppApps(ctx context.Context, path string) (*http.Response, error) {
-	req, err := c.NewDeleteAppAppsRequest(ctx, path)
+// Get the effective rate limit and quota policy for an app
+func (c *Client) GetRateLimitApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewGetRateLimitAppsRequest(ctx, path)
 	if err != nil {
 		return nil, err
 	}
 	return c.Client.Do(ctx, req)
 }
 
-// NewDeleteAppAppsRequest create the request corresponding to the deleteApp action endpoint of the apps resource.
-func (c *Client) NewDeleteAppAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+// NewGetRateLimitAppsRequest create the request corresponding to the getRateLimit action endpoint of the apps resource.
+func (c *Client) NewGetRateLimitAppsRequest(ctx context.Context, path string) (*http.Request, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "http"
 	}
 	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 	return req, nil
 }
 
-// GetAppsPath computes a request path to the get action of apps.
-func GetAppsPath(appID string) string {
-	param0 := appID
+// GetReapingCandidatesAppsPath computes a request path to the getReapingCandidates action of apps.
+func GetReapingCandidatesAppsPath() string {
 
-	return fmt.Sprintf("/apps/%s", param0)
+	return fmt.Sprintf("/apps/reaping/candidates")
 }
 
-// Get app by id
-func (c *Client) GetApps(ctx context.Context, path string) (*http.Response, error) {
-	req, err := c.NewGetAppsRequest(ctx, path)
+// Get the unused apps and what the next run of the reaping job would do with them. Admin only.
+func (c *Client) GetReapingCandidatesApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewGetReapingCandidatesAppsRequest(ctx, path)
 	if err != nil {
 		return nil, err
 	}
 	return c.Client.Do(ctx, req)
 }
 
-// NewGetAppsRequest create the request corresponding to the get action endpoint of the apps resource.
-func (c *Client) NewGetAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+// NewGetReapingCandidatesAppsRequest create the request corresponding to the getReapingCandidates action endpoint of the apps resource.
+func (c *Client) NewGetReapingCandidatesAppsRequest(ctx context.Context, path string) (*http.Request, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "http"
@@ -78,28 +526,37 @@ func (c *Client) NewGetAppsRequest(ctx context.Context, path string) (*http.Requ
 	return req, nil
 }
 
-// GetMyAppsAppsPath computes a request path to the getMyApps action of apps.
-func GetMyAppsAppsPath() string {
+// GetUsageAppsPath computes a request path to the getUsage action of apps.
+func GetUsageAppsPath(appID string) string {
+	param0 := appID
 
-	return fmt.Sprintf("/apps/my")
+	return fmt.Sprintf("/apps/%s/usage", param0)
 }
 
-// Get all user's apps
-func (c *Client) GetMyAppsApps(ctx context.Context, path string) (*http.Response, error) {
-	req, err := c.NewGetMyAppsAppsRequest(ctx, path)
+// Get the daily verification counts and the last use of an app
+func (c *Client) GetUsageApps(ctx context.Context, path string, from *string, to *string) (*http.Response, error) {
+	req, err := c.NewGetUsageAppsRequest(ctx, path, from, to)
 	if err != nil {
 		return nil, err
 	}
 	return c.Client.Do(ctx, req)
 }
 
-// NewGetMyAppsAppsRequest create the request corresponding to the getMyApps action endpoint of the apps resource.
-func (c *Client) NewGetMyAppsAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+// NewGetUsageAppsRequest create the request corresponding to the getUsage action endpoint of the apps resource.
+func (c *Client) NewGetUsageAppsRequest(ctx context.Context, path string, from *string, to *string) (*http.Request, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "http"
 	}
 	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if from != nil {
+		values.Set("from", *from)
+	}
+	if to != nil {
+		values.Set("to", *to)
+	}
+	u.RawQuery = values.Encode()
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, err
@@ -115,8 +572,8 @@ func GetUserAppsAppsPath(userID string) string {
 }
 
 // Get app by id
-func (c *Client) GetUserAppsApps(ctx context.Context, path string) (*http.Response, error) {
-	req, err := c.NewGetUserAppsAppsRequest(ctx, path)
+func (c *Client) GetUserAppsApps(ctx context.Context, path string, selector *string) (*http.Response, error) {
+	req, err := c.NewGetUserAppsAppsRequest(ctx, path, selector)
 	if err != nil {
 		return nil, err
 	}
@@ -124,12 +581,136 @@ func (c *Client) GetUserAppsApps(ctx context.Context, path string) (*http.Respon
 }
 
 // NewGetUserAppsAppsRequest create the request corresponding to the getUserApps action endpoint of the apps resource.
-func (c *Client) NewGetUserAppsAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+func (c *Client) NewGetUserAppsAppsRequest(ctx context.Context, path string, selector *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if selector != nil {
+		values.Set("selector", *selector)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// GetUserQuotaAppsPath computes a request path to the getUserQuota action of apps.
+func GetUserQuotaAppsPath(userID string) string {
+	param0 := userID
+
+	return fmt.Sprintf("/apps/users/%s/quota", param0)
+}
+
+// Get the maximal and the current number of apps for a user. Admin only.
+func (c *Client) GetUserQuotaApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewGetUserQuotaAppsRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewGetUserQuotaAppsRequest create the request corresponding to the getUserQuota action endpoint of the apps resource.
+func (c *Client) NewGetUserQuotaAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// IssueDomainVerificationAppsPath computes a request path to the issueDomainVerification action of apps.
+func IssueDomainVerificationAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/domain-verification", param0)
+}
+
+// Start the verification of the app domain. Returns the token to publish in a DNS TXT record or in a file under /.well-known/ on the domain.
+func (c *Client) IssueDomainVerificationApps(ctx context.Context, path string, idempotencyKey *string) (*http.Response, error) {
+	req, err := c.NewIssueDomainVerificationAppsRequest(ctx, path, idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewIssueDomainVerificationAppsRequest create the request corresponding to the issueDomainVerification action endpoint of the apps resource.
+func (c *Client) NewIssueDomainVerificationAppsRequest(ctx context.Context, path string, idempotencyKey *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	header := req.Header
+	if idempotencyKey != nil {
+
+		header.Set("Idempotency-Key", *idempotencyKey)
+	}
+	return req, nil
+}
+
+// ListAppsAppsPath computes a request path to the listApps action of apps.
+func ListAppsAppsPath() string {
+
+	return fmt.Sprintf("/apps/all")
+}
+
+// List the apps of all users, ordered by the time of registration. Admin only.
+func (c *Client) ListAppsApps(ctx context.Context, path string, domain *string, owner *string, page *int, pageSize *int, registeredFrom *string, registeredTo *string, status *string) (*http.Response, error) {
+	req, err := c.NewListAppsAppsRequest(ctx, path, domain, owner, page, pageSize, registeredFrom, registeredTo, status)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewListAppsAppsRequest create the request corresponding to the listApps action endpoint of the apps resource.
+func (c *Client) NewListAppsAppsRequest(ctx context.Context, path string, domain *string, owner *string, page *int, pageSize *int, registeredFrom *string, registeredTo *string, status *string) (*http.Request, error) {
 	scheme := c.Scheme
 	if scheme == "" {
 		scheme = "http"
 	}
 	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if domain != nil {
+		values.Set("domain", *domain)
+	}
+	if owner != nil {
+		values.Set("owner", *owner)
+	}
+	if page != nil {
+		tmp1 := strconv.Itoa(*page)
+		values.Set("page", tmp1)
+	}
+	if pageSize != nil {
+		tmp2 := strconv.Itoa(*pageSize)
+		values.Set("pageSize", tmp2)
+	}
+	if registeredFrom != nil {
+		values.Set("registeredFrom", *registeredFrom)
+	}
+	if registeredTo != nil {
+		values.Set("registeredTo", *registeredTo)
+	}
+	if status != nil {
+		values.Set("status", *status)
+	}
+	u.RawQuery = values.Encode()
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
 		return nil, err
@@ -174,8 +755,8 @@ func RegisterAppAppsPath() string {
 }
 
 // Register new app
-func (c *Client) RegisterAppApps(ctx context.Context, path string, payload *AppPayload, contentType string) (*http.Response, error) {
-	req, err := c.NewRegisterAppAppsRequest(ctx, path, payload, contentType)
+func (c *Client) RegisterAppApps(ctx context.Context, path string, payload *AppPayload, idempotencyKey *string, contentType string) (*http.Response, error) {
+	req, err := c.NewRegisterAppAppsRequest(ctx, path, payload, idempotencyKey, contentType)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +764,7 @@ func (c *Client) RegisterAppApps(ctx context.Context, path string, payload *AppP
 }
 
 // NewRegisterAppAppsRequest create the request corresponding to the registerApp action endpoint of the apps resource.
-func (c *Client) NewRegisterAppAppsRequest(ctx context.Context, path string, payload *AppPayload, contentType string) (*http.Request, error) {
+func (c *Client) NewRegisterAppAppsRequest(ctx context.Context, path string, payload *AppPayload, idempotencyKey *string, contentType string) (*http.Request, error) {
 	var body bytes.Buffer
 	if contentType == "" {
 		contentType = "*/*" // Use default encoder
@@ -207,6 +788,178 @@ func (c *Client) NewRegisterAppAppsRequest(ctx context.Context, path string, pay
 	} else {
 		header.Set("Content-Type", contentType)
 	}
+	if idempotencyKey != nil {
+
+		header.Set("Idempotency-Key", *idempotencyKey)
+	}
+	return req, nil
+}
+
+// RemoveReapingExemptionAppsPath computes a request path to the removeReapingExemption action of apps.
+func RemoveReapingExemptionAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/reaping-exemption", param0)
+}
+
+// Remove the reaping exemption of an app. Admin only.
+func (c *Client) RemoveReapingExemptionApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewRemoveReapingExemptionAppsRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewRemoveReapingExemptionAppsRequest create the request corresponding to the removeReapingExemption action endpoint of the apps resource.
+func (c *Client) NewRemoveReapingExemptionAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// SearchAppsAppsPath computes a request path to the searchApps action of apps.
+func SearchAppsAppsPath() string {
+
+	return fmt.Sprintf("/apps/search")
+}
+
+// Search the apps by name, description, domain and labels. Admin only.
+func (c *Client) SearchAppsApps(ctx context.Context, path string, limit *int, owner *string, q *string, registeredFrom *string, registeredTo *string, status *string) (*http.Response, error) {
+	req, err := c.NewSearchAppsAppsRequest(ctx, path, limit, owner, q, registeredFrom, registeredTo, status)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewSearchAppsAppsRequest create the request corresponding to the searchApps action endpoint of the apps resource.
+func (c *Client) NewSearchAppsAppsRequest(ctx context.Context, path string, limit *int, owner *string, q *string, registeredFrom *string, registeredTo *string, status *string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	values := u.Query()
+	if limit != nil {
+		tmp3 := strconv.Itoa(*limit)
+		values.Set("limit", tmp3)
+	}
+	if owner != nil {
+		values.Set("owner", *owner)
+	}
+	if q != nil {
+		values.Set("q", *q)
+	}
+	if registeredFrom != nil {
+		values.Set("registeredFrom", *registeredFrom)
+	}
+	if registeredTo != nil {
+		values.Set("registeredTo", *registeredTo)
+	}
+	if status != nil {
+		values.Set("status", *status)
+	}
+	u.RawQuery = values.Encode()
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// SetRateLimitAppsPath computes a request path to the setRateLimit action of apps.
+func SetRateLimitAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/rate-limit", param0)
+}
+
+// Set the rate limit and quota policy for an app. Admin only.
+func (c *Client) SetRateLimitApps(ctx context.Context, path string, payload *RateLimitPayload, contentType string) (*http.Response, error) {
+	req, err := c.NewSetRateLimitAppsRequest(ctx, path, payload, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewSetRateLimitAppsRequest create the request corresponding to the setRateLimit action endpoint of the apps resource.
+func (c *Client) NewSetRateLimitAppsRequest(ctx context.Context, path string, payload *RateLimitPayload, contentType string) (*http.Request, error) {
+	var body bytes.Buffer
+	if contentType == "" {
+		contentType = "*/*" // Use default encoder
+	}
+	err := c.Encoder.Encode(payload, &body, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode body: %s", err)
+	}
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("PUT", u.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	header := req.Header
+	if contentType == "*/*" {
+		header.Set("Content-Type", "application/json")
+	} else {
+		header.Set("Content-Type", contentType)
+	}
+	return req, nil
+}
+
+// SetUserQuotaAppsPath computes a request path to the setUserQuota action of apps.
+func SetUserQuotaAppsPath(userID string) string {
+	param0 := userID
+
+	return fmt.Sprintf("/apps/users/%s/quota", param0)
+}
+
+// Override the maximal number of apps for a user. Admin only.
+func (c *Client) SetUserQuotaApps(ctx context.Context, path string, payload *AppQuotaPayload, contentType string) (*http.Response, error) {
+	req, err := c.NewSetUserQuotaAppsRequest(ctx, path, payload, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewSetUserQuotaAppsRequest create the request corresponding to the setUserQuota action endpoint of the apps resource.
+func (c *Client) NewSetUserQuotaAppsRequest(ctx context.Context, path string, payload *AppQuotaPayload, contentType string) (*http.Request, error) {
+	var body bytes.Buffer
+	if contentType == "" {
+		contentType = "*/*" // Use default encoder
+	}
+	err := c.Encoder.Encode(payload, &body, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode body: %s", err)
+	}
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("PUT", u.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	header := req.Header
+	if contentType == "*/*" {
+		header.Set("Content-Type", "application/json")
+	} else {
+		header.Set("Content-Type", contentType)
+	}
 	return req, nil
 }
 
@@ -254,6 +1007,36 @@ func (c *Client) NewUpdateAppAppsRequest(ctx context.Context, path string, paylo
 	return req, nil
 }
 
+// UploadLogoAppsPath computes a request path to the uploadLogo action of apps.
+func UploadLogoAppsPath(appID string) string {
+	param0 := appID
+
+	return fmt.Sprintf("/apps/%s/logo", param0)
+}
+
+// Upload the logo of an app as the "logo" file of a multipart/form-data request. PNG, JPEG and GIF images are accepted.
+func (c *Client) UploadLogoApps(ctx context.Context, path string) (*http.Response, error) {
+	req, err := c.NewUploadLogoAppsRequest(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client.Do(ctx, req)
+}
+
+// NewUploadLogoAppsRequest create the request corresponding to the uploadLogo action endpoint of the apps resource.
+func (c *Client) NewUploadLogoAppsRequest(ctx context.Context, path string) (*http.Request, error) {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	u := url.URL{Host: c.Host, Scheme: scheme, Path: path}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
 // VerifyAppAppsPath computes a request path to the verifyApp action of apps.
 func VerifyAppAppsPath() string {
 
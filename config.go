@@ -0,0 +1,611 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Microkubes/microservice-apps-management/db"
+	"github.com/Microkubes/microservice-apps-management/logging"
+	"github.com/Microkubes/microservice-apps-management/logo"
+	"github.com/Microkubes/microservice-apps-management/reaper"
+)
+
+// AppsConfig holds the configuration specific to the apps-management microservice.
+// It is read from the same JSON file as the common microservice configuration.
+type AppsConfig struct {
+	// RateLimits holds the rate limit settings.
+	RateLimits RateLimitsConfig `json:"rateLimits,omitempty"`
+
+	// Usage holds the usage metering settings.
+	Usage UsageConfig `json:"usage,omitempty"`
+
+	// Reaping holds the settings of the job that flags, suspends and deletes the unused apps.
+	Reaping ReapingConfig `json:"reaping,omitempty"`
+
+	// AppQuotas holds the maximal number of apps per user.
+	AppQuotas AppQuotasConfig `json:"appQuotas,omitempty"`
+
+	// DomainVerification holds the settings of the app domain verification.
+	DomainVerification DomainVerificationConfig `json:"domainVerification,omitempty"`
+
+	// Logos holds the settings of the app logos.
+	Logos LogosConfig `json:"logos,omitempty"`
+
+	// PublicView holds the settings of the public view of the apps shown on consent screens.
+	PublicView PublicViewConfig `json:"publicView,omitempty"`
+
+	// Bulk holds the settings of the bulk operations on apps.
+	Bulk BulkConfig `json:"bulk,omitempty"`
+
+	// Idempotency holds the settings of the idempotency keys.
+	Idempotency IdempotencyConfig `json:"idempotency,omitempty"`
+
+	// Metrics holds the settings of the Prometheus metrics.
+	Metrics MetricsConfig `json:"metrics,omitempty"`
+
+	// Tracing holds the settings of the OpenTelemetry tracing.
+	Tracing TracingConfig `json:"tracing,omitempty"`
+
+	// Health holds the settings of the liveness and the readiness probes.
+	Health HealthConfig `json:"health,omitempty"`
+
+	// Shutdown holds the settings of the graceful shutdown.
+	Shutdown ShutdownConfig `json:"shutdown,omitempty"`
+
+	// GatewayRegistration holds the settings of the self-registration on the API gateway.
+	GatewayRegistration GatewayRegistrationConfig `json:"gatewayRegistration,omitempty"`
+
+	// Logging holds the settings of the service log.
+	Logging LoggingConfig `json:"logging,omitempty"`
+
+	// Reload holds the settings of the hot reload of the configuration file.
+	Reload ReloadConfig `json:"reload,omitempty"`
+
+	// Cache holds the settings of the cache of the app credentials used by the verifications.
+	Cache CacheConfig `json:"cache,omitempty"`
+
+	// Store holds the settings of the operations of the store.
+	Store StoreConfig `json:"store,omitempty"`
+}
+
+// StoreConfig holds the settings of the operations of the store.
+type StoreConfig struct {
+	// Timeout is the timeout of the store operations that have no timeout of their own. Defaults to 5 seconds.
+	Timeout Duration `json:"timeout,omitempty"`
+	// Timeouts are the timeouts of single operations, by the name of the method of the store, like "SearchApps".
+	// The operations that read many apps default to 30 seconds, and the searches to 10 seconds.
+	Timeouts map[string]Duration `json:"timeouts,omitempty"`
+}
+
+// OperationTimeouts returns the timeouts of single operations.
+func (c *StoreConfig) OperationTimeouts() map[string]time.Duration {
+	timeouts := map[string]time.Duration{}
+	for operation, timeout := range c.Timeouts {
+		timeouts[operation] = time.Duration(timeout)
+	}
+	return timeouts
+}
+
+// CacheConfig holds the settings of the cache of the app credentials used by the verifications.
+type CacheConfig struct {
+	// Enabled turns on the cache.
+	Enabled bool `json:"enabled"`
+	// MaxEntries is the maximal number of cached apps. The least recently used app is evicted when the cache is full.
+	// Defaults to 10000.
+	MaxEntries int `json:"maxEntries,omitempty"`
+	// TTL is how long an app is cached. Defaults to 30 seconds.
+	TTL Duration `json:"ttl,omitempty"`
+	// NegativeTTL is how long an unknown app ID is cached. Defaults to 5 seconds.
+	NegativeTTL Duration `json:"negativeTTL,omitempty"`
+	// Invalidation holds the settings of sending the invalidations to the other replicas.
+	Invalidation CacheInvalidationConfig `json:"invalidation,omitempty"`
+}
+
+// CacheInvalidationConfig holds the settings of sending the invalidations of the app cache to the other replicas.
+type CacheInvalidationConfig struct {
+	// PeersHost is the DNS name that resolves to the addresses of all replicas, like a headless Kubernetes
+	// service. The invalidations are not sent if not set.
+	PeersHost string `json:"peersHost,omitempty"`
+	// PeersPort is the port of the replicas. Defaults to the port of the service.
+	PeersPort int `json:"peersPort,omitempty"`
+	// Path is the path where the replicas receive the invalidations. Defaults to /internal/cache/invalidate.
+	Path string `json:"path,omitempty"`
+	// Token is the secret shared by the replicas, required with PeersHost.
+	Token string `json:"token,omitempty"`
+	// Timeout is the timeout of sending the invalidations to a replica. Defaults to 2 seconds.
+	Timeout Duration `json:"timeout,omitempty"`
+}
+
+// ReloadConfig holds the settings of the hot reload of the configuration file.
+type ReloadConfig struct {
+	// WatchInterval is how often the configuration file is checked for changes. Defaults to 10 seconds.
+	// The configuration is also reloaded on SIGHUP.
+	WatchInterval Duration `json:"watchInterval,omitempty"`
+}
+
+// LoggingConfig holds the settings of the service log.
+type LoggingConfig struct {
+	// Level is the lowest level of the logged messages: "info" or "error". Defaults to "info".
+	Level string `json:"level,omitempty"`
+	// Format is the format of the log: "json" or "text". Defaults to "json".
+	Format string `json:"format,omitempty"`
+	// RedactFields are the fields redacted from the log, in addition to the secrets, credentials and tokens
+	// that are always redacted.
+	RedactFields []string `json:"redactFields,omitempty"`
+}
+
+// GatewayRegistrationConfig holds the settings of the self-registration on the API gateway.
+type GatewayRegistrationConfig struct {
+	// InitialBackoff is the wait before retrying a failed registration. Defaults to 1 second.
+	InitialBackoff Duration `json:"initialBackoff,omitempty"`
+	// MaxBackoff is the longest wait between retries, which double after every failure. Defaults to 1 minute.
+	MaxBackoff Duration `json:"maxBackoff,omitempty"`
+	// ReconcileInterval is how often the registration is repeated, in case the gateway lost it. Defaults to 5 minutes.
+	ReconcileInterval Duration `json:"reconcileInterval,omitempty"`
+}
+
+// ShutdownConfig holds the settings of the graceful shutdown.
+type ShutdownConfig struct {
+	// Delay is how long the service keeps serving after it is marked as not ready and deregistered, so that
+	// the load balancers stop routing to it before the connections are drained. Defaults to 5 seconds.
+	Delay Duration `json:"delay,omitempty"`
+	// DrainTimeout is how long the in-flight requests may take to finish. Defaults to 20 seconds.
+	DrainTimeout Duration `json:"drainTimeout,omitempty"`
+}
+
+// HealthConfig holds the settings of the liveness and the readiness probes.
+type HealthConfig struct {
+	// LivenessPath is the path of the liveness probe. Defaults to /livez.
+	LivenessPath string `json:"livenessPath,omitempty"`
+	// ReadinessPath is the path of the readiness probe. Defaults to /readyz.
+	ReadinessPath string `json:"readinessPath,omitempty"`
+	// CheckTimeout is the time limit of every readiness check. Defaults to 2 seconds.
+	CheckTimeout Duration `json:"checkTimeout,omitempty"`
+}
+
+// Span exporters of the tracing config.
+const (
+	TracingExporterNone   = "none"
+	TracingExporterStdout = "stdout"
+	TracingExporterOTLP   = "otlp"
+)
+
+// TracingConfig holds the settings of the OpenTelemetry tracing.
+type TracingConfig struct {
+	// Exporter is where the spans are sent: "none", "stdout" or "otlp". Defaults to "none", which turns tracing off.
+	Exporter string `json:"exporter,omitempty"`
+	// Endpoint is the OTLP/HTTP traces endpoint of the collector. Defaults to http://localhost:4318/v1/traces.
+	Endpoint string `json:"endpoint,omitempty"`
+	// ServiceName is the service.name of the spans. Defaults to apps-management.
+	ServiceName string `json:"serviceName,omitempty"`
+	// ExportInterval is how often the spans are exported. Defaults to 5 seconds.
+	ExportInterval Duration `json:"exportInterval,omitempty"`
+	// Timeout is the timeout of an export to the collector. Defaults to 10 seconds.
+	Timeout Duration `json:"timeout,omitempty"`
+	// SampleRatio is the fraction of the new traces that are sampled, greater than 0 and at most 1. The traces
+	// continued from other services follow the decision of the caller. Defaults to 1, which samples every trace.
+	SampleRatio float64 `json:"sampleRatio,omitempty"`
+	// SkipPaths are the paths of the requests that are not traced. Defaults to the health probes, the metrics,
+	// /healthcheck and /version.
+	SkipPaths []string `json:"skipPaths,omitempty"`
+}
+
+// MetricsConfig holds the settings of the Prometheus metrics.
+type MetricsConfig struct {
+	// Enabled turns on collecting and exposing the metrics.
+	Enabled bool `json:"enabled,omitempty"`
+	// Path is the path where the metrics are exposed. Defaults to /metrics.
+	// It must be in the ignorePatterns of the security config, so that Prometheus can scrape it without credentials.
+	Path string `json:"path,omitempty"`
+	// AppsInterval is how often the apps are counted by status. Defaults to 1 minute.
+	AppsInterval Duration `json:"appsInterval,omitempty"`
+}
+
+// BulkConfig holds the settings of the bulk operations on apps.
+type BulkConfig struct {
+	// MaxApps is the maximal number of apps a single bulk request can change. Defaults to 100.
+	MaxApps int `json:"maxApps,omitempty"`
+}
+
+// IdempotencyConfig holds the settings of the idempotency keys.
+type IdempotencyConfig struct {
+	// TTL is how long the result of a request sent with an idempotency key is kept for retries. Defaults to 24 hours.
+	TTL Duration `json:"ttl,omitempty"`
+	// Lease is how long a key stays reserved for a request in progress. The key of a request that has not
+	// completed within the lease, like when an instance crashed, can be used by a retry. Defaults to 1 minute.
+	Lease Duration `json:"lease,omitempty"`
+}
+
+// PublicViewConfig holds the settings of the public view of the apps.
+type PublicViewConfig struct {
+	// CacheMaxAge is how long clients and proxies may cache the public view of an app. Defaults to 5 minutes.
+	CacheMaxAge Duration `json:"cacheMaxAge,omitempty"`
+}
+
+// LogosConfig holds the settings of the app logos.
+type LogosConfig struct {
+	// StorageDir is the directory where the logos are stored. Defaults to "logos".
+	StorageDir string `json:"storageDir,omitempty"`
+	// MaxBytes is the maximal size of an uploaded logo. Defaults to 1 MB.
+	MaxBytes int `json:"maxBytes,omitempty"`
+	// MinSize is the minimal width and height of a logo in pixels. Defaults to 16.
+	MinSize int `json:"minSize,omitempty"`
+	// MaxSize is the maximal width and height of a logo in pixels. Defaults to 2048.
+	MaxSize int `json:"maxSize,omitempty"`
+	// ThumbnailSize is the width and height of the generated thumbnails in pixels. Defaults to 128.
+	ThumbnailSize int `json:"thumbnailSize,omitempty"`
+	// CacheMaxAge is how long clients may cache a logo. Defaults to 24 hours.
+	CacheMaxAge Duration `json:"cacheMaxAge,omitempty"`
+}
+
+// Limits returns the limits for the uploaded logos.
+func (c *LogosConfig) Limits() logo.Limits {
+	return logo.Limits{
+		MaxBytes:      c.MaxBytes,
+		MinSize:       c.MinSize,
+		MaxSize:       c.MaxSize,
+		ThumbnailSize: c.ThumbnailSize,
+	}
+}
+
+// DomainVerificationConfig holds the settings of the app domain verification.
+type DomainVerificationConfig struct {
+	// Timeout is the timeout of a single DNS lookup or HTTP request. Defaults to 10 seconds.
+	Timeout Duration `json:"timeout,omitempty"`
+	// ReverifyEnabled turns on the periodic re-verification of the verified domains.
+	ReverifyEnabled bool `json:"reverifyEnabled"`
+	// ReverifyInterval is how often the job looks for domains to check again. Defaults to 1 hour.
+	ReverifyInterval Duration `json:"reverifyInterval,omitempty"`
+	// ReverifyAfter is how long a successful check stays valid. Defaults to 30 days.
+	ReverifyAfter Duration `json:"reverifyAfter,omitempty"`
+	// MaxFailures is the number of consecutive failed checks after which a domain is no longer verified.
+	// Defaults to 3.
+	MaxFailures int `json:"maxFailures,omitempty"`
+}
+
+// AppQuotasConfig holds the maximal number of apps per user.
+type AppQuotasConfig struct {
+	// DefaultMaxApps is the maximal number of apps a user can register, unless an admin has
+	// set a different number for that user. 0 means no limit.
+	DefaultMaxApps int `json:"defaultMaxApps"`
+}
+
+// RateLimitsConfig holds the rate limit settings.
+type RateLimitsConfig struct {
+	// Default is the policy reported for apps that do not have their own rate limit policy.
+	// If not set, such apps have no limits.
+	Default *db.RateLimitPolicy `json:"default,omitempty"`
+}
+
+// UsageConfig holds the usage metering settings.
+type UsageConfig struct {
+	// FlushInterval is how often the buffered usage is written to the database.
+	// Defaults to 30 seconds.
+	FlushInterval Duration `json:"flushInterval,omitempty"`
+	// TrustedProxies are the IP addresses or CIDR ranges of the proxies in front of the service, like the API
+	// gateway. The client address of a request from a trusted proxy is the last address in X-Forwarded-For that
+	// is not a trusted proxy. X-Forwarded-For is ignored if not set, and the address of the connection is used.
+	TrustedProxies []string `json:"trustedProxies,omitempty"`
+}
+
+// TrustedProxyNets returns the ranges of the trusted proxies. The invalid entries, reported by the validation,
+// are skipped.
+func (c *UsageConfig) TrustedProxyNets() []*net.IPNet {
+	nets := []*net.IPNet{}
+	for _, proxy := range c.TrustedProxies {
+		if ipNet, err := parseIPNet(proxy); err == nil {
+			nets = append(nets, ipNet)
+		}
+	}
+	return nets
+}
+
+// parseIPNet parses a CIDR range or a single IP address, which is a range of one address.
+func parseIPNet(value string) (*net.IPNet, error) {
+	if strings.Contains(value, "/") {
+		_, ipNet, err := net.ParseCIDR(value)
+		return ipNet, err
+	}
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, fmt.Errorf("%q is not an IP address or a CIDR range", value)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)}, nil
+}
+
+// ReapingConfig holds the settings of the job that flags, suspends and deletes the unused apps.
+// The number of days are counted from the last activity of the app. A value of 0 disables that stage.
+type ReapingConfig struct {
+	// Enabled turns on the periodic reaping job.
+	Enabled bool `json:"enabled"`
+	// DryRun only logs the report of every run, without changing the apps.
+	DryRun bool `json:"dryRun"`
+	// Interval is how often the job runs. Defaults to 24 hours.
+	Interval Duration `json:"interval,omitempty"`
+	// FlagAfterDays is the number of days after which an unused app is flagged and its owner notified.
+	FlagAfterDays int `json:"flagAfterDays"`
+	// SuspendAfterDays is the number of days after which an unused app is suspended.
+	SuspendAfterDays int `json:"suspendAfterDays"`
+	// DeleteAfterDays is the number of days after which an unused app is soft-deleted.
+	DeleteAfterDays int `json:"deleteAfterDays"`
+}
+
+// Policy returns the reaping policy for these settings.
+func (c *ReapingConfig) Policy() reaper.Policy {
+	return reaper.Policy{
+		FlagAfterDays:    c.FlagAfterDays,
+		SuspendAfterDays: c.SuspendAfterDays,
+		DeleteAfterDays:  c.DeleteAfterDays,
+		DryRun:           c.DryRun,
+	}
+}
+
+// Duration is a time.Duration that is read from and written to JSON as a string, like "30s".
+type Duration time.Duration
+
+// UnmarshalJSON parses the duration from a JSON string.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// MarshalJSON writes the duration as a JSON string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// LoadAppsConfig loads the apps-management specific configuration from the given JSON file.
+func LoadAppsConfig(confFile string) (*AppsConfig, error) {
+	data, err := ioutil.ReadFile(confFile)
+	if err != nil {
+		return nil, err
+	}
+
+	appsConfig := &AppsConfig{}
+	if err := json.Unmarshal(data, appsConfig); err != nil {
+		return nil, err
+	}
+
+	appsConfig.setDefaults()
+	if problems := appsConfig.validate(); len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+
+	return appsConfig, nil
+}
+
+// setDefaults sets the documented defaults of the settings that are not set.
+func (c *AppsConfig) setDefaults() {
+	if c.Usage.FlushInterval <= 0 {
+		c.Usage.FlushInterval = Duration(30 * time.Second)
+	}
+	if c.Reaping.Interval <= 0 {
+		c.Reaping.Interval = Duration(24 * time.Hour)
+	}
+	if c.DomainVerification.Timeout <= 0 {
+		c.DomainVerification.Timeout = Duration(10 * time.Second)
+	}
+	if c.DomainVerification.ReverifyInterval <= 0 {
+		c.DomainVerification.ReverifyInterval = Duration(time.Hour)
+	}
+	if c.DomainVerification.ReverifyAfter <= 0 {
+		c.DomainVerification.ReverifyAfter = Duration(30 * 24 * time.Hour)
+	}
+	if c.DomainVerification.MaxFailures <= 0 {
+		c.DomainVerification.MaxFailures = 3
+	}
+	if c.Logos.StorageDir == "" {
+		c.Logos.StorageDir = "logos"
+	}
+	if c.Logos.MaxBytes <= 0 {
+		c.Logos.MaxBytes = 1 << 20
+	}
+	if c.Logos.MinSize <= 0 {
+		c.Logos.MinSize = 16
+	}
+	if c.Logos.MaxSize <= 0 {
+		c.Logos.MaxSize = 2048
+	}
+	if c.Logos.ThumbnailSize <= 0 {
+		c.Logos.ThumbnailSize = 128
+	}
+	if c.Logos.CacheMaxAge <= 0 {
+		c.Logos.CacheMaxAge = Duration(24 * time.Hour)
+	}
+	if c.PublicView.CacheMaxAge <= 0 {
+		c.PublicView.CacheMaxAge = Duration(5 * time.Minute)
+	}
+	if c.Bulk.MaxApps <= 0 {
+		c.Bulk.MaxApps = 100
+	}
+	if c.Idempotency.TTL <= 0 {
+		c.Idempotency.TTL = Duration(24 * time.Hour)
+	}
+	if c.Idempotency.Lease <= 0 {
+		c.Idempotency.Lease = Duration(time.Minute)
+	}
+	if c.Metrics.Path == "" {
+		c.Metrics.Path = "/metrics"
+	}
+	if c.Metrics.AppsInterval <= 0 {
+		c.Metrics.AppsInterval = Duration(time.Minute)
+	}
+	if c.Tracing.Exporter == "" {
+		c.Tracing.Exporter = TracingExporterNone
+	}
+	if c.Tracing.Endpoint == "" {
+		c.Tracing.Endpoint = "http://localhost:4318/v1/traces"
+	}
+	if c.Tracing.ServiceName == "" {
+		c.Tracing.ServiceName = "apps-management"
+	}
+	if c.Tracing.ExportInterval <= 0 {
+		c.Tracing.ExportInterval = Duration(5 * time.Second)
+	}
+	if c.Tracing.Timeout <= 0 {
+		c.Tracing.Timeout = Duration(10 * time.Second)
+	}
+	if c.Tracing.SampleRatio == 0 {
+		c.Tracing.SampleRatio = 1
+	}
+	if c.Health.LivenessPath == "" {
+		c.Health.LivenessPath = "/livez"
+	}
+	if c.Health.ReadinessPath == "" {
+		c.Health.ReadinessPath = "/readyz"
+	}
+	if c.Health.CheckTimeout <= 0 {
+		c.Health.CheckTimeout = Duration(2 * time.Second)
+	}
+	if c.Tracing.SkipPaths == nil {
+		c.Tracing.SkipPaths = []string{c.Health.LivenessPath, c.Health.ReadinessPath, c.Metrics.Path, "/healthcheck", "/version"}
+	}
+	if c.Shutdown.Delay <= 0 {
+		c.Shutdown.Delay = Duration(5 * time.Second)
+	}
+	if c.Shutdown.DrainTimeout <= 0 {
+		c.Shutdown.DrainTimeout = Duration(20 * time.Second)
+	}
+	if c.GatewayRegistration.InitialBackoff <= 0 {
+		c.GatewayRegistration.InitialBackoff = Duration(time.Second)
+	}
+	if c.GatewayRegistration.MaxBackoff <= 0 {
+		c.GatewayRegistration.MaxBackoff = Duration(time.Minute)
+	}
+	if c.GatewayRegistration.ReconcileInterval <= 0 {
+		c.GatewayRegistration.ReconcileInterval = Duration(5 * time.Minute)
+	}
+	if c.Logging.Level == "" {
+		c.Logging.Level = logging.LevelInfo
+	}
+	if c.Logging.Format == "" {
+		c.Logging.Format = logging.FormatJSON
+	}
+	if c.Reload.WatchInterval <= 0 {
+		c.Reload.WatchInterval = Duration(10 * time.Second)
+	}
+	if c.Cache.MaxEntries <= 0 {
+		c.Cache.MaxEntries = 10000
+	}
+	if c.Cache.TTL <= 0 {
+		c.Cache.TTL = Duration(30 * time.Second)
+	}
+	if c.Cache.NegativeTTL <= 0 {
+		c.Cache.NegativeTTL = Duration(5 * time.Second)
+	}
+	if c.Cache.Invalidation.Path == "" {
+		c.Cache.Invalidation.Path = "/internal/cache/invalidate"
+	}
+	if c.Cache.Invalidation.Timeout <= 0 {
+		c.Cache.Invalidation.Timeout = Duration(2 * time.Second)
+	}
+	if c.Store.Timeout <= 0 {
+		c.Store.Timeout = Duration(5 * time.Second)
+	}
+	if c.Store.Timeouts == nil {
+		c.Store.Timeouts = map[string]Duration{}
+	}
+	for operation, timeout := range map[string]time.Duration{
+		"SearchApps":         10 * time.Second,
+		"ListApps":           10 * time.Second,
+		"CountAppsByStatus":  30 * time.Second,
+		"GetAppsUnusedSince": 30 * time.Second,
+		"GetAppsToReverify":  30 * time.Second,
+	} {
+		if _, ok := c.Store.Timeouts[operation]; !ok {
+			c.Store.Timeouts[operation] = Duration(timeout)
+		}
+	}
+}
+
+// validate checks the settings and returns a description of every problem.
+func (c *AppsConfig) validate() []string {
+	problems := []string{}
+	switch c.Tracing.Exporter {
+	case TracingExporterNone, TracingExporterStdout, TracingExporterOTLP:
+	default:
+		problems = append(problems, fmt.Sprintf("tracing.exporter: unknown exporter %q", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio <= 0 || c.Tracing.SampleRatio > 1 {
+		problems = append(problems, fmt.Sprintf("tracing.sampleRatio: %v is not greater than 0 and at most 1", c.Tracing.SampleRatio))
+	}
+	if _, err := logging.ParseLevel(c.Logging.Level); err != nil {
+		problems = append(problems, fmt.Sprintf("logging.level: %s", err))
+	}
+	if c.Logging.Format != logging.FormatJSON && c.Logging.Format != logging.FormatText {
+		problems = append(problems, fmt.Sprintf("logging.format: unknown format %q, expected json or text", c.Logging.Format))
+	}
+	for path, value := range map[string]string{
+		"health.livenessPath":     c.Health.LivenessPath,
+		"health.readinessPath":    c.Health.ReadinessPath,
+		"metrics.path":            c.Metrics.Path,
+		"cache.invalidation.path": c.Cache.Invalidation.Path,
+	} {
+		if !strings.HasPrefix(value, "/") {
+			problems = append(problems, fmt.Sprintf("%s: %q must start with /", path, value))
+		}
+	}
+	if policy := c.RateLimits.Default; policy != nil {
+		if policy.RequestsPerSecond < 0 || policy.RequestsPerMinute < 0 || policy.RequestsPerDay < 0 || policy.Burst < 0 || policy.MonthlyQuota < 0 {
+			problems = append(problems, "rateLimits.default: the limits must not be negative")
+		}
+	}
+	for _, proxy := range c.Usage.TrustedProxies {
+		if _, err := parseIPNet(proxy); err != nil {
+			problems = append(problems, fmt.Sprintf("usage.trustedProxies: %q is not an IP address or a CIDR range", proxy))
+		}
+	}
+	if c.AppQuotas.DefaultMaxApps < 0 {
+		problems = append(problems, "appQuotas.defaultMaxApps: must not be negative")
+	}
+	if c.Reaping.FlagAfterDays < 0 || c.Reaping.SuspendAfterDays < 0 || c.Reaping.DeleteAfterDays < 0 {
+		problems = append(problems, "reaping: the number of days must not be negative")
+	}
+	if c.Logos.MinSize > c.Logos.MaxSize {
+		problems = append(problems, fmt.Sprintf("logos.minSize: %d is larger than logos.maxSize %d", c.Logos.MinSize, c.Logos.MaxSize))
+	}
+	if c.Cache.Invalidation.PeersHost != "" && c.Cache.Invalidation.Token == "" {
+		problems = append(problems, "cache.invalidation.token: required to send the invalidations to the peers")
+	}
+	operations := map[string]bool{}
+	for _, operation := range db.StoreOperations {
+		operations[operation] = true
+	}
+	for operation, timeout := range c.Store.Timeouts {
+		if !operations[operation] {
+			problems = append(problems, fmt.Sprintf("store.timeouts.%s: unknown store operation", operation))
+		} else if timeout < 0 {
+			problems = append(problems, fmt.Sprintf("store.timeouts.%s: must not be negative", operation))
+		}
+	}
+	if c.GatewayRegistration.InitialBackoff > c.GatewayRegistration.MaxBackoff {
+		problems = append(problems, "gatewayRegistration.initialBackoff: must not be longer than gatewayRegistration.maxBackoff")
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+// ValidationError lists the problems of an invalid configuration.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n - " + strings.Join(e.Problems, "\n - ")
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoadAppsConfig(t *testing.T) {
+	appsConfig, err := LoadAppsConfig("config.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if appsConfig.RateLimits.Default == nil {
+		t.Fatal("Expected default rate limit policy to be loaded")
+	}
+	if appsConfig.RateLimits.Default.RequestsPerSecond != 10 {
+		t.Errorf("Expected 10 requests per second, got %d", appsConfig.RateLimits.Default.RequestsPerSecond)
+	}
+	if time.Duration(appsConfig.Usage.FlushInterval) != 30*time.Second {
+		t.Errorf("Expected 30s usage flush interval, got %s", time.Duration(appsConfig.Usage.FlushInterval))
+	}
+	if appsConfig.AppQuotas.DefaultMaxApps != 20 {
+		t.Errorf("Expected 20 apps per user, got %d", appsConfig.AppQuotas.DefaultMaxApps)
+	}
+	if time.Duration(appsConfig.DomainVerification.ReverifyAfter) != 720*time.Hour || appsConfig.DomainVerification.MaxFailures != 3 {
+		t.Errorf("Invalid domain verification settings: %+v", appsConfig.DomainVerification)
+	}
+	if !appsConfig.Reaping.DryRun || appsConfig.Reaping.FlagAfterDays != 90 {
+		t.Errorf("Invalid reaping settings: %+v", appsConfig.Reaping)
+	}
+	if appsConfig.Logos.MaxBytes != 1<<20 || appsConfig.Logos.ThumbnailSize != 128 || time.Duration(appsConfig.Logos.CacheMaxAge) != 24*time.Hour {
+		t.Errorf("Invalid logo settings: %+v", appsConfig.Logos)
+	}
+	if time.Duration(appsConfig.PublicView.CacheMaxAge) != 5*time.Minute {
+		t.Errorf("Expected 5m public view cache max age, got %s", time.Duration(appsConfig.PublicView.CacheMaxAge))
+	}
+	if appsConfig.Bulk.MaxApps != 100 || time.Duration(appsConfig.Idempotency.TTL) != 24*time.Hour ||
+		time.Duration(appsConfig.Idempotency.Lease) != time.Minute {
+		t.Errorf("Invalid bulk settings: %+v, %+v", appsConfig.Bulk, appsConfig.Idempotency)
+	}
+	if !appsConfig.Metrics.Enabled || appsConfig.Metrics.Path != "/metrics" || time.Duration(appsConfig.Metrics.AppsInterval) != time.Minute {
+		t.Errorf("Invalid metrics settings: %+v", appsConfig.Metrics)
+	}
+	if appsConfig.Tracing.Exporter != TracingExporterNone || appsConfig.Tracing.Endpoint != "http://otel-collector:4318/v1/traces" ||
+		time.Duration(appsConfig.Tracing.ExportInterval) != 5*time.Second || appsConfig.Tracing.SampleRatio != 0.1 ||
+		len(appsConfig.Tracing.SkipPaths) != 5 {
+		t.Errorf("Invalid tracing settings: %+v", appsConfig.Tracing)
+	}
+	if appsConfig.Health.LivenessPath != "/livez" || appsConfig.Health.ReadinessPath != "/readyz" ||
+		time.Duration(appsConfig.Health.CheckTimeout) != 2*time.Second {
+		t.Errorf("Invalid health settings: %+v", appsConfig.Health)
+	}
+	if time.Duration(appsConfig.Shutdown.Delay) != 5*time.Second || time.Duration(appsConfig.Shutdown.DrainTimeout) != 20*time.Second {
+		t.Errorf("Invalid shutdown settings: %+v", appsConfig.Shutdown)
+	}
+	if time.Duration(appsConfig.GatewayRegistration.MaxBackoff) != time.Minute ||
+		time.Duration(appsConfig.GatewayRegistration.ReconcileInterval) != 5*time.Minute {
+		t.Errorf("Invalid gateway registration settings: %+v", appsConfig.GatewayRegistration)
+	}
+	if appsConfig.Logging.Level != "info" || appsConfig.Logging.Format != "json" || len(appsConfig.Logging.RedactFields) != 1 ||
+		time.Duration(appsConfig.Reload.WatchInterval) != 10*time.Second {
+		t.Errorf("Invalid logging or reload settings: %+v, %+v", appsConfig.Logging, appsConfig.Reload)
+	}
+	if !appsConfig.Cache.Enabled || appsConfig.Cache.MaxEntries != 10000 || time.Duration(appsConfig.Cache.NegativeTTL) != 5*time.Second ||
+		appsConfig.Cache.Invalidation.Path != "/internal/cache/invalidate" {
+		t.Errorf("Invalid cache settings: %+v", appsConfig.Cache)
+	}
+	if timeouts := appsConfig.Store.OperationTimeouts(); time.Duration(appsConfig.Store.Timeout) != 5*time.Second ||
+		timeouts["FindApp"] != 2*time.Second || timeouts["GetAppsToReverify"] != 30*time.Second {
+		t.Errorf("Invalid store settings: %+v", appsConfig.Store)
+	}
+}
+
+func TestLoadAppsConfigMissingFile(t *testing.T) {
+	if _, err := LoadAppsConfig("does-not-exist.json"); err == nil {
+		t.Fatal("Expected error for missing config file")
+	}
+}
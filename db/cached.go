@@ -0,0 +1,346 @@
+package db
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Microkubes/backends"
+	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/Microkubes/microservice-apps-management/idempotency"
+	"github.com/Microkubes/microservice-apps-management/labels"
+)
+
+// Events of the app cache reported to the CacheObserver.
+const (
+	// CacheHit is a lookup of a cached app.
+	CacheHit = "hit"
+	// CacheNegativeHit is a lookup of an app ID that is cached as unknown.
+	CacheNegativeHit = "negative_hit"
+	// CacheMiss is a lookup that read the app from the store.
+	CacheMiss = "miss"
+	// CacheInvalidation is the removal of an app from the cache after a change.
+	CacheInvalidation = "invalidation"
+)
+
+// CacheObserver is called on every event of the cache with the number of cached entries after the event.
+type CacheObserver func(event string, entries int)
+
+// InvalidationPublisher sends the IDs of the changed apps to the other replicas of the service, which
+// call Invalidate on their caches.
+type InvalidationPublisher interface {
+	Publish(appID string) error
+}
+
+// CachedStore is an AppsManagementStore that caches the app credentials read by FindApp, which is on the
+// path of every app verification. The apps are cached for a TTL, and the unknown app IDs for a separate,
+// usually shorter, TTL. When the cache is full, the least recently used app is evicted.
+//
+// Every method that changes an app invalidates it in the cache, and publishes the invalidation to the other
+// replicas. RecordUsage does not, so the last use of a cached app may be stale for up to the TTL.
+type CachedStore struct {
+	// Observer is called on every event of the cache. Optional.
+	Observer CacheObserver
+	// Publisher sends the invalidations to the other replicas. Optional.
+	Publisher InvalidationPublisher
+	// ErrorHandler is called with the errors of publishing the invalidations.
+	ErrorHandler func(err error)
+
+	store       AppsManagementStore
+	maxEntries  int
+	ttl         time.Duration
+	negativeTTL time.Duration
+	now         func() time.Time
+
+	mutex      sync.Mutex
+	entries    map[string]*list.Element
+	recent     *list.List
+	generation uint64
+}
+
+type cacheEntry struct {
+	appID     string
+	clientApp *ClientApp
+	expires   time.Time
+}
+
+// NewCachedStore wraps the store with a cache of up to maxEntries apps. The apps are cached for ttl and
+// the unknown app IDs for negativeTTL.
+func NewCachedStore(store AppsManagementStore, maxEntries int, ttl, negativeTTL time.Duration) *CachedStore {
+	return &CachedStore{
+		store:       store,
+		maxEntries:  maxEntries,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		now:         time.Now,
+		entries:     map[string]*list.Element{},
+		recent:      list.New(),
+	}
+}
+
+// FindApp finds the app by its ID in the cache, reading it from the store on a miss, and checks its secret.
+// Returns a not found error for unknown, soft-deleted and suspended apps, and nil if the secret does not match.
+func (s *CachedStore) FindApp(ctx context.Context, id, secret string) (*ClientApp, error) {
+	clientApp, err := s.lookup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if clientApp == nil {
+		return nil, backends.ErrNotFound("app not found")
+	}
+	found := *clientApp
+	return checkCredentials(&found, secret)
+}
+
+// lookup returns the cached app, or reads it from the store and caches it. Returns nil for unknown apps.
+func (s *CachedStore) lookup(ctx context.Context, appID string) (*ClientApp, error) {
+	s.mutex.Lock()
+	if element, ok := s.entries[appID]; ok {
+		entry := element.Value.(*cacheEntry)
+		if s.now().Before(entry.expires) {
+			s.recent.MoveToFront(element)
+			event := CacheHit
+			if entry.clientApp == nil {
+				event = CacheNegativeHit
+			}
+			s.observe(event)
+			s.mutex.Unlock()
+			return entry.clientApp, nil
+		}
+		s.remove(element)
+	}
+	s.observe(CacheMiss)
+	generation := s.generation
+	s.mutex.Unlock()
+
+	clientApp, err := s.store.GetClientApp(ctx, appID)
+	if err != nil && !backends.IsErrNotFound(err) {
+		return nil, err
+	}
+	ttl := s.ttl
+	if err != nil {
+		clientApp = nil
+		ttl = s.negativeTTL
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	// An app that changed while it was read is not cached, because the read may have returned the previous version.
+	if generation == s.generation && ttl > 0 {
+		s.add(&cacheEntry{appID: appID, clientApp: clientApp, expires: s.now().Add(ttl)})
+	}
+	return clientApp, nil
+}
+
+// add caches the entry, evicting the least recently used entries over the limit. Must be called with the mutex held.
+func (s *CachedStore) add(entry *cacheEntry) {
+	if element, ok := s.entries[entry.appID]; ok {
+		s.remove(element)
+	}
+	s.entries[entry.appID] = s.recent.PushFront(entry)
+	for s.maxEntries > 0 && s.recent.Len() > s.maxEntries {
+		s.remove(s.recent.Back())
+	}
+}
+
+// remove removes the entry from the cache. Must be called with the mutex held.
+func (s *CachedStore) remove(element *list.Element) {
+	s.recent.Remove(element)
+	delete(s.entries, element.Value.(*cacheEntry).appID)
+}
+
+// observe reports the event. Must be called with the mutex held.
+func (s *CachedStore) observe(event string) {
+	if s.Observer != nil {
+		s.Observer(event, s.recent.Len())
+	}
+}
+
+// Invalidate removes the app from the cache. It is called for the invalidations received from the other replicas.
+func (s *CachedStore) Invalidate(appID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.generation++
+	if element, ok := s.entries[appID]; ok {
+		s.remove(element)
+	}
+	s.observe(CacheInvalidation)
+}
+
+// invalidate removes the changed app from the cache and publishes the invalidation to the other replicas.
+func (s *CachedStore) invalidate(appID string) {
+	s.Invalidate(appID)
+	if s.Publisher == nil {
+		return
+	}
+	if err := s.Publisher.Publish(appID); err != nil && s.ErrorHandler != nil {
+		s.ErrorHandler(err)
+	}
+}
+
+// RegisterApp calls RegisterApp on the wrapped store and invalidates the new app, which may be cached as unknown.
+func (s *CachedStore) RegisterApp(ctx context.Context, payload *app.AppPayload, userID string, maxApps int) (*app.RegApps, error) {
+	res, err := s.store.RegisterApp(ctx, payload, userID, maxApps)
+	if err == nil && res != nil {
+		s.invalidate(res.ID)
+	}
+	return res, err
+}
+
+// GetApp calls GetApp on the wrapped store.
+func (s *CachedStore) GetApp(ctx context.Context, appID string) (*app.Apps, error) {
+	return s.store.GetApp(ctx, appID)
+}
+
+// GetMyApps calls GetMyApps on the wrapped store.
+func (s *CachedStore) GetMyApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
+	return s.store.GetMyApps(ctx, userID, selector)
+}
+
+// GetUserApps calls GetUserApps on the wrapped store.
+func (s *CachedStore) GetUserApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
+	return s.store.GetUserApps(ctx, userID, selector)
+}
+
+// DeleteApp calls DeleteApp on the wrapped store and invalidates the cached app.
+func (s *CachedStore) DeleteApp(ctx context.Context, appID string) error {
+	defer s.invalidate(appID)
+	return s.store.DeleteApp(ctx, appID)
+}
+
+// UpdateApp calls UpdateApp on the wrapped store and invalidates the cached app.
+func (s *CachedStore) UpdateApp(ctx context.Context, payload *app.AppPayload, appID string) (*app.Apps, error) {
+	defer s.invalidate(appID)
+	return s.store.UpdateApp(ctx, payload, appID)
+}
+
+// RegenerateSecret calls RegenerateSecret on the wrapped store and invalidates the cached app.
+func (s *CachedStore) RegenerateSecret(ctx context.Context, appID string) (*ClientApp, error) {
+	defer s.invalidate(appID)
+	return s.store.RegenerateSecret(ctx, appID)
+}
+
+// SetRateLimit calls SetRateLimit on the wrapped store and invalidates the cached app.
+func (s *CachedStore) SetRateLimit(ctx context.Context, appID string, payload *app.RateLimitPayload) (*app.RateLimit, error) {
+	defer s.invalidate(appID)
+	return s.store.SetRateLimit(ctx, appID, payload)
+}
+
+// DeleteRateLimit calls DeleteRateLimit on the wrapped store and invalidates the cached app.
+func (s *CachedStore) DeleteRateLimit(ctx context.Context, appID string) error {
+	defer s.invalidate(appID)
+	return s.store.DeleteRateLimit(ctx, appID)
+}
+
+// RecordUsage calls RecordUsage on the wrapped store.
+func (s *CachedStore) RecordUsage(ctx context.Context, record *UsageRecord) error {
+	return s.store.RecordUsage(ctx, record)
+}
+
+// GetAppUsage calls GetAppUsage on the wrapped store.
+func (s *CachedStore) GetAppUsage(ctx context.Context, appID string, from, to time.Time) (*app.AppUsage, error) {
+	return s.store.GetAppUsage(ctx, appID, from, to)
+}
+
+// GetAppsUnusedSince calls GetAppsUnusedSince on the wrapped store.
+func (s *CachedStore) GetAppsUnusedSince(ctx context.Context, since time.Time) ([]*ClientApp, error) {
+	return s.store.GetAppsUnusedSince(ctx, since)
+}
+
+// SetAppStatus calls SetAppStatus on the wrapped store and invalidates the cached app.
+func (s *CachedStore) SetAppStatus(ctx context.Context, appID, status string) error {
+	defer s.invalidate(appID)
+	return s.store.SetAppStatus(ctx, appID, status)
+}
+
+// TransitionAppStatus calls TransitionAppStatus on the wrapped store and invalidates the cached app.
+func (s *CachedStore) TransitionAppStatus(ctx context.Context, appID string, from *StatusVersion, status string) error {
+	defer s.invalidate(appID)
+	return s.store.TransitionAppStatus(ctx, appID, from, status)
+}
+
+// SetReapingExempt calls SetReapingExempt on the wrapped store and invalidates the cached app.
+func (s *CachedStore) SetReapingExempt(ctx context.Context, appID string, exempt bool) (*app.Apps, error) {
+	defer s.invalidate(appID)
+	return s.store.SetReapingExempt(ctx, appID, exempt)
+}
+
+// GetClientApp calls GetClientApp on the wrapped store.
+func (s *CachedStore) GetClientApp(ctx context.Context, appID string) (*ClientApp, error) {
+	return s.store.GetClientApp(ctx, appID)
+}
+
+// IssueVerificationToken calls IssueVerificationToken on the wrapped store and invalidates the cached app.
+func (s *CachedStore) IssueVerificationToken(ctx context.Context, appID string) (*ClientApp, error) {
+	defer s.invalidate(appID)
+	return s.store.IssueVerificationToken(ctx, appID)
+}
+
+// SaveVerificationResult calls SaveVerificationResult on the wrapped store and invalidates the cached app.
+func (s *CachedStore) SaveVerificationResult(ctx context.Context, appID string, result *VerificationResult) (*ClientApp, error) {
+	defer s.invalidate(appID)
+	return s.store.SaveVerificationResult(ctx, appID, result)
+}
+
+// GetAppsToReverify calls GetAppsToReverify on the wrapped store.
+func (s *CachedStore) GetAppsToReverify(ctx context.Context, checkedBefore time.Time) ([]*ClientApp, error) {
+	return s.store.GetAppsToReverify(ctx, checkedBefore)
+}
+
+// GetUserQuota calls GetUserQuota on the wrapped store.
+func (s *CachedStore) GetUserQuota(ctx context.Context, userID string) (*UserQuota, error) {
+	return s.store.GetUserQuota(ctx, userID)
+}
+
+// SetUserQuota calls SetUserQuota on the wrapped store.
+func (s *CachedStore) SetUserQuota(ctx context.Context, userID string, maxApps int) (*UserQuota, error) {
+	return s.store.SetUserQuota(ctx, userID, maxApps)
+}
+
+// DeleteUserQuota calls DeleteUserQuota on the wrapped store.
+func (s *CachedStore) DeleteUserQuota(ctx context.Context, userID string) error {
+	return s.store.DeleteUserQuota(ctx, userID)
+}
+
+// CountUserApps calls CountUserApps on the wrapped store.
+func (s *CachedStore) CountUserApps(ctx context.Context, userID string) (int, error) {
+	return s.store.CountUserApps(ctx, userID)
+}
+
+// SearchApps calls SearchApps on the wrapped store.
+func (s *CachedStore) SearchApps(ctx context.Context, query *SearchQuery) ([]*ClientApp, error) {
+	return s.store.SearchApps(ctx, query)
+}
+
+// ListApps calls ListApps on the wrapped store.
+func (s *CachedStore) ListApps(ctx context.Context, filter *AppFilter, page *Page) (*AppPage, error) {
+	return s.store.ListApps(ctx, filter, page)
+}
+
+// CountAppsByStatus calls CountAppsByStatus on the wrapped store.
+func (s *CachedStore) CountAppsByStatus(ctx context.Context) (map[string]int, error) {
+	return s.store.CountAppsByStatus(ctx)
+}
+
+// SetAppLogo calls SetAppLogo on the wrapped store and invalidates the cached app.
+func (s *CachedStore) SetAppLogo(ctx context.Context, appID string, logo *AppLogo) (*ClientApp, error) {
+	defer s.invalidate(appID)
+	return s.store.SetAppLogo(ctx, appID, logo)
+}
+
+// SetAppLabels calls SetAppLabels on the wrapped store and invalidates the cached app.
+func (s *CachedStore) SetAppLabels(ctx context.Context, appID string, set map[string]string, remove []string) (*ClientApp, error) {
+	defer s.invalidate(appID)
+	return s.store.SetAppLabels(ctx, appID, set, remove)
+}
+
+// IdempotencyStore calls IdempotencyStore on the wrapped store.
+func (s *CachedStore) IdempotencyStore(ttl, lease time.Duration) idempotency.Store {
+	return s.store.IdempotencyStore(ttl, lease)
+}
+
+// Ping calls Ping on the wrapped store.
+func (s *CachedStore) Ping(ctx context.Context) error {
+	return s.store.Ping(ctx)
+}
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Microkubes/backends"
+	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/asaskevich/govalidator"
+)
+
+// validateConsentInfo checks the links and the email addresses shown on the consent screen.
+// Empty values are allowed and remove the field.
+func validateConsentInfo(payload *app.AppPayload) error {
+	if payload.PrivacyPolicyURL != nil {
+		if err := validateLink("privacyPolicyUrl", *payload.PrivacyPolicyURL); err != nil {
+			return err
+		}
+	}
+	if payload.TermsOfServiceURL != nil {
+		if err := validateLink("termsOfServiceUrl", *payload.TermsOfServiceURL); err != nil {
+			return err
+		}
+	}
+	if payload.SupportEmail != nil {
+		if err := validateEmail("supportEmail", *payload.SupportEmail); err != nil {
+			return err
+		}
+	}
+	for _, contact := range payload.Contacts {
+		if strings.TrimSpace(contact) == "" {
+			return backends.ErrInvalidInput("contacts: empty email address")
+		}
+		if err := validateEmail("contacts", contact); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyConsentInfo copies the consent screen fields that are set in the payload to the app, and returns the
+// names of the changed fields in the database.
+func applyConsentInfo(clientApp *ClientApp, payload *app.AppPayload) []string {
+	changed := []string{}
+	if payload.PrivacyPolicyURL != nil {
+		clientApp.PrivacyPolicyURL = strings.TrimSpace(*payload.PrivacyPolicyURL)
+		changed = append(changed, "privacyPolicyUrl")
+	}
+	if payload.TermsOfServiceURL != nil {
+		clientApp.TermsOfServiceURL = strings.TrimSpace(*payload.TermsOfServiceURL)
+		changed = append(changed, "termsOfServiceUrl")
+	}
+	if payload.SupportEmail != nil {
+		clientApp.SupportEmail = strings.TrimSpace(*payload.SupportEmail)
+		changed = append(changed, "supportEmail")
+	}
+	if payload.Contacts != nil {
+		contacts := []string{}
+		for _, contact := range payload.Contacts {
+			contacts = append(contacts, strings.TrimSpace(contact))
+		}
+		clientApp.Contacts = contacts
+		changed = append(changed, "contacts")
+	}
+	return changed
+}
+
+// validateLink checks that the link is an absolute http or https URL without credentials.
+func validateLink(field, link string) error {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return nil
+	}
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil || !govalidator.IsURL(link) {
+		return backends.ErrInvalidInput(fmt.Sprintf("%s: %q is not a valid http or https URL", field, link))
+	}
+	return nil
+}
+
+// validateEmail checks that the value is a valid email address.
+func validateEmail(field, email string) error {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil
+	}
+	if !govalidator.IsEmail(email) {
+		return backends.ErrInvalidInput(fmt.Sprintf("%s: %q is not a valid email address", field, email))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+
+	"github.com/Microkubes/backends"
+)
+
+// contextRepository is a backends.Repository bound to the context of a store operation. The backends do not
+// take a context, so a read that is still running when the context is done is abandoned: its result is dropped
+// and the error of the context is returned, but the goroutine and the query keep running until the backend
+// returns. A write is not started after the context is done, but once started it runs to the end, so that its
+// outcome is never unknown. The operations that must stop at the deadline run on the mongoStore instead.
+type contextRepository struct {
+	ctx        context.Context
+	repository backends.Repository
+}
+
+// withContext binds the repository to the context.
+func withContext(ctx context.Context, repository backends.Repository) backends.Repository {
+	return &contextRepository{
+		ctx:        ctx,
+		repository: repository,
+	}
+}
+
+// GetOne reads one object, unless the context is done first.
+func (r *contextRepository) GetOne(filter backends.Filter, result interface{}) (interface{}, error) {
+	var res interface{}
+	err := read(r.ctx, func() (err error) {
+		res, err = r.repository.GetOne(filter, result)
+		return err
+	})
+	return res, err
+}
+
+// GetAll reads the objects, unless the context is done first.
+func (r *contextRepository) GetAll(filter backends.Filter, resultsTypeHint interface{}, order string, sorting string, limit int, offset int) (interface{}, error) {
+	var res interface{}
+	err := read(r.ctx, func() (err error) {
+		res, err = r.repository.GetAll(filter, resultsTypeHint, order, sorting, limit, offset)
+		return err
+	})
+	return res, err
+}
+
+// Save saves the object if the context is not done.
+func (r *contextRepository) Save(object interface{}, filter backends.Filter) (interface{}, error) {
+	if err := r.ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	return r.repository.Save(object, filter)
+}
+
+// DeleteOne deletes one object if the context is not done.
+func (r *contextRepository) DeleteOne(filter backends.Filter) error {
+	if err := r.ctx.Err(); err != nil {
+		return contextError(err)
+	}
+	return r.repository.DeleteOne(filter)
+}
+
+// DeleteAll deletes the objects if the context is not done.
+func (r *contextRepository) DeleteAll(filter backends.Filter) error {
+	if err := r.ctx.Err(); err != nil {
+		return contextError(err)
+	}
+	return r.repository.DeleteAll(filter)
+}
+
+// read runs the read in the background and waits for it until the context is done. The read itself is not
+// stopped. The contexts that are never done, like context.Background(), run the read directly.
+func read(ctx context.Context, run func() error) error {
+	if err := ctx.Err(); err != nil {
+		return contextError(err)
+	}
+	if ctx.Done() == nil {
+		return run()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- run()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return contextError(ctx.Err())
+	}
+}
+
+// contextError converts the error of a done context to the error of the store operation.
+func contextError(err error) error {
+	if err == context.DeadlineExceeded {
+		return ErrStoreTimeout("the store operation timed out")
+	}
+	return ErrStoreCanceled("the store operation was canceled")
+}
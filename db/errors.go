@@ -0,0 +1,45 @@
+package db
+
+import (
+	"github.com/keitaroinc/goa"
+)
+
+// ErrQuotaExceeded is returned when the owner has already registered the maximal number of apps.
+var ErrQuotaExceeded = goa.NewErrorClass("quota_exceeded", 403)
+
+// IsErrQuotaExceeded checks if the error is an ErrQuotaExceeded error.
+func IsErrQuotaExceeded(err error) bool {
+	return hasErrorCode(err, "quota_exceeded")
+}
+
+// ErrStoreTimeout is returned when a store operation does not finish before its deadline.
+var ErrStoreTimeout = goa.NewErrorClass("store_timeout", 504)
+
+// IsErrStoreTimeout checks if the error is an ErrStoreTimeout error.
+func IsErrStoreTimeout(err error) bool {
+	return hasErrorCode(err, "store_timeout")
+}
+
+// ErrStoreCanceled is returned when the request of a store operation is canceled, like when the client disconnects.
+var ErrStoreCanceled = goa.NewErrorClass("store_canceled", 503)
+
+// IsErrStoreCanceled checks if the error is an ErrStoreCanceled error.
+func IsErrStoreCanceled(err error) bool {
+	return hasErrorCode(err, "store_canceled")
+}
+
+// ErrStatusConflict is returned when the status of an app is not the expected one, because it has been
+// changed in the meantime.
+var ErrStatusConflict = goa.NewErrorClass("status_conflict", 409)
+
+// IsErrStatusConflict checks if the error is an ErrStatusConflict error.
+func IsErrStatusConflict(err error) bool {
+	return hasErrorCode(err, "status_conflict")
+}
+
+func hasErrorCode(err error, code string) bool {
+	if errResp, ok := err.(*goa.ErrorResponse); ok {
+		return errResp.Code == code
+	}
+	return false
+}
@@ -0,0 +1,207 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/Microkubes/backends"
+	"github.com/Microkubes/microservice-apps-management/idempotency"
+	"github.com/Microkubes/microservice-tools/config"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// IdempotencyRecord is a reserved or completed idempotency key. The status is 0 while the request is in progress,
+// and then the record expires at the end of the lease of the request.
+type IdempotencyRecord struct {
+	ID          string    `json:"id,omitempty" bson:"_id,omitempty"`
+	Key         string    `json:"key" bson:"key"`
+	Fingerprint string    `json:"fingerprint" bson:"fingerprint"`
+	Lease       string    `json:"lease" bson:"lease"`
+	Status      int       `json:"status" bson:"status"`
+	Body        []byte    `json:"body,omitempty" bson:"body,omitempty"`
+	ExpiresAt   time.Time `json:"expiresAt" bson:"expiresAt"`
+}
+
+// backendIdempotencyStore keeps the idempotency records in the database, so that a retry gets the original
+// result from any instance of the service.
+type backendIdempotencyStore struct {
+	repository backends.Repository
+	mongo      *mongoStore
+	ttl        time.Duration
+	lease      time.Duration
+	now        func() time.Time
+}
+
+// IdempotencyStore returns the store of the idempotency keys, which keeps every completed record for the given
+// time. A key is reserved for a request in progress for the lease, so that the key of a request that never
+// completed, like when the service crashed, can be used again after the lease.
+func (c *BackendAppsManagementStore) IdempotencyStore(ttl, lease time.Duration) idempotency.Store {
+	return &backendIdempotencyStore{
+		repository: c.idempotencyRepository,
+		mongo:      c.mongo,
+		ttl:        ttl,
+		lease:      lease,
+		now:        time.Now,
+	}
+}
+
+// Begin reserves the key for a request with the fingerprint. A reservation whose lease has expired is taken
+// over. With MongoDB the reservation is only taken over if it has not changed since it was read, so that of
+// concurrent retries only one takes it over.
+func (s *backendIdempotencyStore) Begin(ctx context.Context, key, fingerprint string) (*idempotency.Record, string, error) {
+	now := s.now()
+	existing, err := s.get(ctx, key)
+	if err != nil && !backends.IsErrNotFound(err) {
+		return nil, "", err
+	}
+
+	if existing != nil && now.Before(existing.ExpiresAt) {
+		if existing.Fingerprint != fingerprint {
+			return nil, "", idempotency.ErrMismatch
+		}
+		if existing.Status == 0 {
+			return nil, "", idempotency.ErrInProgress
+		}
+		return &idempotency.Record{Status: existing.Status, Body: existing.Body}, "", nil
+	}
+
+	lease, err := idempotency.NewLease()
+	if err != nil {
+		return nil, "", err
+	}
+	record := &IdempotencyRecord{
+		Key:         key,
+		Fingerprint: fingerprint,
+		Lease:       lease,
+		ExpiresAt:   now.Add(s.lease),
+	}
+	var filter backends.Filter
+	if existing != nil {
+		// the key has expired, or the request that reserved it has not completed within the lease
+		if s.mongo != nil {
+			if err := s.takeOver(ctx, existing, record); err != nil {
+				return nil, "", err
+			}
+			return nil, lease, nil
+		}
+		filter = backends.NewFilter().Match("key", key)
+	}
+	if _, err := withContext(ctx, s.repository).Save(record, filter); err != nil {
+		if backends.IsErrAlreadyExists(err) {
+			// another request reserved the key in the meantime
+			return nil, "", idempotency.ErrInProgress
+		}
+		return nil, "", err
+	}
+
+	return nil, lease, nil
+}
+
+// takeOver replaces the expired record with the reservation, if the record has not changed since it was read.
+func (s *backendIdempotencyStore) takeOver(ctx context.Context, expired, record *IdempotencyRecord) error {
+	err := s.mongo.run(ctx, "apps-idempotency", func(records *mgo.Collection) error {
+		return records.Update(bson.M{
+			"key":       expired.Key,
+			"expiresAt": expired.ExpiresAt,
+		}, bson.M{
+			"$set": bson.M{
+				"fingerprint": record.Fingerprint,
+				"lease":       record.Lease,
+				"status":      0,
+				"expiresAt":   record.ExpiresAt,
+			},
+			"$unset": bson.M{"body": ""},
+		})
+	})
+	if err == mgo.ErrNotFound {
+		// another request took the key over in the meantime
+		return idempotency.ErrInProgress
+	}
+	return err
+}
+
+// Complete saves the result of the request that holds the lease on the key. With MongoDB the record is only
+// updated while the request holds the lease, so that a request whose reservation has been taken over cannot
+// overwrite the result of the request that took it over.
+func (s *backendIdempotencyStore) Complete(ctx context.Context, key, lease string, record *idempotency.Record) error {
+	expiresAt := s.now().Add(s.ttl)
+	if s.mongo != nil {
+		err := s.mongo.run(ctx, "apps-idempotency", func(records *mgo.Collection) error {
+			return records.Update(bson.M{
+				"key":    key,
+				"lease":  lease,
+				"status": 0,
+			}, bson.M{
+				"$set": bson.M{
+					"status":    record.Status,
+					"body":      record.Body,
+					"expiresAt": expiresAt,
+				},
+			})
+		})
+		if err == mgo.ErrNotFound {
+			// another request took the key over, or the reservation expired and was removed
+			return idempotency.ErrInProgress
+		}
+		return err
+	}
+
+	existing, err := s.get(ctx, key)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return idempotency.ErrInProgress
+		}
+		return err
+	}
+	if existing.Lease != lease || existing.Status != 0 {
+		return idempotency.ErrInProgress
+	}
+
+	existing.Status = record.Status
+	existing.Body = record.Body
+	existing.ExpiresAt = expiresAt
+
+	_, err = withContext(ctx, s.repository).Save(existing, backends.NewFilter().Match("key", key))
+	return err
+}
+
+// Release frees a key that has not been completed, if the request still holds the lease.
+func (s *backendIdempotencyStore) Release(ctx context.Context, key, lease string) {
+	if s.mongo != nil {
+		s.mongo.run(ctx, "apps-idempotency", func(records *mgo.Collection) error {
+			return records.Remove(bson.M{"key": key, "lease": lease, "status": 0})
+		})
+		return
+	}
+
+	existing, err := s.get(ctx, key)
+	if err != nil || existing.Lease != lease || existing.Status != 0 {
+		return
+	}
+	withContext(ctx, s.repository).DeleteOne(backends.NewFilter().Match("key", key))
+}
+
+func (s *backendIdempotencyStore) get(ctx context.Context, key string) (*IdempotencyRecord, error) {
+	res, err := withContext(ctx, s.repository).GetOne(backends.NewFilter().Match("key", key), &IdempotencyRecord{})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*IdempotencyRecord), nil
+}
+
+// ensureIdempotencyExpiry creates a TTL index in MongoDB, so that the expired idempotency records are removed.
+// With other backends the expired records stay until their key is reused.
+func ensureIdempotencyExpiry(info *config.DBInfo, collectionName string) error {
+	session, err := dialMongo(info)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	return session.DB(info.DatabaseName).C(collectionName).EnsureIndex(mgo.Index{
+		Key:         []string{"expiresAt"},
+		ExpireAfter: time.Second,
+		Background:  true,
+	})
+}
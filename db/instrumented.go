@@ -0,0 +1,288 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/Microkubes/backends"
+	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/Microkubes/microservice-apps-management/idempotency"
+	"github.com/Microkubes/microservice-apps-management/labels"
+)
+
+// StoreObserver is called after every store operation with the name of the method, its duration
+// and whether it failed.
+type StoreObserver func(method string, duration time.Duration, failed bool)
+
+// InstrumentedStore is an AppsManagementStore that reports the duration and the failures of the
+// operations of another store.
+type InstrumentedStore struct {
+	store   AppsManagementStore
+	observe StoreObserver
+}
+
+// NewInstrumentedStore wraps the store, so that every operation is reported to the observer.
+func NewInstrumentedStore(store AppsManagementStore, observe StoreObserver) *InstrumentedStore {
+	return &InstrumentedStore{
+		store:   store,
+		observe: observe,
+	}
+}
+
+// done reports an operation that started at the given time.
+func (s *InstrumentedStore) done(method string, start time.Time, err error) {
+	s.observe(method, time.Since(start), isStoreFailure(err))
+}
+
+// isStoreFailure checks if the error is a failure of the store. Not found, invalid input, conflict and quota
+// errors are the expected answers to bad requests, and the operations of the canceled requests are given up by
+// the clients, so they are not failures of the store.
+func isStoreFailure(err error) bool {
+	return err != nil && !backends.IsErrNotFound(err) && !backends.IsErrInvalidInput(err) &&
+		!backends.IsErrAlreadyExists(err) && !IsErrQuotaExceeded(err) && !IsErrStoreCanceled(err)
+}
+
+// GetApp calls GetApp on the wrapped store.
+func (s *InstrumentedStore) GetApp(ctx context.Context, appID string) (*app.Apps, error) {
+	start := time.Now()
+	res, err := s.store.GetApp(ctx, appID)
+	s.done("GetApp", start, err)
+	return res, err
+}
+
+// GetMyApps calls GetMyApps on the wrapped store.
+func (s *InstrumentedStore) GetMyApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.GetMyApps(ctx, userID, selector)
+	s.done("GetMyApps", start, err)
+	return res, err
+}
+
+// GetUserApps calls GetUserApps on the wrapped store.
+func (s *InstrumentedStore) GetUserApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.GetUserApps(ctx, userID, selector)
+	s.done("GetUserApps", start, err)
+	return res, err
+}
+
+// RegisterApp calls RegisterApp on the wrapped store.
+func (s *InstrumentedStore) RegisterApp(ctx context.Context, payload *app.AppPayload, userID string, maxApps int) (*app.RegApps, error) {
+	start := time.Now()
+	res, err := s.store.RegisterApp(ctx, payload, userID, maxApps)
+	s.done("RegisterApp", start, err)
+	return res, err
+}
+
+// DeleteApp calls DeleteApp on the wrapped store.
+func (s *InstrumentedStore) DeleteApp(ctx context.Context, appID string) error {
+	start := time.Now()
+	err := s.store.DeleteApp(ctx, appID)
+	s.done("DeleteApp", start, err)
+	return err
+}
+
+// UpdateApp calls UpdateApp on the wrapped store.
+func (s *InstrumentedStore) UpdateApp(ctx context.Context, payload *app.AppPayload, appID string) (*app.Apps, error) {
+	start := time.Now()
+	res, err := s.store.UpdateApp(ctx, payload, appID)
+	s.done("UpdateApp", start, err)
+	return res, err
+}
+
+// RegenerateSecret calls RegenerateSecret on the wrapped store.
+func (s *InstrumentedStore) RegenerateSecret(ctx context.Context, appID string) (*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.RegenerateSecret(ctx, appID)
+	s.done("RegenerateSecret", start, err)
+	return res, err
+}
+
+// FindApp calls FindApp on the wrapped store.
+func (s *InstrumentedStore) FindApp(ctx context.Context, id, secret string) (*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.FindApp(ctx, id, secret)
+	s.done("FindApp", start, err)
+	return res, err
+}
+
+// SetRateLimit calls SetRateLimit on the wrapped store.
+func (s *InstrumentedStore) SetRateLimit(ctx context.Context, appID string, payload *app.RateLimitPayload) (*app.RateLimit, error) {
+	start := time.Now()
+	res, err := s.store.SetRateLimit(ctx, appID, payload)
+	s.done("SetRateLimit", start, err)
+	return res, err
+}
+
+// DeleteRateLimit calls DeleteRateLimit on the wrapped store.
+func (s *InstrumentedStore) DeleteRateLimit(ctx context.Context, appID string) error {
+	start := time.Now()
+	err := s.store.DeleteRateLimit(ctx, appID)
+	s.done("DeleteRateLimit", start, err)
+	return err
+}
+
+// RecordUsage calls RecordUsage on the wrapped store.
+func (s *InstrumentedStore) RecordUsage(ctx context.Context, record *UsageRecord) error {
+	start := time.Now()
+	err := s.store.RecordUsage(ctx, record)
+	s.done("RecordUsage", start, err)
+	return err
+}
+
+// GetAppUsage calls GetAppUsage on the wrapped store.
+func (s *InstrumentedStore) GetAppUsage(ctx context.Context, appID string, from, to time.Time) (*app.AppUsage, error) {
+	start := time.Now()
+	res, err := s.store.GetAppUsage(ctx, appID, from, to)
+	s.done("GetAppUsage", start, err)
+	return res, err
+}
+
+// GetAppsUnusedSince calls GetAppsUnusedSince on the wrapped store.
+func (s *InstrumentedStore) GetAppsUnusedSince(ctx context.Context, since time.Time) ([]*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.GetAppsUnusedSince(ctx, since)
+	s.done("GetAppsUnusedSince", start, err)
+	return res, err
+}
+
+// SetAppStatus calls SetAppStatus on the wrapped store.
+func (s *InstrumentedStore) SetAppStatus(ctx context.Context, appID, status string) error {
+	start := time.Now()
+	err := s.store.SetAppStatus(ctx, appID, status)
+	s.done("SetAppStatus", start, err)
+	return err
+}
+
+// TransitionAppStatus calls TransitionAppStatus on the wrapped store.
+func (s *InstrumentedStore) TransitionAppStatus(ctx context.Context, appID string, from *StatusVersion, status string) error {
+	start := time.Now()
+	err := s.store.TransitionAppStatus(ctx, appID, from, status)
+	s.done("TransitionAppStatus", start, err)
+	return err
+}
+
+// SetReapingExempt calls SetReapingExempt on the wrapped store.
+func (s *InstrumentedStore) SetReapingExempt(ctx context.Context, appID string, exempt bool) (*app.Apps, error) {
+	start := time.Now()
+	res, err := s.store.SetReapingExempt(ctx, appID, exempt)
+	s.done("SetReapingExempt", start, err)
+	return res, err
+}
+
+// GetClientApp calls GetClientApp on the wrapped store.
+func (s *InstrumentedStore) GetClientApp(ctx context.Context, appID string) (*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.GetClientApp(ctx, appID)
+	s.done("GetClientApp", start, err)
+	return res, err
+}
+
+// IssueVerificationToken calls IssueVerificationToken on the wrapped store.
+func (s *InstrumentedStore) IssueVerificationToken(ctx context.Context, appID string) (*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.IssueVerificationToken(ctx, appID)
+	s.done("IssueVerificationToken", start, err)
+	return res, err
+}
+
+// SaveVerificationResult calls SaveVerificationResult on the wrapped store.
+func (s *InstrumentedStore) SaveVerificationResult(ctx context.Context, appID string, result *VerificationResult) (*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.SaveVerificationResult(ctx, appID, result)
+	s.done("SaveVerificationResult", start, err)
+	return res, err
+}
+
+// GetAppsToReverify calls GetAppsToReverify on the wrapped store.
+func (s *InstrumentedStore) GetAppsToReverify(ctx context.Context, checkedBefore time.Time) ([]*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.GetAppsToReverify(ctx, checkedBefore)
+	s.done("GetAppsToReverify", start, err)
+	return res, err
+}
+
+// GetUserQuota calls GetUserQuota on the wrapped store.
+func (s *InstrumentedStore) GetUserQuota(ctx context.Context, userID string) (*UserQuota, error) {
+	start := time.Now()
+	res, err := s.store.GetUserQuota(ctx, userID)
+	s.done("GetUserQuota", start, err)
+	return res, err
+}
+
+// SetUserQuota calls SetUserQuota on the wrapped store.
+func (s *InstrumentedStore) SetUserQuota(ctx context.Context, userID string, maxApps int) (*UserQuota, error) {
+	start := time.Now()
+	res, err := s.store.SetUserQuota(ctx, userID, maxApps)
+	s.done("SetUserQuota", start, err)
+	return res, err
+}
+
+// DeleteUserQuota calls DeleteUserQuota on the wrapped store.
+func (s *InstrumentedStore) DeleteUserQuota(ctx context.Context, userID string) error {
+	start := time.Now()
+	err := s.store.DeleteUserQuota(ctx, userID)
+	s.done("DeleteUserQuota", start, err)
+	return err
+}
+
+// CountUserApps calls CountUserApps on the wrapped store.
+func (s *InstrumentedStore) CountUserApps(ctx context.Context, userID string) (int, error) {
+	start := time.Now()
+	res, err := s.store.CountUserApps(ctx, userID)
+	s.done("CountUserApps", start, err)
+	return res, err
+}
+
+// SearchApps calls SearchApps on the wrapped store.
+func (s *InstrumentedStore) SearchApps(ctx context.Context, query *SearchQuery) ([]*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.SearchApps(ctx, query)
+	s.done("SearchApps", start, err)
+	return res, err
+}
+
+// ListApps calls ListApps on the wrapped store.
+func (s *InstrumentedStore) ListApps(ctx context.Context, filter *AppFilter, page *Page) (*AppPage, error) {
+	start := time.Now()
+	res, err := s.store.ListApps(ctx, filter, page)
+	s.done("ListApps", start, err)
+	return res, err
+}
+
+// CountAppsByStatus calls CountAppsByStatus on the wrapped store.
+func (s *InstrumentedStore) CountAppsByStatus(ctx context.Context) (map[string]int, error) {
+	start := time.Now()
+	res, err := s.store.CountAppsByStatus(ctx)
+	s.done("CountAppsByStatus", start, err)
+	return res, err
+}
+
+// SetAppLogo calls SetAppLogo on the wrapped store.
+func (s *InstrumentedStore) SetAppLogo(ctx context.Context, appID string, logo *AppLogo) (*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.SetAppLogo(ctx, appID, logo)
+	s.done("SetAppLogo", start, err)
+	return res, err
+}
+
+// SetAppLabels calls SetAppLabels on the wrapped store.
+func (s *InstrumentedStore) SetAppLabels(ctx context.Context, appID string, set map[string]string, remove []string) (*ClientApp, error) {
+	start := time.Now()
+	res, err := s.store.SetAppLabels(ctx, appID, set, remove)
+	s.done("SetAppLabels", start, err)
+	return res, err
+}
+
+// Ping calls Ping on the wrapped store.
+func (s *InstrumentedStore) Ping(ctx context.Context) error {
+	start := time.Now()
+	err := s.store.Ping(ctx)
+	s.done("Ping", start, err)
+	return err
+}
+
+// IdempotencyStore returns the idempotency store of the wrapped store.
+func (s *InstrumentedStore) IdempotencyStore(ttl, lease time.Duration) idempotency.Store {
+	return s.store.IdempotencyStore(ttl, lease)
+}
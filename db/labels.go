@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"strings"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/Microkubes/backends"
+	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/Microkubes/microservice-apps-management/labels"
+)
+
+// ownerFilter returns the backend filter for the apps of the owner. The equality requirements of the
+// selector are added to the filter when the label key can be used as a property path. The whole selector
+// must still be applied to the results with selectApps.
+func ownerFilter(owner string, selector labels.Selector) backends.Filter {
+	filter := backends.NewFilter().Match("owner", owner)
+	for key, value := range selector.Equalities() {
+		if strings.ContainsAny(key, "./") {
+			continue
+		}
+		filter = filter.Match("labels."+key, value)
+	}
+	return filter
+}
+
+// selectApps returns the apps with labels that satisfy the selector.
+func selectApps(apps []*map[string]interface{}, selector labels.Selector) []*map[string]interface{} {
+	if selector.Empty() {
+		return apps
+	}
+	result := []*map[string]interface{}{}
+	for _, clientApp := range apps {
+		if selector.Matches(appLabels(*clientApp)) {
+			result = append(result, clientApp)
+		}
+	}
+	return result
+}
+
+// appLabels returns the labels of an app read from the backend as a map.
+func appLabels(clientApp map[string]interface{}) map[string]string {
+	var values map[string]interface{}
+	switch v := clientApp["labels"].(type) {
+	case map[string]interface{}:
+		values = v
+	case bson.M:
+		values = v
+	}
+
+	result := map[string]string{}
+	for key, value := range values {
+		if s, ok := value.(string); ok {
+			result[key] = s
+		}
+	}
+	return result
+}
+
+// validateLabels validates the labels and the annotations of an app.
+func validateLabels(payload *app.AppPayload) error {
+	if err := labels.Validate(payload.Labels); err != nil {
+		return backends.ErrInvalidInput(err)
+	}
+	if err := labels.ValidateAnnotations(payload.Annotations); err != nil {
+		return backends.ErrInvalidInput(err)
+	}
+	return nil
+}
+
+// SetAppLabels adds or changes the labels of an app and removes the labels with the given keys.
+// The annotations and the other fields of the app are not changed.
+func (c *BackendAppsManagementStore) SetAppLabels(ctx context.Context, appID string, set map[string]string, remove []string) (*ClientApp, error) {
+	existing, err := c.getClientApp(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := mergeLabels(existing.Labels, set, remove)
+	if err := labels.Validate(merged); err != nil {
+		return nil, backends.ErrInvalidInput(err)
+	}
+	existing.Labels = merged
+	existing.LabelTerms = labelTerms(merged)
+
+	return c.saveApp(ctx, appID, existing, "labels", "labelTerms")
+}
+
+// mergeLabels returns a copy of the labels with the set labels added or changed and the removed keys deleted.
+func mergeLabels(current, set map[string]string, remove []string) map[string]string {
+	result := map[string]string{}
+	for key, value := range current {
+		result[key] = value
+	}
+	for _, key := range remove {
+		delete(result, key)
+	}
+	for key, value := range set {
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,262 @@
+package db
+
+import (
+	"context"
+
+	"github.com/Microkubes/backends"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// AppFilter restricts a listing or a search of apps. The zero value of a criterion means no restriction.
+type AppFilter struct {
+	// Status restricts the result to apps with the status. Deleted apps are found only by status.
+	Status string
+	// Owner restricts the result to the apps of a user.
+	Owner string
+	// Domain restricts the result to the apps with the domain.
+	Domain string
+	// RegisteredFrom and RegisteredTo restrict the result to apps registered in the period (Unix seconds, inclusive).
+	RegisteredFrom int64
+	RegisteredTo   int64
+}
+
+// Matches checks if the app satisfies all criteria of the filter.
+func (f *AppFilter) Matches(clientApp *ClientApp) bool {
+	status := clientApp.CurrentStatus()
+	if f.Status != "" && status != f.Status {
+		return false
+	}
+	if f.Status == "" && status == StatusDeleted {
+		return false
+	}
+	if f.Owner != "" && clientApp.Owner != f.Owner {
+		return false
+	}
+	if f.Domain != "" && clientApp.Domain != f.Domain {
+		return false
+	}
+	if f.RegisteredFrom > 0 && clientApp.RegisteredAt < f.RegisteredFrom {
+		return false
+	}
+	if f.RegisteredTo > 0 && clientApp.RegisteredAt > f.RegisteredTo {
+		return false
+	}
+	return true
+}
+
+// backendFilter returns the criteria that can be passed to the backend. The status and the registration
+// period are checked in memory, because the status of active apps may be missing.
+func (f *AppFilter) backendFilter() backends.Filter {
+	filter := backends.NewFilter()
+	if f.Owner != "" {
+		filter = filter.Match("owner", f.Owner)
+	}
+	if f.Domain != "" {
+		filter = filter.Match("domain", f.Domain)
+	}
+	return filter
+}
+
+// mongoSelector returns the MongoDB selector of the apps that satisfy the filter.
+func (f *AppFilter) mongoSelector() bson.M {
+	selector := bson.M{}
+	switch f.Status {
+	case "":
+		selector["status"] = bson.M{"$ne": StatusDeleted}
+	case StatusActive:
+		selector["status"] = bson.M{"$in": []interface{}{nil, "", StatusActive}}
+	default:
+		selector["status"] = f.Status
+	}
+	if f.Owner != "" {
+		selector["owner"] = f.Owner
+	}
+	if f.Domain != "" {
+		selector["domain"] = f.Domain
+	}
+	registeredAt := bson.M{}
+	if f.RegisteredFrom > 0 {
+		registeredAt["$gte"] = f.RegisteredFrom
+	}
+	if f.RegisteredTo > 0 {
+		registeredAt["$lte"] = f.RegisteredTo
+	}
+	if len(registeredAt) > 0 {
+		selector["registeredAt"] = registeredAt
+	}
+	return selector
+}
+
+// filterApps returns the apps that satisfy the filter.
+func filterApps(clientApps []*ClientApp, filter *AppFilter) []*ClientApp {
+	result := []*ClientApp{}
+	for _, clientApp := range clientApps {
+		if filter.Matches(clientApp) {
+			result = append(result, clientApp)
+		}
+	}
+	return result
+}
+
+// Page selects a page of a listing.
+type Page struct {
+	// Number is the number of the page, starting from 1.
+	Number int
+	// Size is the maximal number of apps on a page. 0 means all apps on a single page.
+	Size int
+}
+
+// AppPage is a page of a listing of apps.
+type AppPage struct {
+	// Apps holds the apps on the page, ordered by the time of registration.
+	Apps []*ClientApp
+	// Total is the number of apps that satisfy the filter, on all pages.
+	Total int
+}
+
+// ListApps returns a page of all apps, of all users, that satisfy the filter. With MongoDB only the apps on
+// the page are read, and the total is counted by MongoDB. The other backends cannot filter by status and
+// registration period, so the apps are filtered and paged in memory.
+func (c *BackendAppsManagementStore) ListApps(ctx context.Context, filter *AppFilter, page *Page) (*AppPage, error) {
+	if c.mongo != nil {
+		return c.mongo.listApps(ctx, filter, page)
+	}
+
+	var typeHint map[string]interface{}
+	apps, err := withContext(ctx, c.repository).GetAll(filter.backendFilter(), typeHint, "registeredat", "asc", 0, 0)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return &AppPage{Apps: []*ClientApp{}}, nil
+		}
+		return nil, err
+	}
+
+	clientApps, err := decodeClientApps(apps)
+	if err != nil {
+		return nil, err
+	}
+
+	return pageApps(filterApps(clientApps, filter), page), nil
+}
+
+// CountAppsByStatus returns the number of apps with every lifecycle status, including the deleted apps.
+// With MongoDB the apps are counted by MongoDB, otherwise all apps are read.
+func (c *BackendAppsManagementStore) CountAppsByStatus(ctx context.Context) (map[string]int, error) {
+	if c.mongo != nil {
+		return c.mongo.countAppsByStatus(ctx)
+	}
+
+	var typeHint map[string]interface{}
+	apps, err := withContext(ctx, c.repository).GetAll(backends.NewFilter(), typeHint, "registeredat", "asc", 0, 0)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return countByStatus(nil), nil
+		}
+		return nil, err
+	}
+
+	clientApps, err := decodeClientApps(apps)
+	if err != nil {
+		return nil, err
+	}
+
+	return countByStatus(clientApps), nil
+}
+
+// listApps reads the apps on the page and counts the apps that satisfy the filter.
+func (s *mongoStore) listApps(ctx context.Context, filter *AppFilter, page *Page) (*AppPage, error) {
+	selector := filter.mongoSelector()
+
+	skip, limit := 0, 0
+	if page != nil && page.Size > 0 {
+		number := page.Number
+		if number < 1 {
+			number = 1
+		}
+		skip, limit = (number-1)*page.Size, page.Size
+	}
+	// The ID breaks the ties between the apps registered in the same second, so that the pages do not overlap.
+	clientApps, err := s.findApps(ctx, selector, []string{"registeredAt", "_id"}, skip, limit, false)
+	if err != nil {
+		return nil, err
+	}
+
+	total := len(clientApps)
+	if limit > 0 {
+		err = s.run(ctx, "apps-management", func(apps *mgo.Collection) (err error) {
+			total, err = apps.Find(selector).Count()
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &AppPage{Apps: clientApps, Total: total}, nil
+}
+
+// countAppsByStatus groups the apps by status in MongoDB. The apps without a status are active.
+func (s *mongoStore) countAppsByStatus(ctx context.Context) (map[string]int, error) {
+	var groups []struct {
+		Status interface{} `bson:"_id"`
+		Count  int         `bson:"count"`
+	}
+	err := s.run(ctx, "apps-management", func(apps *mgo.Collection) error {
+		return apps.Pipe([]bson.M{
+			{"$group": bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}},
+		}).All(&groups)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := countByStatus(nil)
+	for _, group := range groups {
+		status, _ := group.Status.(string)
+		if status == "" {
+			status = StatusActive
+		}
+		counts[status] += group.Count
+	}
+	return counts, nil
+}
+
+// countByStatus counts the apps by their lifecycle status. Every status is included, also when there are no apps with it.
+func countByStatus(clientApps []*ClientApp) map[string]int {
+	counts := map[string]int{
+		StatusActive:    0,
+		StatusFlagged:   0,
+		StatusSuspended: 0,
+		StatusDeleted:   0,
+	}
+	for _, clientApp := range clientApps {
+		counts[clientApp.CurrentStatus()]++
+	}
+	return counts
+}
+
+// pageApps sorts the apps by the time of registration and returns the selected page.
+func pageApps(clientApps []*ClientApp, page *Page) *AppPage {
+	sortByRegistration(clientApps)
+	result := &AppPage{Apps: clientApps, Total: len(clientApps)}
+	if page == nil || page.Size <= 0 {
+		return result
+	}
+
+	number := page.Number
+	if number < 1 {
+		number = 1
+	}
+	start := (number - 1) * page.Size
+	if start >= len(clientApps) {
+		result.Apps = []*ClientApp{}
+		return result
+	}
+	end := start + page.Size
+	if end > len(clientApps) {
+		end = len(clientApps)
+	}
+	result.Apps = clientApps[start:end]
+	return result
+}
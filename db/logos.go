@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// AppLogo describes the logo of an app. The images themselves are kept in a blob store.
+type AppLogo struct {
+	ContentType string `json:"contentType" bson:"contentType"`
+	Size        int    `json:"size" bson:"size"`
+	Width       int    `json:"width" bson:"width"`
+	Height      int    `json:"height" bson:"height"`
+	Hash        string `json:"hash" bson:"hash"`
+	UpdatedAt   int64  `json:"updatedAt" bson:"updatedAt"`
+}
+
+// ETag returns the entity tag of the logo image of the given size.
+func (l *AppLogo) ETag(size string) string {
+	return fmt.Sprintf(`"%s-%s"`, l.Hash, size)
+}
+
+// logoURLs returns the links to the logo and its thumbnail. The links change with the logo, so they can be cached.
+func (ca *ClientApp) logoURLs() (*string, *string) {
+	if ca.Logo == nil {
+		return nil, nil
+	}
+	version := ca.Logo.Hash
+	if len(version) > 16 {
+		version = version[:16]
+	}
+	logoURL := fmt.Sprintf("/apps/%s/logo?v=%s", ca.ID, version)
+	thumbnailURL := fmt.Sprintf("/apps/%s/logo?size=thumbnail&v=%s", ca.ID, version)
+	return &logoURL, &thumbnailURL
+}
+
+// SetAppLogo sets the logo of an app. A nil logo removes the logo.
+func (c *BackendAppsManagementStore) SetAppLogo(ctx context.Context, appID string, logo *AppLogo) (*ClientApp, error) {
+	existing, err := c.getClientApp(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	if logo != nil {
+		logo.UpdatedAt = time.Now().Unix()
+	}
+	existing.Logo = logo
+
+	return c.saveApp(ctx, appID, existing, "logo")
+}
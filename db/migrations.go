@@ -0,0 +1,103 @@
+package db
+
+import (
+	"time"
+
+	"github.com/Microkubes/microservice-tools/config"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// migrateNameIndex migrates an apps collection in MongoDB from names unique across all apps to names
+// unique per owner. It drops the old unique index on "name" and sets the name key of the apps that do
+// not have one. If the same owner already has several apps with the same normalized name, the app ID
+// is appended to the name key of the later ones, so the unique index on the name key can be created.
+// The soft-deleted apps release their names, like the apps deleted after the migration.
+// Must run before the repository is defined, because the index on "name" is redefined as non-unique.
+func migrateNameIndex(info *config.DBInfo, collectionName string) error {
+	session, err := dialMongo(info)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	collection := session.DB(info.DatabaseName).C(collectionName)
+
+	indexes, err := collection.Indexes()
+	if err != nil {
+		if queryErr, ok := err.(*mgo.QueryError); ok && queryErr.Code == 26 {
+			// the collection does not exist yet
+			return nil
+		}
+		return err
+	}
+	for _, index := range indexes {
+		if index.Unique && len(index.Key) == 1 && index.Key[0] == "name" {
+			if err := collection.DropIndexName(index.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	var apps []bson.M
+	query := collection.Find(bson.M{"nameKey": bson.M{"$exists": false}}).Sort("registeredAt")
+	if err := query.All(&apps); err != nil {
+		return err
+	}
+
+	for _, clientApp := range apps {
+		owner, _ := clientApp["owner"].(string)
+		name, _ := clientApp["name"].(string)
+		key := nameKey(owner, name)
+
+		count, err := collection.Find(bson.M{"nameKey": key}).Count()
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			key = key + "#" + idString(clientApp["_id"])
+		}
+
+		if err := collection.UpdateId(clientApp["_id"], bson.M{"$set": bson.M{"nameKey": key}}); err != nil {
+			return err
+		}
+	}
+
+	var deleted []bson.M
+	query = collection.Find(bson.M{
+		"status":  StatusDeleted,
+		"nameKey": bson.M{"$not": bson.RegEx{Pattern: "^deleted:"}},
+	}).Select(bson.M{"_id": 1})
+	if err := query.All(&deleted); err != nil {
+		return err
+	}
+	for _, clientApp := range deleted {
+		key := releasedNameKey(idString(clientApp["_id"]))
+		if err := collection.UpdateId(clientApp["_id"], bson.M{"$set": bson.M{"nameKey": key}}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dialMongo opens a MongoDB session directly, for the operations the backends package does not support.
+func dialMongo(info *config.DBInfo) (*mgo.Session, error) {
+	return mgo.DialWithInfo(&mgo.DialInfo{
+		Addrs:    []string{info.Host},
+		Database: info.DatabaseName,
+		Username: info.Username,
+		Password: info.Password,
+		Timeout:  30 * time.Second,
+	})
+}
+
+func idString(id interface{}) string {
+	if objectID, ok := id.(bson.ObjectId); ok {
+		return objectID.Hex()
+	}
+	if str, ok := id.(string); ok {
+		return str
+	}
+	return ""
+}
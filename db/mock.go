@@ -1,17 +1,23 @@
 package db
 
 import (
-	"encoding/json"
+	"context"
 	"sync"
+	"time"
 
 	"github.com/Microkubes/backends"
 	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/Microkubes/microservice-apps-management/idempotency"
+	"github.com/Microkubes/microservice-apps-management/labels"
 )
 
 // DB emulates a database driver using in-memory data structures.
 type DB struct {
 	sync.Mutex
-	apps map[string]*app.AppPayload
+	apps          map[string]*app.AppPayload
+	quotas        map[string]int
+	logos         map[string]*AppLogo
+	verifications map[string]*VerificationResult
 }
 
 // New initializes a new "DB" with dummy data.
@@ -23,12 +29,19 @@ func New() *DB {
 		Name:        name,
 		Description: &desc,
 		Domain:      &domain,
+		Origins:     []string{"https://*.example.com"},
+		Labels:      map[string]string{"env": "prod", "team": "payments"},
+	}
+	return &DB{
+		apps:          map[string]*app.AppPayload{"5975c461f9f8eb02aae053f3": client},
+		quotas:        map[string]int{},
+		logos:         map[string]*AppLogo{},
+		verifications: map[string]*VerificationResult{},
 	}
-	return &DB{apps: map[string]*app.AppPayload{"5975c461f9f8eb02aae053f3": client}}
 }
 
 // Mock GetApp method
-func (db *DB) GetApp(appID string) (*app.Apps, error) {
+func (db *DB) GetApp(ctx context.Context, appID string) (*app.Apps, error) {
 	if appID == "internal-error" {
 		return nil, backends.ErrBackendError("internal-server-error")
 	}
@@ -54,76 +67,78 @@ func (db *DB) GetApp(appID string) (*app.Apps, error) {
 }
 
 // Mock GetUserApps method
-func (db *DB) GetMyApps(userID string) ([]byte, error) {
+func (db *DB) GetMyApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
 	if userID == "internal-error" {
 		return nil, backends.ErrBackendError("inertnal-server-error")
 	}
 
 	client, ok := db.apps[userID]
-	if !ok {
+	if !ok || !selector.Matches(client.Labels) {
 		return nil, backends.ErrNotFound("app not found")
 	}
 
-	var apps []map[string]interface{}
-	app1 := map[string]interface{}{
-		"ID":           "5975c461f9f8eb02aae053f3",
-		"Name":         client.Name,
-		"Description":  *client.Description,
-		"Domain":       *client.Domain,
-		"Owner":        "ada5c461f9f8eb02aae05zzz",
-		"RegisteredAt": 1505746311,
-	}
-
-	apps = append(apps, app1)
-
-	res, err := json.Marshal(apps)
-	if err != nil {
-		return nil, backends.ErrBackendError(err)
+	app1 := &ClientApp{
+		ID:           "5975c461f9f8eb02aae053f3",
+		Name:         client.Name,
+		Description:  *client.Description,
+		Domain:       *client.Domain,
+		Owner:        "ada5c461f9f8eb02aae05zzz",
+		Secret:       "GO70Gpt-y8tEYq8HFPDrtva7HhbEb10pEdVu4qjLCLoAXpT9q5DnvM7D",
+		RegisteredAt: 1505746311,
+		Labels:       client.Labels,
 	}
 
-	return res, nil
+	return []*ClientApp{app1}, nil
 }
 
 // Mock GetUserApps method
-func (db *DB) GetUserApps(userID string) ([]byte, error) {
+func (db *DB) GetUserApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
 	if userID == "internal-error" {
 		return nil, backends.ErrBackendError("inertnal-server-error")
 	}
 
 	client, ok := db.apps[userID]
-	if !ok {
+	if !ok || !selector.Matches(client.Labels) {
 		return nil, backends.ErrNotFound("app not found")
 	}
 
-	var apps []map[string]interface{}
-	app1 := map[string]interface{}{
-		"ID":           "5975c461f9f8eb02aae053f3",
-		"Name":         client.Name,
-		"Description":  *client.Description,
-		"Domain":       *client.Domain,
-		"Owner":        "ada5c461f9f8eb02aae05zzz",
-		"Secret":       "GO70Gpt-y8tEYq8HFPDrtva7HhbEb10pEdVu4qjLCLoAXpT9q5DnvM7D",
-		"RegisteredAt": 1505746311,
-	}
-
-	apps = append(apps, app1)
-
-	res, err := json.Marshal(apps)
-	if err != nil {
-		return nil, backends.ErrBackendError(err)
+	app1 := &ClientApp{
+		ID:           "5975c461f9f8eb02aae053f3",
+		Name:         client.Name,
+		Description:  *client.Description,
+		Domain:       *client.Domain,
+		Owner:        "ada5c461f9f8eb02aae05zzz",
+		Secret:       "GO70Gpt-y8tEYq8HFPDrtva7HhbEb10pEdVu4qjLCLoAXpT9q5DnvM7D",
+		RegisteredAt: 1505746311,
+		Labels:       client.Labels,
 	}
 
-	return res, nil
+	return []*ClientApp{app1}, nil
 }
 
 // Mock RegisterApp method
-func (db *DB) RegisterApp(payload *app.AppPayload, userID string) (*app.RegApps, error) {
+func (db *DB) RegisterApp(ctx context.Context, payload *app.AppPayload, userID string, maxApps int) (*app.RegApps, error) {
 	if userID == "internal-error" {
 		return nil, backends.ErrBackendError("inertnal-server-error")
 	}
 	if userID == "bad-request-error" {
 		return nil, backends.ErrInvalidInput("invalid user ID")
 	}
+	if maxApps > 0 && len(db.apps) >= maxApps {
+		return nil, quotaExceeded(len(db.apps), maxApps)
+	}
+	if NormalizeName(payload.Name) == "conflicting name" {
+		return nil, nameTaken(payload.Name)
+	}
+	if _, err := normalizeOrigins(payload.Origins); err != nil {
+		return nil, err
+	}
+	if err := validateLabels(payload); err != nil {
+		return nil, err
+	}
+	if err := validateConsentInfo(payload); err != nil {
+		return nil, err
+	}
 
 	db.apps["qwe5c461f9f8ebrtaae05zzz"] = payload
 
@@ -137,7 +152,7 @@ func (db *DB) RegisterApp(payload *app.AppPayload, userID string) (*app.RegApps,
 }
 
 // Mock DeleteApp method
-func (db *DB) DeleteApp(appID string) error {
+func (db *DB) DeleteApp(ctx context.Context, appID string) error {
 	if appID == "internal-error" {
 		return backends.ErrBackendError("inertnal-server-error")
 	}
@@ -155,13 +170,25 @@ func (db *DB) DeleteApp(appID string) error {
 }
 
 // Mock UpdateApp method
-func (db *DB) UpdateApp(payload *app.AppPayload, appID string) (*app.Apps, error) {
+func (db *DB) UpdateApp(ctx context.Context, payload *app.AppPayload, appID string) (*app.Apps, error) {
 	if appID == "internal-error" {
 		return nil, backends.ErrBackendError("inertnal-server-error")
 	}
 	if appID == "bad-request-error" {
 		return nil, backends.ErrInvalidInput("invalid user ID")
 	}
+	if NormalizeName(payload.Name) == "conflicting name" {
+		return nil, nameTaken(payload.Name)
+	}
+	if _, err := normalizeOrigins(payload.Origins); err != nil {
+		return nil, err
+	}
+	if err := validateLabels(payload); err != nil {
+		return nil, err
+	}
+	if err := validateConsentInfo(payload); err != nil {
+		return nil, err
+	}
 
 	if _, ok := db.apps[appID]; ok {
 		db.apps[appID] = payload
@@ -182,7 +209,7 @@ func (db *DB) UpdateApp(payload *app.AppPayload, appID string) (*app.Apps, error
 }
 
 // Mock RegenerateSecret method
-func (db *DB) RegenerateSecret(appID string) ([]byte, error) {
+func (db *DB) RegenerateSecret(ctx context.Context, appID string) (*ClientApp, error) {
 	if appID == "internal-error" {
 		return nil, backends.ErrBackendError("inertnal-server-error")
 	}
@@ -194,27 +221,339 @@ func (db *DB) RegenerateSecret(appID string) ([]byte, error) {
 		return nil, backends.ErrNotFound("app not found!")
 	}
 
-	name := "app-name"
-	desc := "Some description"
-	domain := "example.com"
-	client := &app.Apps{
-		ID:           appID,
-		Name:         name,
-		Description:  desc,
-		Domain:       domain,
-		Owner:        "ada5c461f9f8eb02aae05zzz",
-		RegisteredAt: 1505746311,
+	clientApp, err := db.GetClientApp(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	clientApp.Secret = "SIvS0fuqd3hXmdFKZ2V6q1YY-RjbZ1WR6yl4u9LQ3dzpy2xz9Omsn9Ay"
+
+	return clientApp, nil
+}
+
+// FindApp tries to find an app with the supplied app ID and secret.
+func (db *DB) FindApp(ctx context.Context, ID, secret string) (*ClientApp, error) {
+	if ID == "internal-error" {
+		return nil, backends.ErrBackendError("internal-server-error")
+	}
+	if _, ok := db.apps[ID]; !ok {
+		return nil, backends.ErrNotFound("app not found")
+	}
+	return nil, nil
+}
+
+// Mock SetRateLimit method
+func (db *DB) SetRateLimit(ctx context.Context, appID string, payload *app.RateLimitPayload) (*app.RateLimit, error) {
+	if appID == "internal-error" {
+		return nil, backends.ErrBackendError("inertnal-server-error")
+	}
+	if appID == "bad-request-error" {
+		return nil, backends.ErrInvalidInput("invalid app ID")
+	}
+
+	if _, ok := db.apps[appID]; !ok {
+		return nil, backends.ErrNotFound("app not found!")
+	}
+
+	return NewRateLimitPolicy(payload).ToMedia(), nil
+}
+
+// Mock DeleteRateLimit method
+func (db *DB) DeleteRateLimit(ctx context.Context, appID string) error {
+	if appID == "internal-error" {
+		return backends.ErrBackendError("inertnal-server-error")
+	}
+	if appID == "bad-request-error" {
+		return backends.ErrInvalidInput("invalid app ID")
 	}
 
-	res, err := json.Marshal(client)
+	if _, ok := db.apps[appID]; !ok {
+		return backends.ErrNotFound("app not found!")
+	}
+
+	return nil
+}
+
+// Mock RecordUsage method
+func (db *DB) RecordUsage(ctx context.Context, record *UsageRecord) error {
+	return nil
+}
+
+// Mock GetAppUsage method
+func (db *DB) GetAppUsage(ctx context.Context, appID string, from, to time.Time) (*app.AppUsage, error) {
+	if appID == "internal-error" {
+		return nil, backends.ErrBackendError("inertnal-server-error")
+	}
+	if appID == "bad-request-error" {
+		return nil, backends.ErrInvalidInput("invalid app ID")
+	}
+
+	if _, ok := db.apps[appID]; !ok {
+		return nil, backends.ErrNotFound("app not found!")
+	}
+
+	days, err := usageDays(from, to)
 	if err != nil {
-		return nil, backends.ErrBackendError(err)
+		return nil, err
+	}
+
+	return buildAppUsage(&ClientApp{ID: appID}, days, []*DailyUsage{}), nil
+}
+
+// Mock GetAppsUnusedSince method
+func (db *DB) GetAppsUnusedSince(ctx context.Context, since time.Time) ([]*ClientApp, error) {
+	clientApps := []*ClientApp{}
+	for appID, client := range db.apps {
+		clientApps = append(clientApps, &ClientApp{
+			ID:           appID,
+			Name:         client.Name,
+			Description:  *client.Description,
+			Domain:       *client.Domain,
+			Owner:        "ada5c461f9f8eb02aae05zzz",
+			RegisteredAt: 1505746311,
+		})
 	}
 
+	return filterUnusedApps(clientApps, since), nil
+}
+
+// Mock SetAppStatus method
+func (db *DB) SetAppStatus(ctx context.Context, appID, status string) error {
+	if appID == "internal-error" {
+		return backends.ErrBackendError("inertnal-server-error")
+	}
+
+	if _, ok := db.apps[appID]; !ok {
+		return backends.ErrNotFound("app not found!")
+	}
+
+	return nil
+}
+
+// Mock TransitionAppStatus method
+func (db *DB) TransitionAppStatus(ctx context.Context, appID string, from *StatusVersion, status string) error {
+	return db.SetAppStatus(ctx, appID, status)
+}
+
+// Mock SetReapingExempt method
+func (db *DB) SetReapingExempt(ctx context.Context, appID string, exempt bool) (*app.Apps, error) {
+	if appID == "internal-error" {
+		return nil, backends.ErrBackendError("inertnal-server-error")
+	}
+	if appID == "bad-request-error" {
+		return nil, backends.ErrInvalidInput("invalid app ID")
+	}
+
+	res, err := db.GetApp(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := StatusActive
+	res.Status = &status
+	res.ReapingExempt = &exempt
+
 	return res, nil
 }
 
-// FindApp tries to find an app with the supplied app ID and secret.
-func (db *DB) FindApp(ID, secret string) (*ClientApp, error) {
-	return nil, nil
+// Mock GetUserQuota method
+func (db *DB) GetUserQuota(ctx context.Context, userID string) (*UserQuota, error) {
+	if userID == "internal-error" {
+		return nil, backends.ErrBackendError("inertnal-server-error")
+	}
+
+	maxApps, ok := db.quotas[userID]
+	if !ok {
+		return nil, backends.ErrNotFound("quota not found")
+	}
+
+	return &UserQuota{UserID: userID, MaxApps: maxApps}, nil
+}
+
+// Mock SetUserQuota method
+func (db *DB) SetUserQuota(ctx context.Context, userID string, maxApps int) (*UserQuota, error) {
+	if userID == "internal-error" {
+		return nil, backends.ErrBackendError("inertnal-server-error")
+	}
+	if userID == "bad-request-error" {
+		return nil, backends.ErrInvalidInput("invalid user ID")
+	}
+
+	db.quotas[userID] = maxApps
+
+	return &UserQuota{UserID: userID, MaxApps: maxApps}, nil
+}
+
+// Mock DeleteUserQuota method
+func (db *DB) DeleteUserQuota(ctx context.Context, userID string) error {
+	if userID == "internal-error" {
+		return backends.ErrBackendError("inertnal-server-error")
+	}
+
+	if _, ok := db.quotas[userID]; !ok {
+		return backends.ErrNotFound("quota not found")
+	}
+	delete(db.quotas, userID)
+
+	return nil
+}
+
+// Mock CountUserApps method
+func (db *DB) CountUserApps(ctx context.Context, userID string) (int, error) {
+	if userID == "internal-error" {
+		return 0, backends.ErrBackendError("inertnal-server-error")
+	}
+
+	return len(db.apps), nil
+}
+
+// Mock GetClientApp method
+func (db *DB) GetClientApp(ctx context.Context, appID string) (*ClientApp, error) {
+	if appID == "internal-error" {
+		return nil, backends.ErrBackendError("inertnal-server-error")
+	}
+	if appID == "bad-request-error" {
+		return nil, backends.ErrInvalidInput("invalid app ID")
+	}
+
+	client, ok := db.apps[appID]
+	if !ok {
+		return nil, backends.ErrNotFound("app not found!")
+	}
+
+	clientApp := &ClientApp{
+		ID:                appID,
+		Name:              client.Name,
+		Description:       *client.Description,
+		Domain:            *client.Domain,
+		Owner:             "ada5c461f9f8eb02aae05zzz",
+		Secret:            "GO70Gpt-y8tEYq8HFPDrtva7HhbEb10pEdVu4qjLCLoAXpT9q5DnvM7D",
+		RegisteredAt:      1505746311,
+		Origins:           client.Origins,
+		Labels:            client.Labels,
+		Annotations:       client.Annotations,
+		Logo:              db.logos[appID],
+		VerificationToken: "verification-token",
+	}
+	applyConsentInfo(clientApp, client)
+	if result, ok := db.verifications[appID]; ok {
+		applyVerificationResult(clientApp, result)
+	}
+
+	return clientApp, nil
+}
+
+// Mock IssueVerificationToken method
+func (db *DB) IssueVerificationToken(ctx context.Context, appID string) (*ClientApp, error) {
+	return db.GetClientApp(ctx, appID)
+}
+
+// Mock SaveVerificationResult method
+func (db *DB) SaveVerificationResult(ctx context.Context, appID string, result *VerificationResult) (*ClientApp, error) {
+	if _, err := db.GetClientApp(ctx, appID); err != nil {
+		return nil, err
+	}
+
+	db.verifications[appID] = result
+
+	return db.GetClientApp(ctx, appID)
+}
+
+// Mock GetAppsToReverify method
+func (db *DB) GetAppsToReverify(ctx context.Context, checkedBefore time.Time) ([]*ClientApp, error) {
+	return []*ClientApp{}, nil
+}
+
+// Mock SearchApps method
+func (db *DB) SearchApps(ctx context.Context, query *SearchQuery) ([]*ClientApp, error) {
+	if query.Owner == "internal-error" {
+		return nil, backends.ErrBackendError("inertnal-server-error")
+	}
+
+	clientApps := []*ClientApp{}
+	for appID := range db.apps {
+		clientApp, err := db.GetClientApp(ctx, appID)
+		if err != nil {
+			return nil, err
+		}
+		clientApps = append(clientApps, clientApp)
+	}
+
+	return searchResults(rankApps(clientApps, query), query.Limit), nil
+}
+
+// Mock ListApps method
+func (db *DB) ListApps(ctx context.Context, filter *AppFilter, page *Page) (*AppPage, error) {
+	if filter.Owner == "internal-error" {
+		return nil, backends.ErrBackendError("inertnal-server-error")
+	}
+
+	clientApps := []*ClientApp{}
+	for appID := range db.apps {
+		clientApp, err := db.GetClientApp(ctx, appID)
+		if err != nil {
+			return nil, err
+		}
+		clientApps = append(clientApps, clientApp)
+	}
+
+	return pageApps(filterApps(clientApps, filter), page), nil
+}
+
+// Mock SetAppLabels method
+func (db *DB) SetAppLabels(ctx context.Context, appID string, set map[string]string, remove []string) (*ClientApp, error) {
+	if appID == "internal-error" {
+		return nil, backends.ErrBackendError("inertnal-server-error")
+	}
+
+	client, ok := db.apps[appID]
+	if !ok {
+		return nil, backends.ErrNotFound("app not found!")
+	}
+
+	merged := mergeLabels(client.Labels, set, remove)
+	if err := labels.Validate(merged); err != nil {
+		return nil, backends.ErrInvalidInput(err)
+	}
+	client.Labels = merged
+
+	return db.GetClientApp(ctx, appID)
+}
+
+// Mock IdempotencyStore method
+func (db *DB) IdempotencyStore(ttl, lease time.Duration) idempotency.Store {
+	return idempotency.NewMemoryStore(ttl)
+}
+
+// Mock Ping method
+func (db *DB) Ping(ctx context.Context) error {
+	return nil
+}
+
+// Mock CountAppsByStatus method
+func (db *DB) CountAppsByStatus(ctx context.Context) (map[string]int, error) {
+	clientApps := []*ClientApp{}
+	for appID := range db.apps {
+		clientApp, err := db.GetClientApp(ctx, appID)
+		if err != nil {
+			return nil, err
+		}
+		clientApps = append(clientApps, clientApp)
+	}
+
+	return countByStatus(clientApps), nil
+}
+
+// Mock SetAppLogo method
+func (db *DB) SetAppLogo(ctx context.Context, appID string, logo *AppLogo) (*ClientApp, error) {
+	if _, err := db.GetClientApp(ctx, appID); err != nil {
+		return nil, err
+	}
+
+	if logo == nil {
+		delete(db.logos, appID)
+	} else {
+		db.logos[appID] = logo
+	}
+
+	return db.GetClientApp(ctx, appID)
 }
@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Microkubes/backends"
+	"github.com/Microkubes/microservice-tools/config"
+	"github.com/keitaroinc/goa"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// mongoStore runs the operations that the backends cannot express, like counters and conditional updates,
+// directly on MongoDB, so that they are atomic across the instances of the service.
+type mongoStore struct {
+	session      *mgo.Session
+	databaseName string
+}
+
+// newMongoStore connects to MongoDB.
+func newMongoStore(info *config.DBInfo) (*mongoStore, error) {
+	session, err := dialMongo(info)
+	if err != nil {
+		return nil, err
+	}
+	return &mongoStore{
+		session:      session,
+		databaseName: info.DatabaseName,
+	}, nil
+}
+
+// run runs the operation on the collection with a copy of the session. The socket timeout of the copy is
+// set from the deadline of the context, so that the operation stops at the deadline instead of running
+// on in the background. An operation that failed because the context is done reports the context error.
+func (s *mongoStore) run(ctx context.Context, collectionName string, operation func(collection *mgo.Collection) error) error {
+	if err := ctx.Err(); err != nil {
+		return contextError(err)
+	}
+
+	session := s.session.Copy()
+	defer session.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		session.SetSocketTimeout(time.Until(deadline))
+	}
+
+	err := operation(session.DB(s.databaseName).C(collectionName))
+	if err != nil && ctx.Err() != nil {
+		return contextError(ctx.Err())
+	}
+	if err != nil && err != mgo.ErrNotFound && !mgo.IsDup(err) {
+		return goa.ErrInternal(err)
+	}
+	return err
+}
+
+// findApps returns the apps that match the selector, sorted by the given fields, without their secrets unless
+// withSecret is set. A limit of 0 means no limit. MongoDB stops the query at the deadline of the context.
+func (s *mongoStore) findApps(ctx context.Context, selector bson.M, sort []string, skip, limit int, withSecret bool) ([]*ClientApp, error) {
+	var docs []bson.M
+	err := s.run(ctx, "apps-management", func(apps *mgo.Collection) error {
+		query := apps.Find(selector)
+		if !withSecret {
+			query = query.Select(bson.M{"secret": 0})
+		}
+		if len(sort) > 0 {
+			query = query.Sort(sort...)
+		}
+		if skip > 0 {
+			query = query.Skip(skip)
+		}
+		if limit > 0 {
+			query = query.Limit(limit)
+		}
+		return withMaxTime(ctx, query).All(&docs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeMongoApps(docs)
+}
+
+// getApp returns the app with the given ID, including the deleted apps and the secret. Returns a not found
+// error if there is no such app.
+func (s *mongoStore) getApp(ctx context.Context, appID string) (*ClientApp, error) {
+	clientApps, err := s.findApps(ctx, bson.M{"_id": mongoID(appID)}, nil, 0, 1, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(clientApps) == 0 {
+		return nil, backends.ErrNotFound("app not found")
+	}
+	return clientApps[0], nil
+}
+
+// updateApp sets the given fields of the app if it matches the selector, and returns the updated app. Only the
+// given fields are written, so that the concurrent updates of the other fields are kept. Returns
+// mgo.ErrNotFound if the app does not match.
+func (s *mongoStore) updateApp(ctx context.Context, appID string, selector, fields bson.M) (*ClientApp, error) {
+	selector["_id"] = mongoID(appID)
+
+	var doc bson.M
+	err := s.run(ctx, "apps-management", func(apps *mgo.Collection) error {
+		_, err := apps.Find(selector).Apply(mgo.Change{
+			Update:    bson.M{"$set": fields},
+			ReturnNew: true,
+		}, &doc)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clientApps, err := decodeMongoApps([]bson.M{doc})
+	if err != nil {
+		return nil, err
+	}
+	return clientApps[0], nil
+}
+
+// withMaxTime makes MongoDB stop the query at the deadline of the context, instead of finishing it for nobody.
+func withMaxTime(ctx context.Context, query *mgo.Query) *mgo.Query {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) > 0 {
+		return query.SetMaxTime(time.Until(deadline))
+	}
+	return query
+}
+
+// decodeMongoApps converts the app documents read from MongoDB to apps, with the object IDs as hex strings.
+func decodeMongoApps(docs []bson.M) ([]*ClientApp, error) {
+	clientApps := []*ClientApp{}
+	for _, doc := range docs {
+		doc["id"] = idString(doc["_id"])
+		delete(doc, "_id")
+		delete(doc, "score")
+
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return nil, goa.ErrInternal(err)
+		}
+		clientApp := &ClientApp{}
+		if err := json.Unmarshal(data, clientApp); err != nil {
+			return nil, goa.ErrInternal(err)
+		}
+		clientApps = append(clientApps, clientApp)
+	}
+	return clientApps, nil
+}
+
+// close closes the MongoDB session.
+func (s *mongoStore) close() {
+	s.session.Close()
+}
+
+// mongoID returns the MongoDB _id of an object with the given ID. The backends store the IDs as object IDs.
+func mongoID(id string) interface{} {
+	if bson.IsObjectIdHex(id) {
+		return bson.ObjectIdHex(id)
+	}
+	return id
+}
+
+// upsert updates the document that matches the selector, or inserts it. Two concurrent upserts of the same
+// new document can both try to insert it, and the one that loses is retried as an update.
+func upsert(collection *mgo.Collection, selector, update interface{}) error {
+	_, err := collection.Upsert(selector, update)
+	if mgo.IsDup(err) {
+		_, err = collection.Upsert(selector, update)
+	}
+	return err
+}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// textIndexName is the name of the text index on the apps collection in MongoDB.
+const textIndexName = "apps_text_search"
+
+// mongoTextSearch searches the apps with a MongoDB text index on the name, description, domain and labels.
+type mongoTextSearch struct {
+	mongo          *mongoStore
+	collectionName string
+}
+
+// newMongoTextSearch creates the text index on the apps collection. The labels are indexed through the
+// labelTerms attribute, which is set for the apps that do not have it yet.
+func newMongoTextSearch(mongo *mongoStore, collectionName string) (*mongoTextSearch, error) {
+	session := mongo.session.Copy()
+	defer session.Close()
+
+	collection := session.DB(mongo.databaseName).C(collectionName)
+	err := collection.EnsureIndex(mgo.Index{
+		Name: textIndexName,
+		Key:  []string{"$text:name", "$text:description", "$text:domain", "$text:labelTerms"},
+		Weights: map[string]int{
+			"name":        10,
+			"domain":      5,
+			"labelTerms":  3,
+			"description": 1,
+		},
+		DefaultLanguage: "none",
+		Background:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var apps []bson.M
+	query := collection.Find(bson.M{"labels": bson.M{"$exists": true}, "labelTerms": bson.M{"$exists": false}}).Select(bson.M{"labels": 1})
+	if err := query.All(&apps); err != nil {
+		return nil, err
+	}
+	for _, clientApp := range apps {
+		if err := collection.UpdateId(clientApp["_id"], bson.M{"$set": bson.M{"labelTerms": labelTerms(appLabels(clientApp))}}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &mongoTextSearch{
+		mongo:          mongo,
+		collectionName: collectionName,
+	}, nil
+}
+
+// searchText runs a text search on the apps collection. The other criteria of the query are part of the
+// MongoDB query, so the limit can be applied by MongoDB.
+func (s *mongoTextSearch) searchText(ctx context.Context, query *SearchQuery) ([]*ClientApp, error) {
+	filter := query.AppFilter.mongoSelector()
+	filter["$text"] = bson.M{"$search": query.Text}
+
+	clientApps, err := s.mongo.findTextMatches(ctx, s.collectionName, filter, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return clientApps, nil
+}
+
+// findTextMatches runs the text search query on the collection, the best matches first.
+func (s *mongoStore) findTextMatches(ctx context.Context, collectionName string, filter bson.M, limit int) ([]*ClientApp, error) {
+	var docs []bson.M
+	err := s.run(ctx, collectionName, func(collection *mgo.Collection) error {
+		find := collection.Find(filter).
+			Select(bson.M{"score": bson.M{"$meta": "textScore"}, "secret": 0}).
+			Sort("$textScore:score")
+		if limit > 0 {
+			find = find.Limit(limit)
+		}
+		return withMaxTime(ctx, find).All(&docs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeMongoApps(docs)
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/Microkubes/backends"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// NormalizeName normalizes an app name for the uniqueness check. The whitespace is trimmed and
+// collapsed to a single space and the letters are case-folded, so "My  App" and "my app" are
+// considered the same name.
+func NormalizeName(name string) string {
+	return strings.Map(foldRune, strings.Join(strings.Fields(name), " "))
+}
+
+// nameKey returns the key under which the name of an app is unique: the owner and the normalized name.
+func nameKey(owner, name string) string {
+	return owner + "/" + NormalizeName(name)
+}
+
+// releasedNameKey returns the name key of a soft-deleted app. It does not hold the name, so the owner can give
+// the name to another app. The keys of the names always contain a slash, so it cannot be taken by a name.
+func releasedNameKey(appID string) string {
+	return "deleted:" + appID
+}
+
+// foldRune returns the same rune for all runes that are equivalent under Unicode simple case folding.
+func foldRune(r rune) rune {
+	folded := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < folded {
+			folded = f
+		}
+	}
+	return unicode.ToLower(folded)
+}
+
+// checkNameAvailable checks that no other app than the one with the given ID uses the name key.
+func (c *BackendAppsManagementStore) checkNameAvailable(ctx context.Context, key, appID string) error {
+	res, err := withContext(ctx, c.repository).GetOne(backends.NewFilter().Match("nameKey", key), &ClientApp{})
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if existing := res.(*ClientApp); existing.ID != appID {
+		return nameTaken(existing.Name)
+	}
+
+	return nil
+}
+
+// updateNameKey sets the name key of an app whose status has changed from the given status. A soft-deleted app
+// releases its name. A restored app takes its name back, unless the owner has given it to another app in the
+// meantime; then the app keeps the released key until the owner renames it.
+func (c *BackendAppsManagementStore) updateNameKey(ctx context.Context, clientApp *ClientApp, appID, from string) error {
+	to := clientApp.CurrentStatus()
+	switch {
+	case to == StatusDeleted && from != StatusDeleted:
+		clientApp.NameKey = releasedNameKey(appID)
+	case from == StatusDeleted && to != StatusDeleted:
+		key := nameKey(clientApp.Owner, clientApp.Name)
+		err := c.checkNameAvailable(ctx, key, appID)
+		if err == nil {
+			clientApp.NameKey = key
+		} else if !backends.IsErrAlreadyExists(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// reclaimName gives a restored app its name back in MongoDB, unless another app of the owner has taken it.
+func (s *mongoStore) reclaimName(ctx context.Context, appID string, clientApp *ClientApp) error {
+	err := s.run(ctx, "apps-management", func(apps *mgo.Collection) error {
+		return apps.Update(
+			bson.M{"_id": mongoID(appID), "nameKey": releasedNameKey(appID)},
+			bson.M{"$set": bson.M{"nameKey": nameKey(clientApp.Owner, clientApp.Name)}},
+		)
+	})
+	if err == mgo.ErrNotFound || mgo.IsDup(err) {
+		return nil
+	}
+	return err
+}
+
+func nameTaken(name string) error {
+	return backends.ErrAlreadyExists("you already have an app with that name", "name", name)
+}
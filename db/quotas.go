@@ -0,0 +1,239 @@
+package db
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Microkubes/backends"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// appCountersCollection is the MongoDB collection with the number of apps of every user, not counting
+// the deleted apps.
+const appCountersCollection = "apps-counters"
+
+// AppCounter is the number of apps of a user, not counting the deleted apps.
+type AppCounter struct {
+	UserID string `bson:"_id"`
+	Count  int    `bson:"count"`
+}
+
+// UserQuota holds the maximal number of apps for a user, set by an admin instead of the default.
+type UserQuota struct {
+	ID      string `json:"id,omitempty" bson:"_id,omitempty"`
+	UserID  string `json:"userId" bson:"userId"`
+	MaxApps int    `json:"maxApps" bson:"maxApps"`
+}
+
+// GetUserQuota returns the maximal number of apps set for a user.
+// Returns a not found error if there is no quota set for the user.
+func (c *BackendAppsManagementStore) GetUserQuota(ctx context.Context, userID string) (*UserQuota, error) {
+	res, err := withContext(ctx, c.quotaRepository).GetOne(backends.NewFilter().Match("userId", userID), &UserQuota{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*UserQuota), nil
+}
+
+// SetUserQuota sets the maximal number of apps for a user.
+func (c *BackendAppsManagementStore) SetUserQuota(ctx context.Context, userID string, maxApps int) (*UserQuota, error) {
+	quota, err := c.GetUserQuota(ctx, userID)
+	if err != nil && !backends.IsErrNotFound(err) {
+		return nil, err
+	}
+
+	var filter backends.Filter
+	if quota != nil {
+		filter = backends.NewFilter().Match("userId", userID)
+	} else {
+		quota = &UserQuota{UserID: userID}
+	}
+	quota.MaxApps = maxApps
+
+	res, err := withContext(ctx, c.quotaRepository).Save(quota, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*UserQuota), nil
+}
+
+// DeleteUserQuota removes the maximal number of apps set for a user.
+func (c *BackendAppsManagementStore) DeleteUserQuota(ctx context.Context, userID string) error {
+	return withContext(ctx, c.quotaRepository).DeleteOne(backends.NewFilter().Match("userId", userID))
+}
+
+// CountUserApps returns the number of apps registered by a user, not counting the deleted apps.
+func (c *BackendAppsManagementStore) CountUserApps(ctx context.Context, userID string) (int, error) {
+	clientApps, err := c.getOwnerApps(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return len(clientApps), nil
+}
+
+// checkAppQuota checks that the user has not registered the maximal number of apps yet.
+// A maxApps of 0 means no limit.
+func (c *BackendAppsManagementStore) checkAppQuota(ctx context.Context, userID string, maxApps int) error {
+	if maxApps <= 0 {
+		return nil
+	}
+
+	used, err := c.CountUserApps(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if used >= maxApps {
+		return quotaExceeded(used, maxApps)
+	}
+
+	return nil
+}
+
+// enforceAppQuota is called after an app has been registered, with the backends that cannot count the
+// apps atomically. Concurrent registrations can pass checkAppQuota at the same time, so the apps of the
+// user are counted again and the new app is kept only if it is among the first maxApps apps of the user.
+// Otherwise, or if the apps cannot be counted, it is deleted. The delete does not depend on the context of
+// the request, so that it is not skipped when the request times out.
+func (c *BackendAppsManagementStore) enforceAppQuota(ctx context.Context, appID, userID string, maxApps int) error {
+	if maxApps <= 0 {
+		return nil
+	}
+
+	clientApps, err := c.getOwnerApps(ctx, userID)
+	if err == nil {
+		sortByRegistration(clientApps)
+		for i, clientApp := range clientApps {
+			if clientApp.ID != appID {
+				continue
+			}
+			if i < maxApps {
+				return nil
+			}
+			break
+		}
+		err = quotaExceeded(len(clientApps)-1, maxApps)
+	}
+
+	if deleteErr := c.repository.DeleteOne(backends.NewFilter().Match("id", appID)); deleteErr != nil && !backends.IsErrNotFound(deleteErr) {
+		return deleteErr
+	}
+
+	return err
+}
+
+// reserveApp counts a new app of the user in MongoDB, unless the user already has maxApps apps, where 0
+// means no limit. The counter is only incremented while it is below maxApps, in a single update, so that
+// concurrent registrations cannot exceed the quota. The app must be released if it is not saved.
+func (c *BackendAppsManagementStore) reserveApp(ctx context.Context, userID string, maxApps int) error {
+	if err := c.initAppCounter(ctx, userID); err != nil {
+		return err
+	}
+
+	selector := bson.M{"_id": userID}
+	if maxApps > 0 {
+		selector["count"] = bson.M{"$lt": maxApps}
+	}
+	err := c.mongo.run(ctx, appCountersCollection, func(counters *mgo.Collection) error {
+		return counters.Update(selector, bson.M{"$inc": bson.M{"count": 1}})
+	})
+	if err != mgo.ErrNotFound {
+		return err
+	}
+
+	counter := &AppCounter{}
+	err = c.mongo.run(ctx, appCountersCollection, func(counters *mgo.Collection) error {
+		return counters.FindId(userID).One(counter)
+	})
+	if err != nil && err != mgo.ErrNotFound {
+		return err
+	}
+	return quotaExceeded(counter.Count, maxApps)
+}
+
+// releaseApp uncounts an app of the user in MongoDB, after its registration failed or it has been deleted.
+// It does not depend on the context of the request, so that the counter is not left too high when the
+// request times out.
+func (c *BackendAppsManagementStore) releaseApp(userID string) error {
+	err := c.mongo.run(context.Background(), appCountersCollection, func(counters *mgo.Collection) error {
+		return counters.Update(bson.M{"_id": userID, "count": bson.M{"$gt": 0}}, bson.M{"$inc": bson.M{"count": -1}})
+	})
+	if err == mgo.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// initAppCounter creates the app counter of a user that does not have one yet, from the apps of the user.
+func (c *BackendAppsManagementStore) initAppCounter(ctx context.Context, userID string) error {
+	err := c.mongo.run(ctx, appCountersCollection, func(counters *mgo.Collection) error {
+		return counters.FindId(userID).One(&AppCounter{})
+	})
+	if err != mgo.ErrNotFound {
+		return err
+	}
+
+	clientApps, err := c.getOwnerApps(ctx, userID)
+	if err != nil {
+		return err
+	}
+	err = c.mongo.run(ctx, appCountersCollection, func(counters *mgo.Collection) error {
+		return counters.Insert(&AppCounter{UserID: userID, Count: len(clientApps)})
+	})
+	if mgo.IsDup(err) {
+		// created by a concurrent registration
+		return nil
+	}
+	return err
+}
+
+// countStatusChange updates the app counter of the owner when an app is soft-deleted or restored.
+func (c *BackendAppsManagementStore) countStatusChange(ctx context.Context, owner, from, to string) error {
+	if c.mongo == nil || (from == StatusDeleted) == (to == StatusDeleted) {
+		return nil
+	}
+	if to == StatusDeleted {
+		return c.releaseApp(owner)
+	}
+	if err := c.initAppCounter(ctx, owner); err != nil {
+		return err
+	}
+	return c.mongo.run(ctx, appCountersCollection, func(counters *mgo.Collection) error {
+		return counters.UpdateId(owner, bson.M{"$inc": bson.M{"count": 1}})
+	})
+}
+
+// getOwnerApps returns the apps of a user, not including the deleted apps.
+func (c *BackendAppsManagementStore) getOwnerApps(ctx context.Context, userID string) ([]*ClientApp, error) {
+	if c.mongo != nil {
+		return c.mongo.findApps(ctx, bson.M{"owner": userID, "status": bson.M{"$ne": StatusDeleted}}, []string{"registeredAt"}, 0, 0, false)
+	}
+
+	var typeHint map[string]interface{}
+	apps, err := withContext(ctx, c.repository).GetAll(backends.NewFilter().Match("owner", userID), typeHint, "registeredat", "asc", 0, 0)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return []*ClientApp{}, nil
+		}
+		return nil, err
+	}
+
+	return decodeClientApps(withoutDeletedApps(apps.(*[]*map[string]interface{})))
+}
+
+// sortByRegistration sorts the apps by the time of registration. Apps registered in the same
+// second are ordered by ID, so all concurrent registrations see the same order.
+func sortByRegistration(clientApps []*ClientApp) {
+	sort.SliceStable(clientApps, func(i, j int) bool {
+		if clientApps[i].RegisteredAt != clientApps[j].RegisteredAt {
+			return clientApps[i].RegisteredAt < clientApps[j].RegisteredAt
+		}
+		return clientApps[i].ID < clientApps[j].ID
+	})
+}
+
+func quotaExceeded(used, maxApps int) error {
+	return ErrQuotaExceeded("the maximal number of apps has been reached", "used", used, "maxApps", maxApps)
+}
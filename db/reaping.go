@@ -0,0 +1,363 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/Microkubes/backends"
+	"github.com/Microkubes/microservice-apps-management/app"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// Lifecycle statuses of an app. Apps without a status are active.
+const (
+	// StatusActive is the status of an app in use.
+	StatusActive = "active"
+	// StatusFlagged is the status of an unused app whose owner has been notified.
+	// The app can still be verified and becomes active again on its next use.
+	StatusFlagged = "flagged"
+	// StatusSuspended is the status of an unused app that can no longer be verified.
+	StatusSuspended = "suspended"
+	// StatusDeleted is the status of a soft-deleted app. The app is hidden and cannot be verified.
+	StatusDeleted = "deleted"
+)
+
+// StatusVersion is the lifecycle status of an app as it has been read, with the time it was set.
+type StatusVersion struct {
+	Status    string
+	ChangedAt int64
+}
+
+// CurrentStatusVersion returns the lifecycle status of the app and the time it was set.
+func (ca *ClientApp) CurrentStatusVersion() *StatusVersion {
+	return &StatusVersion{
+		Status:    ca.CurrentStatus(),
+		ChangedAt: ca.StatusChangedAt,
+	}
+}
+
+// CurrentStatus returns the lifecycle status of the app.
+func (ca *ClientApp) CurrentStatus() string {
+	if ca.Status == "" {
+		return StatusActive
+	}
+	return ca.Status
+}
+
+// CanVerify returns true if the app can be verified with its credentials.
+func (ca *ClientApp) CanVerify() bool {
+	status := ca.CurrentStatus()
+	return status == StatusActive || status == StatusFlagged
+}
+
+// LastActivity returns the time (Unix seconds) of the last use, registration or restore of the app.
+func (ca *ClientApp) LastActivity() int64 {
+	last := ca.RegisteredAt
+	if ca.LastUsedAt > last {
+		last = ca.LastUsedAt
+	}
+	if ca.CurrentStatus() == StatusActive && ca.StatusChangedAt > last {
+		last = ca.StatusChangedAt
+	}
+	return last
+}
+
+// GetAppsUnusedSince returns the apps that are not deleted and have had no activity since the given time.
+// With MongoDB only the unused apps are read, otherwise all apps are read and filtered in memory.
+func (c *BackendAppsManagementStore) GetAppsUnusedSince(ctx context.Context, since time.Time) ([]*ClientApp, error) {
+	if c.mongo != nil {
+		clientApps, err := c.mongo.findApps(ctx, unusedSelector(since), []string{"registeredAt"}, 0, 0, false)
+		if err != nil {
+			return nil, err
+		}
+		return filterUnusedApps(clientApps, since), nil
+	}
+
+	var typeHint map[string]interface{}
+	apps, err := withContext(ctx, c.repository).GetAll(backends.NewFilter(), typeHint, "registeredat", "asc", 0, 0)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return []*ClientApp{}, nil
+		}
+		return nil, err
+	}
+
+	clientApps, err := decodeClientApps(apps)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterUnusedApps(clientApps, since), nil
+}
+
+// SetAppStatus changes the lifecycle status of an app. With MongoDB only the status is updated.
+// A soft-deleted app releases its name, and a restored app takes it back if it is still free.
+func (c *BackendAppsManagementStore) SetAppStatus(ctx context.Context, appID, status string) error {
+	if c.mongo != nil {
+		previous, err := c.mongo.changeStatus(ctx, appID, bson.M{}, status, nil)
+		if err != nil {
+			if err == mgo.ErrNotFound {
+				return backends.ErrNotFound("app not found")
+			}
+			return err
+		}
+		if previous.CurrentStatus() == StatusDeleted && status != StatusDeleted {
+			if err := c.mongo.reclaimName(ctx, appID, previous); err != nil {
+				return err
+			}
+		}
+		return c.countStatusChange(ctx, previous.Owner, previous.CurrentStatus(), status)
+	}
+
+	res, err := withContext(ctx, c.repository).GetOne(backends.NewFilter().Match("id", appID), &ClientApp{})
+	if err != nil {
+		return err
+	}
+	existing := res.(*ClientApp)
+
+	previous := existing.CurrentStatus()
+	existing.Status = status
+	existing.StatusChangedAt = time.Now().Unix()
+	if err := c.updateNameKey(ctx, existing, appID, previous); err != nil {
+		return err
+	}
+
+	if _, err := withContext(ctx, c.repository).Save(existing, backends.NewFilter().Match("id", appID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TransitionAppStatus changes the lifecycle status of an app only if its status is still the one that has
+// been read, and has not been set again since. Otherwise it returns an ErrStatusConflict error. With MongoDB
+// the status is checked and changed in a single update, so that of concurrent transitions from the same
+// status only one succeeds. The other backends can only check the status before saving the app.
+func (c *BackendAppsManagementStore) TransitionAppStatus(ctx context.Context, appID string, from *StatusVersion, status string) error {
+	if c.mongo != nil {
+		changedAt := interface{}(from.ChangedAt)
+		if from.ChangedAt == 0 {
+			changedAt = bson.M{"$in": []interface{}{nil, 0}}
+		}
+		currentStatus := interface{}(from.Status)
+		if from.Status == StatusActive {
+			currentStatus = bson.M{"$in": []interface{}{nil, "", StatusActive}}
+		}
+
+		previous, err := c.mongo.changeStatus(ctx, appID, bson.M{
+			"status":          currentStatus,
+			"statusChangedAt": changedAt,
+		}, status, nil)
+		if err != nil {
+			if err == mgo.ErrNotFound {
+				return statusConflict(appID)
+			}
+			return err
+		}
+		return c.countStatusChange(ctx, previous.Owner, previous.CurrentStatus(), status)
+	}
+
+	res, err := withContext(ctx, c.repository).GetOne(backends.NewFilter().Match("id", appID), &ClientApp{})
+	if err != nil {
+		return err
+	}
+	existing := res.(*ClientApp)
+	if *existing.CurrentStatusVersion() != *from {
+		return statusConflict(appID)
+	}
+
+	existing.Status = status
+	existing.StatusChangedAt = time.Now().Unix()
+	if err := c.updateNameKey(ctx, existing, appID, from.Status); err != nil {
+		return err
+	}
+
+	_, err = withContext(ctx, c.repository).Save(existing, backends.NewFilter().Match("id", appID))
+	return err
+}
+
+// changeStatus sets the status of the app, and the other given fields, if the app matches the selector, and
+// returns the app as it was before. A soft-deleted app releases its name in the same update. Returns
+// mgo.ErrNotFound if the app does not match.
+func (s *mongoStore) changeStatus(ctx context.Context, appID string, selector bson.M, status string, fields bson.M) (*ClientApp, error) {
+	selector["_id"] = mongoID(appID)
+	update := bson.M{
+		"status":          status,
+		"statusChangedAt": time.Now().Unix(),
+	}
+	if status == StatusDeleted {
+		update["nameKey"] = releasedNameKey(appID)
+	}
+	for field, value := range fields {
+		update[field] = value
+	}
+
+	previous := &ClientApp{}
+	err := s.run(ctx, "apps-management", func(apps *mgo.Collection) error {
+		_, err := apps.Find(selector).Apply(mgo.Change{
+			Update: bson.M{"$set": update},
+		}, previous)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
+
+func statusConflict(appID string) error {
+	return ErrStatusConflict("the status of the app has changed in the meantime", "appId", appID)
+}
+
+// SetReapingExempt exempts an app from reaping, or removes the exemption. Exempting an app
+// restores it to active if it has been suspended or deleted. With MongoDB only the changed fields are updated.
+func (c *BackendAppsManagementStore) SetReapingExempt(ctx context.Context, appID string, exempt bool) (*app.Apps, error) {
+	if c.mongo != nil {
+		clientApp, err := c.setMongoReapingExempt(ctx, appID, exempt)
+		if err != nil {
+			if err == mgo.ErrNotFound {
+				return nil, backends.ErrNotFound("app not found")
+			}
+			return nil, err
+		}
+		return clientApp.ToMedia(), nil
+	}
+
+	res, err := withContext(ctx, c.repository).GetOne(backends.NewFilter().Match("id", appID), &ClientApp{})
+	if err != nil {
+		return nil, err
+	}
+	existing := res.(*ClientApp)
+
+	previous := existing.CurrentStatus()
+	existing.ReapingExempt = exempt
+	if exempt && existing.CurrentStatus() != StatusActive {
+		existing.Status = StatusActive
+		existing.StatusChangedAt = time.Now().Unix()
+	}
+	if err := c.updateNameKey(ctx, existing, appID, previous); err != nil {
+		return nil, err
+	}
+
+	res, err = withContext(ctx, c.repository).Save(existing, backends.NewFilter().Match("id", appID))
+	if err != nil {
+		return nil, err
+	}
+
+	clientApp := res.(*ClientApp)
+	clientApp.ID = appID
+
+	return clientApp.ToMedia(), nil
+}
+
+// setMongoReapingExempt sets the reaping exemption of an app in MongoDB. An app that is not active is restored
+// in the same update, which only matches while the app is not active, so that of concurrent exemptions only one
+// restores the app and counts it. An active app only gets the exemption while it is still active.
+func (c *BackendAppsManagementStore) setMongoReapingExempt(ctx context.Context, appID string, exempt bool) (*ClientApp, error) {
+	if !exempt {
+		return c.mongo.updateApp(ctx, appID, bson.M{}, bson.M{"reapingExempt": false})
+	}
+
+	active := []interface{}{nil, "", StatusActive}
+	for attempt := 0; attempt < maxStatusAttempts; attempt++ {
+		previous, err := c.mongo.changeStatus(ctx, appID, bson.M{"status": bson.M{"$nin": active}}, StatusActive,
+			bson.M{"reapingExempt": true})
+		if err == nil {
+			if previous.CurrentStatus() == StatusDeleted {
+				if err := c.mongo.reclaimName(ctx, appID, previous); err != nil {
+					return nil, err
+				}
+			}
+			if err := c.countStatusChange(ctx, previous.Owner, previous.CurrentStatus(), StatusActive); err != nil {
+				return nil, err
+			}
+			return c.mongo.getApp(ctx, appID)
+		}
+		if err != mgo.ErrNotFound {
+			return nil, err
+		}
+
+		clientApp, err := c.mongo.updateApp(ctx, appID, bson.M{"status": bson.M{"$in": active}}, bson.M{"reapingExempt": true})
+		if err != mgo.ErrNotFound {
+			return clientApp, err
+		}
+		// the app does not exist, or its status has changed since the first update
+		if _, err := c.mongo.getApp(ctx, appID); err != nil {
+			return nil, err
+		}
+	}
+	return nil, statusConflict(appID)
+}
+
+// maxStatusAttempts is the number of times a conditional update is tried again after the status of the app
+// has changed in the meantime.
+const maxStatusAttempts = 3
+
+// getClientApp looks up an app by its ID. Soft-deleted apps are reported as not found.
+func (c *BackendAppsManagementStore) getClientApp(ctx context.Context, appID string) (*ClientApp, error) {
+	clientApp, err := c.getAnyApp(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	if clientApp.CurrentStatus() == StatusDeleted {
+		return nil, backends.ErrNotFound("app not found")
+	}
+
+	return clientApp, nil
+}
+
+// getAnyApp looks up an app by its ID, including the soft-deleted apps.
+func (c *BackendAppsManagementStore) getAnyApp(ctx context.Context, appID string) (*ClientApp, error) {
+	if c.mongo != nil {
+		return c.mongo.getApp(ctx, appID)
+	}
+
+	res, err := withContext(ctx, c.repository).GetOne(backends.NewFilter().Match("id", appID), &ClientApp{})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ClientApp), nil
+}
+
+// filterUnusedApps returns the apps that are not deleted and have had no activity since the given time.
+func filterUnusedApps(clientApps []*ClientApp, since time.Time) []*ClientApp {
+	unused := []*ClientApp{}
+	for _, clientApp := range clientApps {
+		if clientApp.CurrentStatus() == StatusDeleted {
+			continue
+		}
+		if clientApp.LastActivity() < since.Unix() {
+			unused = append(unused, clientApp)
+		}
+	}
+	return unused
+}
+
+// unusedSelector returns the MongoDB selector of the apps that filterUnusedApps keeps: the apps that are not
+// deleted, and were not registered, used or reactivated since the given time.
+func unusedSelector(since time.Time) bson.M {
+	notSince := bson.M{"$not": bson.M{"$gte": since.Unix()}}
+	return bson.M{
+		"status":       bson.M{"$ne": StatusDeleted},
+		"registeredAt": bson.M{"$lt": since.Unix()},
+		"lastUsedAt":   notSince,
+		"$or": []bson.M{
+			{"status": bson.M{"$nin": []interface{}{nil, "", StatusActive}}},
+			{"statusChangedAt": notSince},
+		},
+	}
+}
+
+// withoutDeletedApps removes the soft-deleted apps from a list of serialized apps.
+func withoutDeletedApps(apps *[]*map[string]interface{}) []*map[string]interface{} {
+	result := []*map[string]interface{}{}
+	for _, clientApp := range *apps {
+		if status, ok := (*clientApp)["status"]; ok && status == StatusDeleted {
+			continue
+		}
+		result = append(result, clientApp)
+	}
+	return result
+}
@@ -1,31 +1,65 @@
 package db
 
 import (
+	"context"
 	"crypto/rand"
+	"crypto/subtle"
 	"encoding/base64"
 	"encoding/json"
 	"time"
 
+	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 
 	"github.com/Microkubes/backends"
 	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/Microkubes/microservice-apps-management/idempotency"
+	"github.com/Microkubes/microservice-apps-management/labels"
+	"github.com/Microkubes/microservice-apps-management/origins"
 	"github.com/Microkubes/microservice-tools/config"
 	"github.com/asaskevich/govalidator"
 	"github.com/keitaroinc/goa"
 )
 
 // AppsManagementStore defaines the interface for accessing the application data.
+// Every operation takes the context of the request or of the background job that runs it, and returns
+// an ErrStoreTimeout or ErrStoreCanceled error when the context is done first. With MongoDB the reads of
+// the apps, the counters and the conditional updates run on the driver, which stops them at the deadline.
+// The other operations run on the backends, which take no context: a read is abandoned, and keeps running
+// in the database until it ends, and a write is only checked before it starts.
 type AppsManagementStore interface {
 	// GetApp looks up a applications by the app ID.
-	GetApp(appID string) (*app.Apps, error)
-	GetMyApps(userID string) ([]byte, error)
-	GetUserApps(userID string) ([]byte, error)
-	RegisterApp(payload *app.AppPayload, userID string) (*app.RegApps, error)
-	DeleteApp(appID string) error
-	UpdateApp(payload *app.AppPayload, appID string) (*app.Apps, error)
-	RegenerateSecret(appID string) ([]byte, error)
-	FindApp(id, secret string) (*ClientApp, error)
+	GetApp(ctx context.Context, appID string) (*app.Apps, error)
+	GetMyApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error)
+	GetUserApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error)
+	RegisterApp(ctx context.Context, payload *app.AppPayload, userID string, maxApps int) (*app.RegApps, error)
+	DeleteApp(ctx context.Context, appID string) error
+	UpdateApp(ctx context.Context, payload *app.AppPayload, appID string) (*app.Apps, error)
+	RegenerateSecret(ctx context.Context, appID string) (*ClientApp, error)
+	FindApp(ctx context.Context, id, secret string) (*ClientApp, error)
+	SetRateLimit(ctx context.Context, appID string, payload *app.RateLimitPayload) (*app.RateLimit, error)
+	DeleteRateLimit(ctx context.Context, appID string) error
+	RecordUsage(ctx context.Context, record *UsageRecord) error
+	GetAppUsage(ctx context.Context, appID string, from, to time.Time) (*app.AppUsage, error)
+	GetAppsUnusedSince(ctx context.Context, since time.Time) ([]*ClientApp, error)
+	SetAppStatus(ctx context.Context, appID, status string) error
+	TransitionAppStatus(ctx context.Context, appID string, from *StatusVersion, status string) error
+	SetReapingExempt(ctx context.Context, appID string, exempt bool) (*app.Apps, error)
+	GetClientApp(ctx context.Context, appID string) (*ClientApp, error)
+	IssueVerificationToken(ctx context.Context, appID string) (*ClientApp, error)
+	SaveVerificationResult(ctx context.Context, appID string, result *VerificationResult) (*ClientApp, error)
+	GetAppsToReverify(ctx context.Context, checkedBefore time.Time) ([]*ClientApp, error)
+	GetUserQuota(ctx context.Context, userID string) (*UserQuota, error)
+	SetUserQuota(ctx context.Context, userID string, maxApps int) (*UserQuota, error)
+	DeleteUserQuota(ctx context.Context, userID string) error
+	CountUserApps(ctx context.Context, userID string) (int, error)
+	SearchApps(ctx context.Context, query *SearchQuery) ([]*ClientApp, error)
+	ListApps(ctx context.Context, filter *AppFilter, page *Page) (*AppPage, error)
+	CountAppsByStatus(ctx context.Context) (map[string]int, error)
+	SetAppLogo(ctx context.Context, appID string, logo *AppLogo) (*ClientApp, error)
+	SetAppLabels(ctx context.Context, appID string, set map[string]string, remove []string) (*ClientApp, error)
+	IdempotencyStore(ttl, lease time.Duration) idempotency.Store
+	Ping(ctx context.Context) error
 }
 
 // ClientApp holds the data for a registered application (client).
@@ -37,94 +71,157 @@ type ClientApp struct {
 	Owner        string `json:"owner" bson:"owner"`
 	RegisteredAt int64  `json:"registeredAt" bson:"registeredAt"`
 	Secret       string `json:"secret" bson:"secret"`
-}
+	NameKey      string `json:"nameKey,omitempty" bson:"nameKey"`
 
-// BackendAppsManagementStore holds a repository for a certain backend.
-// Implements the AppsManagementStore interface.
-type BackendAppsManagementStore struct {
-	repository backends.Repository
-}
+	Origins     []string          `json:"origins,omitempty" bson:"origins"`
+	Labels      map[string]string `json:"labels,omitempty" bson:"labels"`
+	Annotations map[string]string `json:"annotations,omitempty" bson:"annotations"`
+	LabelTerms  []string          `json:"labelTerms,omitempty" bson:"labelTerms"`
 
-// GetApp retrieves an application by id
-func (c *BackendAppsManagementStore) GetApp(appID string) (*app.Apps, error) {
-	res, err := c.repository.GetOne(backends.NewFilter().Match("id", appID), &ClientApp{})
-	if err != nil {
-		return nil, err
-	}
+	RateLimit *RateLimitPolicy `json:"rateLimit,omitempty" bson:"rateLimit"`
 
-	clientApp := res.(*ClientApp)
+	Logo *AppLogo `json:"logo,omitempty" bson:"logo"`
 
-	return &app.Apps{
-		Description:  clientApp.Description,
-		Domain:       clientApp.Domain,
-		ID:           appID,
-		Name:         clientApp.Name,
-		Owner:        clientApp.Owner,
-		RegisteredAt: int(clientApp.RegisteredAt),
-	}, nil
-}
+	PrivacyPolicyURL  string   `json:"privacyPolicyUrl,omitempty" bson:"privacyPolicyUrl"`
+	TermsOfServiceURL string   `json:"termsOfServiceUrl,omitempty" bson:"termsOfServiceUrl"`
+	SupportEmail      string   `json:"supportEmail,omitempty" bson:"supportEmail"`
+	Contacts          []string `json:"contacts,omitempty" bson:"contacts"`
 
-// GetMyApps retrieves applications for current user
-func (c *BackendAppsManagementStore) GetMyApps(userID string) ([]byte, error) {
-	var typeHint map[string]interface{}
-	apps, err := c.repository.GetAll(backends.NewFilter().Match("owner", userID), typeHint, "registeredat", "asc", 0, 0)
-	if err != nil {
-		return nil, err
-	}
+	LastUsedAt int64  `json:"lastUsedAt,omitempty" bson:"lastUsedAt"`
+	LastUsedIP string `json:"lastUsedIP,omitempty" bson:"lastUsedIP"`
 
-	appsSerialized := apps.(*[]*map[string]interface{})
-	appsValue := *appsSerialized
+	Status          string `json:"status,omitempty" bson:"status"`
+	StatusChangedAt int64  `json:"statusChangedAt,omitempty" bson:"statusChangedAt"`
+	ReapingExempt   bool   `json:"reapingExempt,omitempty" bson:"reapingExempt"`
 
-	if len(appsValue) == 0 {
-		return nil, goa.ErrNotFound("no apps found")
+	DomainVerified        bool   `json:"domainVerified,omitempty" bson:"domainVerified"`
+	VerifiedAt            int64  `json:"verifiedAt,omitempty" bson:"verifiedAt"`
+	VerificationToken     string `json:"verificationToken,omitempty" bson:"verificationToken"`
+	VerificationMethod    string `json:"verificationMethod,omitempty" bson:"verificationMethod"`
+	VerificationCheckedAt int64  `json:"verificationCheckedAt,omitempty" bson:"verificationCheckedAt"`
+	VerificationError     string `json:"verificationError,omitempty" bson:"verificationError"`
+	VerificationFailures  int    `json:"verificationFailures,omitempty" bson:"verificationFailures"`
+}
+
+// RateLimitPolicy holds the rate limits and quotas that the API gateway and the
+// resource servers should enforce for an app. Zero values mean no limit.
+type RateLimitPolicy struct {
+	RequestsPerSecond int `json:"requestsPerSecond" bson:"requestsPerSecond"`
+	RequestsPerMinute int `json:"requestsPerMinute" bson:"requestsPerMinute"`
+	RequestsPerDay    int `json:"requestsPerDay" bson:"requestsPerDay"`
+	Burst             int `json:"burst" bson:"burst"`
+	MonthlyQuota      int `json:"monthlyQuota" bson:"monthlyQuota"`
+}
+
+// ToMedia converts the policy to its media type representation. Returns nil for a nil policy.
+func (p *RateLimitPolicy) ToMedia() *app.RateLimit {
+	if p == nil {
+		return nil
+	}
+	return &app.RateLimit{
+		RequestsPerSecond: p.RequestsPerSecond,
+		RequestsPerMinute: p.RequestsPerMinute,
+		RequestsPerDay:    p.RequestsPerDay,
+		Burst:             p.Burst,
+		MonthlyQuota:      p.MonthlyQuota,
 	}
+}
 
-	for _, client := range appsValue {
-		clientValue := *client
-		delete(clientValue, "secret")
+// NewRateLimitPolicy creates a RateLimitPolicy from the payload. Missing values are set to zero (no limit).
+func NewRateLimitPolicy(payload *app.RateLimitPayload) *RateLimitPolicy {
+	policy := &RateLimitPolicy{}
+	if payload.RequestsPerSecond != nil {
+		policy.RequestsPerSecond = *payload.RequestsPerSecond
+	}
+	if payload.RequestsPerMinute != nil {
+		policy.RequestsPerMinute = *payload.RequestsPerMinute
+	}
+	if payload.RequestsPerDay != nil {
+		policy.RequestsPerDay = *payload.RequestsPerDay
 	}
+	if payload.Burst != nil {
+		policy.Burst = *payload.Burst
+	}
+	if payload.MonthlyQuota != nil {
+		policy.MonthlyQuota = *payload.MonthlyQuota
+	}
+	return policy
+}
 
-	res, err := json.Marshal(apps)
+// BackendAppsManagementStore holds a repository for a certain backend.
+// Implements the AppsManagementStore interface.
+type BackendAppsManagementStore struct {
+	repository            backends.Repository
+	usageRepository       backends.Repository
+	quotaRepository       backends.Repository
+	searcher              textSearcher
+	idempotencyRepository backends.Repository
+	// mongo runs the atomic operations on MongoDB. It is nil with the other backends.
+	mongo *mongoStore
+}
 
+// GetApp retrieves an application by id
+func (c *BackendAppsManagementStore) GetApp(ctx context.Context, appID string) (*app.Apps, error) {
+	clientApp, err := c.getClientApp(ctx, appID)
 	if err != nil {
-		return nil, goa.ErrInternal(err)
+		return nil, err
 	}
 
-	return res, nil
+	return clientApp.ToMedia(), nil
+}
+
+// GetMyApps retrieves applications for current user
+func (c *BackendAppsManagementStore) GetMyApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
+	return c.getSelectedApps(ctx, userID, selector)
 }
 
 // GetUserApps retrieves applications for a user
-func (c *BackendAppsManagementStore) GetUserApps(userID string) ([]byte, error) {
+func (c *BackendAppsManagementStore) GetUserApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
+	return c.getSelectedApps(ctx, userID, selector)
+}
+
+// getSelectedApps retrieves the apps of a user that are not deleted and have labels that satisfy the selector.
+func (c *BackendAppsManagementStore) getSelectedApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
 	var typeHint map[string]interface{}
-	apps, err := c.repository.GetAll(backends.NewFilter().Match("owner", userID), typeHint, "registeredat", "asc", 0, 0)
+	apps, err := withContext(ctx, c.repository).GetAll(ownerFilter(userID, selector), typeHint, "registeredat", "asc", 0, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	appsSerialized := apps.(*[]*map[string]interface{})
-	appsValue := *appsSerialized
+	appsValue := selectApps(withoutDeletedApps(apps.(*[]*map[string]interface{})), selector)
 
 	if len(appsValue) == 0 {
 		return nil, goa.ErrNotFound("no apps found")
 	}
 
-	res, err := json.Marshal(apps)
+	return decodeClientApps(appsValue)
+}
 
+// decodeClientApps converts the apps read from the backend as maps to ClientApps.
+func decodeClientApps(apps interface{}) ([]*ClientApp, error) {
+	data, err := json.Marshal(apps)
 	if err != nil {
 		return nil, goa.ErrInternal(err)
 	}
-
-	return res, nil
+	clientApps := []*ClientApp{}
+	if err := json.Unmarshal(data, &clientApps); err != nil {
+		return nil, goa.ErrInternal(err)
+	}
+	return clientApps, nil
 }
 
-// RegisterApp creates a new application for a user
-func (c *BackendAppsManagementStore) RegisterApp(payload *app.AppPayload, userID string) (*app.RegApps, error) {
-	existing, err := c.repository.GetOne(backends.NewFilter().Match("name", payload.Name), &ClientApp{})
-	if err != nil && err.Error() != "not found" {
-		return nil, goa.ErrInternal(err)
+// RegisterApp creates a new application for a user. The user can have at most maxApps apps,
+// where 0 means no limit.
+func (c *BackendAppsManagementStore) RegisterApp(ctx context.Context, payload *app.AppPayload, userID string, maxApps int) (*app.RegApps, error) {
+	key := nameKey(userID, payload.Name)
+	if err := c.checkNameAvailable(ctx, key, ""); err != nil {
+		return nil, err
 	}
-	if existing != nil {
-		return nil, goa.ErrBadRequest("that application already exists")
+
+	if c.mongo == nil {
+		if err := c.checkAppQuota(ctx, userID, maxApps); err != nil {
+			return nil, err
+		}
 	}
 
 	registeredAt := int64(time.Now().Unix())
@@ -135,6 +232,16 @@ func (c *BackendAppsManagementStore) RegisterApp(payload *app.AppPayload, userID
 	if err := validateDomain(*payload.Domain); err != nil {
 		return nil, err
 	}
+	allowedOrigins, err := normalizeOrigins(payload.Origins)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateLabels(payload); err != nil {
+		return nil, err
+	}
+	if err := validateConsentInfo(payload); err != nil {
+		return nil, err
+	}
 
 	clientApp := &ClientApp{
 		Name:         payload.Name,
@@ -143,26 +250,49 @@ func (c *BackendAppsManagementStore) RegisterApp(payload *app.AppPayload, userID
 		Owner:        userID,
 		Secret:       secret,
 		RegisteredAt: registeredAt,
+		NameKey:      key,
+		Origins:      allowedOrigins,
+		Labels:       payload.Labels,
+		Annotations:  payload.Annotations,
+		LabelTerms:   labelTerms(payload.Labels),
+	}
+	applyConsentInfo(clientApp, payload)
+
+	if c.mongo != nil {
+		if err := c.reserveApp(ctx, userID, maxApps); err != nil {
+			return nil, err
+		}
 	}
 
-	res, err := c.repository.Save(clientApp, nil)
+	res, err := withContext(ctx, c.repository).Save(clientApp, nil)
 
 	if err != nil {
+		if c.mongo != nil {
+			c.releaseApp(userID)
+		}
+		if backends.IsErrAlreadyExists(err) {
+			return nil, nameTaken(payload.Name)
+		}
 		return nil, err
 	}
 
 	ca := res.(*ClientApp)
-	regApp := &app.RegApps{
-		ID:     ca.ID,
-		Secret: secret,
+	if c.mongo == nil {
+		if err := c.enforceAppQuota(ctx, ca.ID, userID, maxApps); err != nil {
+			return nil, err
+		}
 	}
 
-	return regApp, nil
+	return ca.ToRegMedia(), nil
 }
 
 // DeleteApp deletes an application by id
-func (c *BackendAppsManagementStore) DeleteApp(appID string) error {
-	err := c.repository.DeleteOne(backends.NewFilter().Match("id", appID))
+func (c *BackendAppsManagementStore) DeleteApp(ctx context.Context, appID string) error {
+	if c.mongo != nil {
+		return c.deleteCountedApp(ctx, appID)
+	}
+
+	err := withContext(ctx, c.repository).DeleteOne(backends.NewFilter().Match("id", appID))
 	if err != nil {
 		if err.Error() == "not found" {
 			return goa.ErrNotFound("no app found!")
@@ -173,90 +303,243 @@ func (c *BackendAppsManagementStore) DeleteApp(appID string) error {
 	return nil
 }
 
+// deleteCountedApp deletes an app in MongoDB and uncounts it, unless it has been soft-deleted before. The
+// app is removed and returned in a single operation, so that concurrent deletes uncount it only once.
+func (c *BackendAppsManagementStore) deleteCountedApp(ctx context.Context, appID string) error {
+	deleted := &ClientApp{}
+	err := c.mongo.run(ctx, "apps-management", func(apps *mgo.Collection) error {
+		_, err := apps.FindId(mongoID(appID)).Apply(mgo.Change{Remove: true}, deleted)
+		return err
+	})
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return goa.ErrNotFound("no app found!")
+		}
+		return err
+	}
+
+	if deleted.CurrentStatus() != StatusDeleted {
+		return c.releaseApp(deleted.Owner)
+	}
+	return nil
+}
+
 // UpdateApp updates an application by id
-func (c *BackendAppsManagementStore) UpdateApp(payload *app.AppPayload, appID string) (*app.Apps, error) {
-	res, err := c.repository.GetOne(backends.NewFilter().Match("id", appID), &ClientApp{})
+func (c *BackendAppsManagementStore) UpdateApp(ctx context.Context, payload *app.AppPayload, appID string) (*app.Apps, error) {
+	existing, err := c.getClientApp(ctx, appID)
 	if err != nil {
 		return nil, err
 	}
-	existing := res.(*ClientApp)
+
+	key := nameKey(existing.Owner, payload.Name)
+	if err := c.checkNameAvailable(ctx, key, appID); err != nil {
+		return nil, err
+	}
+	if err := validateLabels(payload); err != nil {
+		return nil, err
+	}
+	if err := validateConsentInfo(payload); err != nil {
+		return nil, err
+	}
 
 	existing.Name = payload.Name
+	existing.NameKey = key
+	changed := []string{"name", "nameKey"}
 
 	if payload.Description != nil {
 		existing.Description = *payload.Description
+		changed = append(changed, "description")
 	}
 
-	if payload.Domain != nil {
+	if payload.Domain != nil && *payload.Domain != existing.Domain {
 		existing.Domain = *payload.Domain
+		resetDomainVerification(existing)
+		changed = append(changed, "domain")
+		changed = append(changed, verificationFields...)
+		changed = append(changed, "verificationToken")
 	}
 
-	res, err = c.repository.Save(existing, backends.NewFilter().Match("id", appID))
+	if payload.Origins != nil {
+		allowedOrigins, err := normalizeOrigins(payload.Origins)
+		if err != nil {
+			return nil, err
+		}
+		existing.Origins = allowedOrigins
+		changed = append(changed, "origins")
+	}
+
+	if payload.Labels != nil {
+		existing.Labels = payload.Labels
+		existing.LabelTerms = labelTerms(payload.Labels)
+		changed = append(changed, "labels", "labelTerms")
+	}
+
+	if payload.Annotations != nil {
+		existing.Annotations = payload.Annotations
+		changed = append(changed, "annotations")
+	}
+
+	changed = append(changed, applyConsentInfo(existing, payload)...)
+
+	clientApp, err := c.saveApp(ctx, appID, existing, changed...)
 	if err != nil {
-		if err.Error() == "not found" {
+		if err.Error() == "not found" || backends.IsErrNotFound(err) {
 			return nil, goa.ErrNotFound("application not found.")
 		}
+		if backends.IsErrAlreadyExists(err) {
+			return nil, nameTaken(payload.Name)
+		}
 		return nil, goa.ErrInternal(err)
 	}
 
-	clientApp := res.(*ClientApp)
-
-	return &app.Apps{
-		Description:  clientApp.Description,
-		Domain:       clientApp.Domain,
-		ID:           appID,
-		Name:         clientApp.Name,
-		Owner:        clientApp.Owner,
-		RegisteredAt: int(clientApp.RegisteredAt),
-	}, nil
+	return clientApp.ToMedia(), nil
 }
 
 // RegenerateSecret creates a new secret for an application by id
-func (c *BackendAppsManagementStore) RegenerateSecret(appID string) ([]byte, error) {
+func (c *BackendAppsManagementStore) RegenerateSecret(ctx context.Context, appID string) (*ClientApp, error) {
 	secret, err := GenerateRandomString(42)
 	if err != nil {
 		return nil, goa.ErrInternal(err)
 	}
 
-	res, err := c.repository.GetOne(backends.NewFilter().Match("id", appID), &ClientApp{})
+	existing, err := c.getClientApp(ctx, appID)
 	if err != nil {
 		return nil, err
 	}
-	existing := res.(*ClientApp)
 
 	existing.Secret = secret
 
-	client, err := c.repository.Save(existing, backends.NewFilter().Match("id", appID))
+	clientApp, err := c.saveApp(ctx, appID, existing, "secret")
 	if err != nil {
-		if err.Error() == "not found" {
+		if err.Error() == "not found" || backends.IsErrNotFound(err) {
 			return nil, goa.ErrNotFound("application not found.")
 		}
 		return nil, goa.ErrInternal(err)
 	}
 
-	resp, err := json.Marshal(client)
+	return clientApp, nil
+}
+
+// FindApp tries to find an application (client) by its ID and secret.
+// Returns a not found error for unknown, soft-deleted and suspended apps, and nil if the secret does not match.
+func (c *BackendAppsManagementStore) FindApp(ctx context.Context, ID, secret string) (*ClientApp, error) {
+	ca, err := c.getAnyApp(ctx, ID)
 	if err != nil {
+		return nil, err
+	}
+
+	return checkCredentials(ca, secret)
+}
+
+// checkCredentials returns the app if it can be verified and the secret matches, comparing the secrets in
+// constant time. Returns a not found error if the app cannot be verified, and nil if the secret does not match.
+func checkCredentials(ca *ClientApp, secret string) (*ClientApp, error) {
+	if !ca.CanVerify() {
+		return nil, backends.ErrNotFound("app not found")
+	}
+	if subtle.ConstantTimeCompare([]byte(ca.Secret), []byte(secret)) != 1 {
+		return nil, nil
+	}
+	return ca, nil
+}
+
+// SetRateLimit sets the rate limit and quota policy for an application by id
+func (c *BackendAppsManagementStore) SetRateLimit(ctx context.Context, appID string, payload *app.RateLimitPayload) (*app.RateLimit, error) {
+	existing, err := c.getClientApp(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing.RateLimit = NewRateLimitPolicy(payload)
+
+	clientApp, err := c.saveApp(ctx, appID, existing, "rateLimit")
+	if err != nil {
+		if err.Error() == "not found" || backends.IsErrNotFound(err) {
+			return nil, goa.ErrNotFound("application not found.")
+		}
 		return nil, goa.ErrInternal(err)
 	}
 
-	return resp, nil
+	return clientApp.RateLimit.ToMedia(), nil
 }
 
-// FindApp tries to find an application (client) by its ID and secret.
-// Returns nil if no such app is found.
-func (c *BackendAppsManagementStore) FindApp(ID, secret string) (*ClientApp, error) {
-	clientApp, err := c.repository.GetOne(backends.NewFilter().Match("id", ID), &ClientApp{})
+// DeleteRateLimit removes the rate limit and quota policy of an application by id
+func (c *BackendAppsManagementStore) DeleteRateLimit(ctx context.Context, appID string) error {
+	existing, err := c.getClientApp(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	existing.RateLimit = nil
+
+	if _, err = c.saveApp(ctx, appID, existing, "rateLimit"); err != nil {
+		if err.Error() == "not found" || backends.IsErrNotFound(err) {
+			return goa.ErrNotFound("application not found.")
+		}
+		return goa.ErrInternal(err)
+	}
+
+	return nil
+}
+
+// saveApp saves the changed fields of an app, given by their names in the database. With MongoDB only these
+// fields are set, and only if the app has not been soft-deleted in the meantime, so that the concurrent updates
+// of the other fields, like the last use of the app, are kept. The other backends save the whole app.
+func (c *BackendAppsManagementStore) saveApp(ctx context.Context, appID string, existing *ClientApp, changed ...string) (*ClientApp, error) {
+	if c.mongo != nil {
+		fields, err := appFields(existing, changed)
+		if err != nil {
+			return nil, err
+		}
+		clientApp, err := c.mongo.updateApp(ctx, appID, bson.M{"status": bson.M{"$ne": StatusDeleted}}, fields)
+		if err != nil {
+			if err == mgo.ErrNotFound {
+				return nil, backends.ErrNotFound("app not found")
+			}
+			if mgo.IsDup(err) {
+				return nil, backends.ErrAlreadyExists("app already exists")
+			}
+			return nil, err
+		}
+		return clientApp, nil
+	}
+
+	res, err := withContext(ctx, c.repository).Save(existing, backends.NewFilter().Match("id", appID))
 	if err != nil {
 		return nil, err
 	}
 
-	ca := clientApp.(*ClientApp)
+	clientApp := res.(*ClientApp)
+	clientApp.ID = appID
+
+	return clientApp, nil
+}
 
-	if ca.Secret == secret {
-		return ca, nil
+// appFields returns the values of the given fields of the app, by their names in the database.
+func appFields(clientApp *ClientApp, names []string) (bson.M, error) {
+	data, err := bson.Marshal(clientApp)
+	if err != nil {
+		return nil, goa.ErrInternal(err)
+	}
+	doc := bson.M{}
+	if err := bson.Unmarshal(data, doc); err != nil {
+		return nil, goa.ErrInternal(err)
 	}
 
-	return nil, nil
+	fields := bson.M{}
+	for _, name := range names {
+		fields[name] = doc[name]
+	}
+	return fields, nil
+}
+
+// Ping checks that the backend is reachable, by reading at most one app.
+func (c *BackendAppsManagementStore) Ping(ctx context.Context) error {
+	var typeHint map[string]interface{}
+	if _, err := withContext(ctx, c.repository).GetAll(backends.NewFilter(), typeHint, "", "", 1, 0); err != nil && !backends.IsErrNotFound(err) {
+		return err
+	}
+	return nil
 }
 
 // NewAppsManagementStore creates new AppsManagementStore implementation that supports multiple backend types.
@@ -274,12 +557,20 @@ func NewAppsManagementStore(cfg *config.DBConfig) (store AppsManagementStore, cl
 		backend.Shutdown()
 	}
 
+	if cfg.DBName == "mongodb" {
+		if err := migrateNameIndex(&cfg.DBInfo, "apps-management"); err != nil {
+			return nil, noop, err
+		}
+	}
+
 	repo, err := backend.DefineRepository("apps-management", backends.RepositoryDefinitionMap{
 		"name": "apps-management",
 		"indexes": []backends.Index{
 			backends.NewUniqueIndex("id"),
-			backends.NewUniqueIndex("name"),
+			backends.NewNonUniqueIndex("name"),
+			backends.NewUniqueIndex("nameKey"),
 			backends.NewNonUniqueIndex("registeredAt"),
+			backends.NewNonUniqueIndex("owner"),
 		},
 		"hashKey":       "id",
 		"rangeKey":      "name",
@@ -290,14 +581,115 @@ func NewAppsManagementStore(cfg *config.DBConfig) (store AppsManagementStore, cl
 				"readCapacity":  1,
 				"writeCapacity": 1,
 			},
+			"owner": map[string]interface{}{
+				"readCapacity":  1,
+				"writeCapacity": 1,
+			},
+			"nameKey": map[string]interface{}{
+				"readCapacity":  1,
+				"writeCapacity": 1,
+			},
 		},
 	})
 	if err != nil {
 		return nil, noop, err
 	}
 
+	usageRepo, err := backend.DefineRepository("apps-usage", backends.RepositoryDefinitionMap{
+		"name": "apps-usage",
+		"indexes": []backends.Index{
+			backends.NewUniqueIndex("id"),
+			backends.NewUniqueIndex("key"),
+			backends.NewNonUniqueIndex("appId"),
+		},
+		"hashKey":       "id",
+		"rangeKey":      "key",
+		"readCapacity":  10,
+		"writeCapacity": 10,
+		"GSI": map[string]interface{}{
+			"appId": map[string]interface{}{
+				"readCapacity":  1,
+				"writeCapacity": 1,
+			},
+		},
+	})
+	if err != nil {
+		return nil, noop, err
+	}
+
+	quotaRepo, err := backend.DefineRepository("apps-quotas", backends.RepositoryDefinitionMap{
+		"name": "apps-quotas",
+		"indexes": []backends.Index{
+			backends.NewUniqueIndex("id"),
+			backends.NewUniqueIndex("userId"),
+		},
+		"hashKey":       "id",
+		"rangeKey":      "userId",
+		"readCapacity":  1,
+		"writeCapacity": 1,
+		"GSI": map[string]interface{}{
+			"userId": map[string]interface{}{
+				"readCapacity":  1,
+				"writeCapacity": 1,
+			},
+		},
+	})
+	if err != nil {
+		return nil, noop, err
+	}
+
+	idempotencyRepo, err := backend.DefineRepository("apps-idempotency", backends.RepositoryDefinitionMap{
+		"name": "apps-idempotency",
+		"indexes": []backends.Index{
+			backends.NewUniqueIndex("id"),
+			backends.NewUniqueIndex("key"),
+		},
+		"hashKey":       "id",
+		"rangeKey":      "key",
+		"readCapacity":  1,
+		"writeCapacity": 1,
+		"GSI": map[string]interface{}{
+			"key": map[string]interface{}{
+				"readCapacity":  1,
+				"writeCapacity": 1,
+			},
+		},
+	})
+	if err != nil {
+		return nil, noop, err
+	}
+	if cfg.DBName == "mongodb" {
+		if err := ensureIdempotencyExpiry(&cfg.DBInfo, "apps-idempotency"); err != nil {
+			return nil, noop, err
+		}
+	}
+
+	var mongo *mongoStore
+	var searcher textSearcher
+	if cfg.DBName == "mongodb" {
+		mongo, err = newMongoStore(&cfg.DBInfo)
+		if err != nil {
+			return nil, noop, err
+		}
+		mongoSearch, err := newMongoTextSearch(mongo, "apps-management")
+		if err != nil {
+			mongo.close()
+			return nil, noop, err
+		}
+		searcher = mongoSearch
+		cleanup = func() {
+			mongo.close()
+			backend.Shutdown()
+		}
+	}
+
 	store = &BackendAppsManagementStore{
-		repository: repo,
+		repository:            repo,
+		usageRepository:       usageRepo,
+		quotaRepository:       quotaRepo,
+		searcher:              searcher,
+		idempotencyRepository: idempotencyRepo,
+		mongo:                 mongo,
 	}
 
 	return store, cleanup, err
@@ -350,3 +742,12 @@ func validateDomain(domain string) error {
 
 	return nil
 }
+
+// normalizeOrigins validates the allowed origins of an app and returns them in canonical form.
+func normalizeOrigins(allowedOrigins []string) ([]string, error) {
+	normalized, err := origins.NormalizeAll(allowedOrigins)
+	if err != nil {
+		return nil, backends.ErrInvalidInput(err)
+	}
+	return normalized, nil
+}
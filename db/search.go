@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/Microkubes/backends"
+)
+
+// SearchQuery holds the criteria of an app search. The zero value of a criterion means no restriction.
+type SearchQuery struct {
+	// Text holds the words to search for. An app matches if it contains any of the words.
+	Text string
+	// AppFilter restricts the search by status, owner, domain and registration period.
+	AppFilter
+	// Limit is the maximal number of apps in the result.
+	Limit int
+}
+
+// textSearcher finds apps with a text index of the backend.
+type textSearcher interface {
+	// searchText returns the apps that match the text and the criteria of the query, the best matches first.
+	searchText(ctx context.Context, query *SearchQuery) ([]*ClientApp, error)
+}
+
+// SearchApps finds the apps that match the query. The text index of the backend is used when there is one,
+// otherwise the apps are matched in memory.
+func (c *BackendAppsManagementStore) SearchApps(ctx context.Context, query *SearchQuery) ([]*ClientApp, error) {
+	if c.searcher != nil && len(searchTerms(query.Text)) > 0 {
+		clientApps, err := c.searcher.searchText(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		return searchResults(filterApps(clientApps, &query.AppFilter), query.Limit), nil
+	}
+
+	var typeHint map[string]interface{}
+	apps, err := withContext(ctx, c.repository).GetAll(query.backendFilter(), typeHint, "registeredat", "asc", 0, 0)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return []*ClientApp{}, nil
+		}
+		return nil, err
+	}
+
+	clientApps, err := decodeClientApps(apps)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchResults(rankApps(clientApps, query), query.Limit), nil
+}
+
+// searchResults returns at most limit apps.
+func searchResults(clientApps []*ClientApp, limit int) []*ClientApp {
+	if limit > 0 && len(clientApps) > limit {
+		return clientApps[:limit]
+	}
+	return clientApps
+}
+
+// rankApps returns the apps that match the query, ordered by the number of matched words and then by
+// the time of registration. Without words all apps that match the other criteria are returned.
+func rankApps(clientApps []*ClientApp, query *SearchQuery) []*ClientApp {
+	terms := searchTerms(query.Text)
+	filtered := filterApps(clientApps, &query.AppFilter)
+	if len(terms) == 0 {
+		sortByRegistration(filtered)
+		return filtered
+	}
+
+	scores := map[*ClientApp]int{}
+	result := []*ClientApp{}
+	for _, clientApp := range filtered {
+		if score := matchScore(clientApp, terms); score > 0 {
+			scores[clientApp] = score
+			result = append(result, clientApp)
+		}
+	}
+	sortByRegistration(result)
+	sort.SliceStable(result, func(i, j int) bool {
+		return scores[result[i]] > scores[result[j]]
+	})
+	return result
+}
+
+// matchScore returns the number of words found in the name, description, domain or labels of the app.
+func matchScore(clientApp *ClientApp, terms []string) int {
+	text := strings.ToLower(strings.Join(append([]string{
+		clientApp.Name,
+		clientApp.Description,
+		clientApp.Domain,
+	}, labelTerms(clientApp.Labels)...), "\n"))
+
+	score := 0
+	for _, term := range terms {
+		if strings.Contains(text, term) {
+			score++
+		}
+	}
+	return score
+}
+
+// searchTerms splits the search text into lower-case words.
+func searchTerms(text string) []string {
+	return strings.Fields(strings.ToLower(text))
+}
+
+// labelTerms returns the keys and the values of the labels, sorted, to be indexed for text search.
+func labelTerms(labels map[string]string) []string {
+	terms := []string{}
+	for key, value := range labels {
+		terms = append(terms, key)
+		if value != "" {
+			terms = append(terms, value)
+		}
+	}
+	sort.Strings(terms)
+	return terms
+}
@@ -0,0 +1,297 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/Microkubes/microservice-apps-management/idempotency"
+	"github.com/Microkubes/microservice-apps-management/labels"
+)
+
+// StoreOperations are the names of the operations of the AppsManagementStore, which can have their own timeouts.
+var StoreOperations = []string{
+	"GetApp", "GetMyApps", "GetUserApps", "RegisterApp", "DeleteApp", "UpdateApp", "RegenerateSecret",
+	"FindApp", "SetRateLimit", "DeleteRateLimit", "RecordUsage", "GetAppUsage", "GetAppsUnusedSince",
+	"SetAppStatus", "TransitionAppStatus", "SetReapingExempt", "GetClientApp", "IssueVerificationToken",
+	"SaveVerificationResult", "GetAppsToReverify", "GetUserQuota", "SetUserQuota", "DeleteUserQuota",
+	"CountUserApps", "SearchApps", "ListApps", "CountAppsByStatus", "SetAppLogo", "SetAppLabels", "Ping",
+}
+
+// TimeoutStore is an AppsManagementStore that gives every operation of another store a deadline, so that a
+// slow database does not hold the requests and the background jobs forever.
+type TimeoutStore struct {
+	store    AppsManagementStore
+	timeout  time.Duration
+	timeouts map[string]time.Duration
+}
+
+// NewTimeoutStore wraps the store, so that every operation times out after its timeout in timeouts, by the
+// name of the method, or else after the default timeout. A timeout of zero means no timeout.
+func NewTimeoutStore(store AppsManagementStore, timeout time.Duration, timeouts map[string]time.Duration) *TimeoutStore {
+	return &TimeoutStore{
+		store:    store,
+		timeout:  timeout,
+		timeouts: timeouts,
+	}
+}
+
+// context returns the context of an operation, with the deadline of the operation. An earlier deadline of the
+// request is kept.
+func (s *TimeoutStore) context(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	timeout, ok := s.timeouts[method]
+	if !ok {
+		timeout = s.timeout
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// GetApp calls GetApp on the wrapped store within its timeout.
+func (s *TimeoutStore) GetApp(ctx context.Context, appID string) (*app.Apps, error) {
+	ctx, cancel := s.context(ctx, "GetApp")
+	defer cancel()
+	return s.store.GetApp(ctx, appID)
+}
+
+// GetMyApps calls GetMyApps on the wrapped store within its timeout.
+func (s *TimeoutStore) GetMyApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "GetMyApps")
+	defer cancel()
+	return s.store.GetMyApps(ctx, userID, selector)
+}
+
+// GetUserApps calls GetUserApps on the wrapped store within its timeout.
+func (s *TimeoutStore) GetUserApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "GetUserApps")
+	defer cancel()
+	return s.store.GetUserApps(ctx, userID, selector)
+}
+
+// RegisterApp calls RegisterApp on the wrapped store within its timeout.
+func (s *TimeoutStore) RegisterApp(ctx context.Context, payload *app.AppPayload, userID string, maxApps int) (*app.RegApps, error) {
+	ctx, cancel := s.context(ctx, "RegisterApp")
+	defer cancel()
+	return s.store.RegisterApp(ctx, payload, userID, maxApps)
+}
+
+// DeleteApp calls DeleteApp on the wrapped store within its timeout.
+func (s *TimeoutStore) DeleteApp(ctx context.Context, appID string) error {
+	ctx, cancel := s.context(ctx, "DeleteApp")
+	defer cancel()
+	return s.store.DeleteApp(ctx, appID)
+}
+
+// UpdateApp calls UpdateApp on the wrapped store within its timeout.
+func (s *TimeoutStore) UpdateApp(ctx context.Context, payload *app.AppPayload, appID string) (*app.Apps, error) {
+	ctx, cancel := s.context(ctx, "UpdateApp")
+	defer cancel()
+	return s.store.UpdateApp(ctx, payload, appID)
+}
+
+// RegenerateSecret calls RegenerateSecret on the wrapped store within its timeout.
+func (s *TimeoutStore) RegenerateSecret(ctx context.Context, appID string) (*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "RegenerateSecret")
+	defer cancel()
+	return s.store.RegenerateSecret(ctx, appID)
+}
+
+// FindApp calls FindApp on the wrapped store within its timeout.
+func (s *TimeoutStore) FindApp(ctx context.Context, id, secret string) (*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "FindApp")
+	defer cancel()
+	return s.store.FindApp(ctx, id, secret)
+}
+
+// SetRateLimit calls SetRateLimit on the wrapped store within its timeout.
+func (s *TimeoutStore) SetRateLimit(ctx context.Context, appID string, payload *app.RateLimitPayload) (*app.RateLimit, error) {
+	ctx, cancel := s.context(ctx, "SetRateLimit")
+	defer cancel()
+	return s.store.SetRateLimit(ctx, appID, payload)
+}
+
+// DeleteRateLimit calls DeleteRateLimit on the wrapped store within its timeout.
+func (s *TimeoutStore) DeleteRateLimit(ctx context.Context, appID string) error {
+	ctx, cancel := s.context(ctx, "DeleteRateLimit")
+	defer cancel()
+	return s.store.DeleteRateLimit(ctx, appID)
+}
+
+// RecordUsage calls RecordUsage on the wrapped store within its timeout.
+func (s *TimeoutStore) RecordUsage(ctx context.Context, record *UsageRecord) error {
+	ctx, cancel := s.context(ctx, "RecordUsage")
+	defer cancel()
+	return s.store.RecordUsage(ctx, record)
+}
+
+// GetAppUsage calls GetAppUsage on the wrapped store within its timeout.
+func (s *TimeoutStore) GetAppUsage(ctx context.Context, appID string, from, to time.Time) (*app.AppUsage, error) {
+	ctx, cancel := s.context(ctx, "GetAppUsage")
+	defer cancel()
+	return s.store.GetAppUsage(ctx, appID, from, to)
+}
+
+// GetAppsUnusedSince calls GetAppsUnusedSince on the wrapped store within its timeout.
+func (s *TimeoutStore) GetAppsUnusedSince(ctx context.Context, since time.Time) ([]*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "GetAppsUnusedSince")
+	defer cancel()
+	return s.store.GetAppsUnusedSince(ctx, since)
+}
+
+// SetAppStatus calls SetAppStatus on the wrapped store within its timeout.
+func (s *TimeoutStore) SetAppStatus(ctx context.Context, appID, status string) error {
+	ctx, cancel := s.context(ctx, "SetAppStatus")
+	defer cancel()
+	return s.store.SetAppStatus(ctx, appID, status)
+}
+
+// TransitionAppStatus calls TransitionAppStatus on the wrapped store within its timeout.
+func (s *TimeoutStore) TransitionAppStatus(ctx context.Context, appID string, from *StatusVersion, status string) error {
+	ctx, cancel := s.context(ctx, "TransitionAppStatus")
+	defer cancel()
+	return s.store.TransitionAppStatus(ctx, appID, from, status)
+}
+
+// SetReapingExempt calls SetReapingExempt on the wrapped store within its timeout.
+func (s *TimeoutStore) SetReapingExempt(ctx context.Context, appID string, exempt bool) (*app.Apps, error) {
+	ctx, cancel := s.context(ctx, "SetReapingExempt")
+	defer cancel()
+	return s.store.SetReapingExempt(ctx, appID, exempt)
+}
+
+// GetClientApp calls GetClientApp on the wrapped store within its timeout.
+func (s *TimeoutStore) GetClientApp(ctx context.Context, appID string) (*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "GetClientApp")
+	defer cancel()
+	return s.store.GetClientApp(ctx, appID)
+}
+
+// IssueVerificationToken calls IssueVerificationToken on the wrapped store within its timeout.
+func (s *TimeoutStore) IssueVerificationToken(ctx context.Context, appID string) (*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "IssueVerificationToken")
+	defer cancel()
+	return s.store.IssueVerificationToken(ctx, appID)
+}
+
+// SaveVerificationResult calls SaveVerificationResult on the wrapped store within its timeout.
+func (s *TimeoutStore) SaveVerificationResult(ctx context.Context, appID string, result *VerificationResult) (*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "SaveVerificationResult")
+	defer cancel()
+	return s.store.SaveVerificationResult(ctx, appID, result)
+}
+
+// GetAppsToReverify calls GetAppsToReverify on the wrapped store within its timeout.
+func (s *TimeoutStore) GetAppsToReverify(ctx context.Context, checkedBefore time.Time) ([]*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "GetAppsToReverify")
+	defer cancel()
+	return s.store.GetAppsToReverify(ctx, checkedBefore)
+}
+
+// GetUserQuota calls GetUserQuota on the wrapped store within its timeout.
+func (s *TimeoutStore) GetUserQuota(ctx context.Context, userID string) (*UserQuota, error) {
+	ctx, cancel := s.context(ctx, "GetUserQuota")
+	defer cancel()
+	return s.store.GetUserQuota(ctx, userID)
+}
+
+// SetUserQuota calls SetUserQuota on the wrapped store within its timeout.
+func (s *TimeoutStore) SetUserQuota(ctx context.Context, userID string, maxApps int) (*UserQuota, error) {
+	ctx, cancel := s.context(ctx, "SetUserQuota")
+	defer cancel()
+	return s.store.SetUserQuota(ctx, userID, maxApps)
+}
+
+// DeleteUserQuota calls DeleteUserQuota on the wrapped store within its timeout.
+func (s *TimeoutStore) DeleteUserQuota(ctx context.Context, userID string) error {
+	ctx, cancel := s.context(ctx, "DeleteUserQuota")
+	defer cancel()
+	return s.store.DeleteUserQuota(ctx, userID)
+}
+
+// CountUserApps calls CountUserApps on the wrapped store within its timeout.
+func (s *TimeoutStore) CountUserApps(ctx context.Context, userID string) (int, error) {
+	ctx, cancel := s.context(ctx, "CountUserApps")
+	defer cancel()
+	return s.store.CountUserApps(ctx, userID)
+}
+
+// SearchApps calls SearchApps on the wrapped store within its timeout.
+func (s *TimeoutStore) SearchApps(ctx context.Context, query *SearchQuery) ([]*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "SearchApps")
+	defer cancel()
+	return s.store.SearchApps(ctx, query)
+}
+
+// ListApps calls ListApps on the wrapped store within its timeout.
+func (s *TimeoutStore) ListApps(ctx context.Context, filter *AppFilter, page *Page) (*AppPage, error) {
+	ctx, cancel := s.context(ctx, "ListApps")
+	defer cancel()
+	return s.store.ListApps(ctx, filter, page)
+}
+
+// CountAppsByStatus calls CountAppsByStatus on the wrapped store within its timeout.
+func (s *TimeoutStore) CountAppsByStatus(ctx context.Context) (map[string]int, error) {
+	ctx, cancel := s.context(ctx, "CountAppsByStatus")
+	defer cancel()
+	return s.store.CountAppsByStatus(ctx)
+}
+
+// SetAppLogo calls SetAppLogo on the wrapped store within its timeout.
+func (s *TimeoutStore) SetAppLogo(ctx context.Context, appID string, logo *AppLogo) (*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "SetAppLogo")
+	defer cancel()
+	return s.store.SetAppLogo(ctx, appID, logo)
+}
+
+// SetAppLabels calls SetAppLabels on the wrapped store within its timeout.
+func (s *TimeoutStore) SetAppLabels(ctx context.Context, appID string, set map[string]string, remove []string) (*ClientApp, error) {
+	ctx, cancel := s.context(ctx, "SetAppLabels")
+	defer cancel()
+	return s.store.SetAppLabels(ctx, appID, set, remove)
+}
+
+// Ping calls Ping on the wrapped store within its timeout.
+func (s *TimeoutStore) Ping(ctx context.Context) error {
+	ctx, cancel := s.context(ctx, "Ping")
+	defer cancel()
+	return s.store.Ping(ctx)
+}
+
+// IdempotencyStore returns the idempotency store of the wrapped store, whose operations run within the
+// default timeout.
+func (s *TimeoutStore) IdempotencyStore(ttl, lease time.Duration) idempotency.Store {
+	return &timeoutIdempotencyStore{
+		store:   s.store.IdempotencyStore(ttl, lease),
+		timeout: s,
+	}
+}
+
+// timeoutIdempotencyStore is an idempotency.Store that gives every operation of another store the default
+// timeout of a TimeoutStore.
+type timeoutIdempotencyStore struct {
+	store   idempotency.Store
+	timeout *TimeoutStore
+}
+
+// Begin calls Begin on the wrapped store within the timeout.
+func (s *timeoutIdempotencyStore) Begin(ctx context.Context, key, fingerprint string) (*idempotency.Record, string, error) {
+	ctx, cancel := s.timeout.context(ctx, "")
+	defer cancel()
+	return s.store.Begin(ctx, key, fingerprint)
+}
+
+// Complete calls Complete on the wrapped store within the timeout.
+func (s *timeoutIdempotencyStore) Complete(ctx context.Context, key, lease string, record *idempotency.Record) error {
+	ctx, cancel := s.timeout.context(ctx, "")
+	defer cancel()
+	return s.store.Complete(ctx, key, lease, record)
+}
+
+// Release calls Release on the wrapped store within the timeout.
+func (s *timeoutIdempotencyStore) Release(ctx context.Context, key, lease string) {
+	ctx, cancel := s.timeout.context(ctx, "")
+	defer cancel()
+	s.store.Release(ctx, key, lease)
+}
@@ -0,0 +1,285 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/Microkubes/microservice-apps-management/idempotency"
+	"github.com/Microkubes/microservice-apps-management/labels"
+	"github.com/Microkubes/microservice-apps-management/tracing"
+)
+
+// TracedStore is an AppsManagementStore that records a span for every operation of another store.
+// The spans are children of the current span in the context of the operation. The operations without a
+// current span, like the ones of the background jobs, are not traced.
+type TracedStore struct {
+	store AppsManagementStore
+}
+
+// NewTracedStore wraps the store, so that the operations are traced.
+func NewTracedStore(store AppsManagementStore) *TracedStore {
+	return &TracedStore{
+		store: store,
+	}
+}
+
+// start starts the span of an operation, and returns the context of the span for the wrapped store.
+func (s *TracedStore) start(ctx context.Context, method string) (context.Context, *tracing.Span) {
+	ctx, span := tracing.StartChildSpan(ctx, "AppsManagementStore."+method, tracing.KindClient)
+	span.SetAttribute("store.method", method)
+	return ctx, span
+}
+
+// end ends the span of an operation, marking it as failed if the store failed.
+func (s *TracedStore) end(span *tracing.Span, err error) {
+	if isStoreFailure(err) {
+		span.SetError(err)
+	}
+	span.End()
+}
+
+// GetApp calls GetApp on the wrapped store.
+func (s *TracedStore) GetApp(ctx context.Context, appID string) (*app.Apps, error) {
+	ctx, span := s.start(ctx, "GetApp")
+	res, err := s.store.GetApp(ctx, appID)
+	s.end(span, err)
+	return res, err
+}
+
+// GetMyApps calls GetMyApps on the wrapped store.
+func (s *TracedStore) GetMyApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
+	ctx, span := s.start(ctx, "GetMyApps")
+	res, err := s.store.GetMyApps(ctx, userID, selector)
+	s.end(span, err)
+	return res, err
+}
+
+// GetUserApps calls GetUserApps on the wrapped store.
+func (s *TracedStore) GetUserApps(ctx context.Context, userID string, selector labels.Selector) ([]*ClientApp, error) {
+	ctx, span := s.start(ctx, "GetUserApps")
+	res, err := s.store.GetUserApps(ctx, userID, selector)
+	s.end(span, err)
+	return res, err
+}
+
+// RegisterApp calls RegisterApp on the wrapped store.
+func (s *TracedStore) RegisterApp(ctx context.Context, payload *app.AppPayload, userID string, maxApps int) (*app.RegApps, error) {
+	ctx, span := s.start(ctx, "RegisterApp")
+	res, err := s.store.RegisterApp(ctx, payload, userID, maxApps)
+	s.end(span, err)
+	return res, err
+}
+
+// DeleteApp calls DeleteApp on the wrapped store.
+func (s *TracedStore) DeleteApp(ctx context.Context, appID string) error {
+	ctx, span := s.start(ctx, "DeleteApp")
+	err := s.store.DeleteApp(ctx, appID)
+	s.end(span, err)
+	return err
+}
+
+// UpdateApp calls UpdateApp on the wrapped store.
+func (s *TracedStore) UpdateApp(ctx context.Context, payload *app.AppPayload, appID string) (*app.Apps, error) {
+	ctx, span := s.start(ctx, "UpdateApp")
+	res, err := s.store.UpdateApp(ctx, payload, appID)
+	s.end(span, err)
+	return res, err
+}
+
+// RegenerateSecret calls RegenerateSecret on the wrapped store.
+func (s *TracedStore) RegenerateSecret(ctx context.Context, appID string) (*ClientApp, error) {
+	ctx, span := s.start(ctx, "RegenerateSecret")
+	res, err := s.store.RegenerateSecret(ctx, appID)
+	s.end(span, err)
+	return res, err
+}
+
+// FindApp calls FindApp on the wrapped store.
+func (s *TracedStore) FindApp(ctx context.Context, id, secret string) (*ClientApp, error) {
+	ctx, span := s.start(ctx, "FindApp")
+	res, err := s.store.FindApp(ctx, id, secret)
+	s.end(span, err)
+	return res, err
+}
+
+// SetRateLimit calls SetRateLimit on the wrapped store.
+func (s *TracedStore) SetRateLimit(ctx context.Context, appID string, payload *app.RateLimitPayload) (*app.RateLimit, error) {
+	ctx, span := s.start(ctx, "SetRateLimit")
+	res, err := s.store.SetRateLimit(ctx, appID, payload)
+	s.end(span, err)
+	return res, err
+}
+
+// DeleteRateLimit calls DeleteRateLimit on the wrapped store.
+func (s *TracedStore) DeleteRateLimit(ctx context.Context, appID string) error {
+	ctx, span := s.start(ctx, "DeleteRateLimit")
+	err := s.store.DeleteRateLimit(ctx, appID)
+	s.end(span, err)
+	return err
+}
+
+// RecordUsage calls RecordUsage on the wrapped store.
+func (s *TracedStore) RecordUsage(ctx context.Context, record *UsageRecord) error {
+	ctx, span := s.start(ctx, "RecordUsage")
+	err := s.store.RecordUsage(ctx, record)
+	s.end(span, err)
+	return err
+}
+
+// GetAppUsage calls GetAppUsage on the wrapped store.
+func (s *TracedStore) GetAppUsage(ctx context.Context, appID string, from, to time.Time) (*app.AppUsage, error) {
+	ctx, span := s.start(ctx, "GetAppUsage")
+	res, err := s.store.GetAppUsage(ctx, appID, from, to)
+	s.end(span, err)
+	return res, err
+}
+
+// GetAppsUnusedSince calls GetAppsUnusedSince on the wrapped store.
+func (s *TracedStore) GetAppsUnusedSince(ctx context.Context, since time.Time) ([]*ClientApp, error) {
+	ctx, span := s.start(ctx, "GetAppsUnusedSince")
+	res, err := s.store.GetAppsUnusedSince(ctx, since)
+	s.end(span, err)
+	return res, err
+}
+
+// SetAppStatus calls SetAppStatus on the wrapped store.
+func (s *TracedStore) SetAppStatus(ctx context.Context, appID, status string) error {
+	ctx, span := s.start(ctx, "SetAppStatus")
+	err := s.store.SetAppStatus(ctx, appID, status)
+	s.end(span, err)
+	return err
+}
+
+// TransitionAppStatus calls TransitionAppStatus on the wrapped store.
+func (s *TracedStore) TransitionAppStatus(ctx context.Context, appID string, from *StatusVersion, status string) error {
+	ctx, span := s.start(ctx, "TransitionAppStatus")
+	err := s.store.TransitionAppStatus(ctx, appID, from, status)
+	s.end(span, err)
+	return err
+}
+
+// SetReapingExempt calls SetReapingExempt on the wrapped store.
+func (s *TracedStore) SetReapingExempt(ctx context.Context, appID string, exempt bool) (*app.Apps, error) {
+	ctx, span := s.start(ctx, "SetReapingExempt")
+	res, err := s.store.SetReapingExempt(ctx, appID, exempt)
+	s.end(span, err)
+	return res, err
+}
+
+// GetClientApp calls GetClientApp on the wrapped store.
+func (s *TracedStore) GetClientApp(ctx context.Context, appID string) (*ClientApp, error) {
+	ctx, span := s.start(ctx, "GetClientApp")
+	res, err := s.store.GetClientApp(ctx, appID)
+	s.end(span, err)
+	return res, err
+}
+
+// IssueVerificationToken calls IssueVerificationToken on the wrapped store.
+func (s *TracedStore) IssueVerificationToken(ctx context.Context, appID string) (*ClientApp, error) {
+	ctx, span := s.start(ctx, "IssueVerificationToken")
+	res, err := s.store.IssueVerificationToken(ctx, appID)
+	s.end(span, err)
+	return res, err
+}
+
+// SaveVerificationResult calls SaveVerificationResult on the wrapped store.
+func (s *TracedStore) SaveVerificationResult(ctx context.Context, appID string, result *VerificationResult) (*ClientApp, error) {
+	ctx, span := s.start(ctx, "SaveVerificationResult")
+	res, err := s.store.SaveVerificationResult(ctx, appID, result)
+	s.end(span, err)
+	return res, err
+}
+
+// GetAppsToReverify calls GetAppsToReverify on the wrapped store.
+func (s *TracedStore) GetAppsToReverify(ctx context.Context, checkedBefore time.Time) ([]*ClientApp, error) {
+	ctx, span := s.start(ctx, "GetAppsToReverify")
+	res, err := s.store.GetAppsToReverify(ctx, checkedBefore)
+	s.end(span, err)
+	return res, err
+}
+
+// GetUserQuota calls GetUserQuota on the wrapped store.
+func (s *TracedStore) GetUserQuota(ctx context.Context, userID string) (*UserQuota, error) {
+	ctx, span := s.start(ctx, "GetUserQuota")
+	res, err := s.store.GetUserQuota(ctx, userID)
+	s.end(span, err)
+	return res, err
+}
+
+// SetUserQuota calls SetUserQuota on the wrapped store.
+func (s *TracedStore) SetUserQuota(ctx context.Context, userID string, maxApps int) (*UserQuota, error) {
+	ctx, span := s.start(ctx, "SetUserQuota")
+	res, err := s.store.SetUserQuota(ctx, userID, maxApps)
+	s.end(span, err)
+	return res, err
+}
+
+// DeleteUserQuota calls DeleteUserQuota on the wrapped store.
+func (s *TracedStore) DeleteUserQuota(ctx context.Context, userID string) error {
+	ctx, span := s.start(ctx, "DeleteUserQuota")
+	err := s.store.DeleteUserQuota(ctx, userID)
+	s.end(span, err)
+	return err
+}
+
+// CountUserApps calls CountUserApps on the wrapped store.
+func (s *TracedStore) CountUserApps(ctx context.Context, userID string) (int, error) {
+	ctx, span := s.start(ctx, "CountUserApps")
+	res, err := s.store.CountUserApps(ctx, userID)
+	s.end(span, err)
+	return res, err
+}
+
+// SearchApps calls SearchApps on the wrapped store.
+func (s *TracedStore) SearchApps(ctx context.Context, query *SearchQuery) ([]*ClientApp, error) {
+	ctx, span := s.start(ctx, "SearchApps")
+	res, err := s.store.SearchApps(ctx, query)
+	s.end(span, err)
+	return res, err
+}
+
+// ListApps calls ListApps on the wrapped store.
+func (s *TracedStore) ListApps(ctx context.Context, filter *AppFilter, page *Page) (*AppPage, error) {
+	ctx, span := s.start(ctx, "ListApps")
+	res, err := s.store.ListApps(ctx, filter, page)
+	s.end(span, err)
+	return res, err
+}
+
+// CountAppsByStatus calls CountAppsByStatus on the wrapped store.
+func (s *TracedStore) CountAppsByStatus(ctx context.Context) (map[string]int, error) {
+	ctx, span := s.start(ctx, "CountAppsByStatus")
+	res, err := s.store.CountAppsByStatus(ctx)
+	s.end(span, err)
+	return res, err
+}
+
+// SetAppLogo calls SetAppLogo on the wrapped store.
+func (s *TracedStore) SetAppLogo(ctx context.Context, appID string, logo *AppLogo) (*ClientApp, error) {
+	ctx, span := s.start(ctx, "SetAppLogo")
+	res, err := s.store.SetAppLogo(ctx, appID, logo)
+	s.end(span, err)
+	return res, err
+}
+
+// SetAppLabels calls SetAppLabels on the wrapped store.
+func (s *TracedStore) SetAppLabels(ctx context.Context, appID string, set map[string]string, remove []string) (*ClientApp, error) {
+	ctx, span := s.start(ctx, "SetAppLabels")
+	res, err := s.store.SetAppLabels(ctx, appID, set, remove)
+	s.end(span, err)
+	return res, err
+}
+
+// Ping calls Ping on the wrapped store.
+func (s *TracedStore) Ping(ctx context.Context) error {
+	ctx, span := s.start(ctx, "Ping")
+	err := s.store.Ping(ctx)
+	s.end(span, err)
+	return err
+}
+
+// IdempotencyStore returns the idempotency store of the wrapped store.
+func (s *TracedStore) IdempotencyStore(ttl, lease time.Duration) idempotency.Store {
+	return s.store.IdempotencyStore(ttl, lease)
+}
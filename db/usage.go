@@ -0,0 +1,241 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Microkubes/backends"
+	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/keitaroinc/goa"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// DayFormat is the format of the day in the daily usage records.
+const DayFormat = "2006-01-02"
+
+// maxUsageDays is the maximal number of days that can be requested in a single usage time series.
+const maxUsageDays = 366
+
+// UsageRecord holds the aggregated verification counts of an app for a single day,
+// collected since the last write to the store.
+type UsageRecord struct {
+	AppID      string
+	Day        string
+	Success    int
+	Failure    int
+	LastUsedAt int64
+	LastUsedIP string
+}
+
+// DailyUsage holds the verification counters of an app for a single day.
+type DailyUsage struct {
+	ID      string `json:"id,omitempty" bson:"_id,omitempty"`
+	Key     string `json:"key" bson:"key"`
+	AppID   string `json:"appId" bson:"appId"`
+	Day     string `json:"day" bson:"day"`
+	Success int    `json:"success" bson:"success"`
+	Failure int    `json:"failure" bson:"failure"`
+}
+
+// RecordUsage adds the usage record to the daily counters of the app and updates the time and
+// IP address of the last successful verification. With MongoDB the counters are incremented and the
+// last use is set in place, so that concurrent writers do not lose each other's changes. The other
+// backends can only save whole documents, so there the counters are read and saved back.
+func (c *BackendAppsManagementStore) RecordUsage(ctx context.Context, record *UsageRecord) error {
+	if c.mongo != nil {
+		return c.mongo.recordUsage(ctx, record)
+	}
+
+	key := usageKey(record)
+
+	res, err := withContext(ctx, c.usageRepository).GetOne(backends.NewFilter().Match("key", key), &DailyUsage{})
+	if err != nil && !backends.IsErrNotFound(err) {
+		return err
+	}
+
+	daily := &DailyUsage{
+		Key:   key,
+		AppID: record.AppID,
+		Day:   record.Day,
+	}
+	var filter backends.Filter
+	if res != nil {
+		daily = res.(*DailyUsage)
+		filter = backends.NewFilter().Match("key", key)
+	}
+	daily.Success += record.Success
+	daily.Failure += record.Failure
+
+	if _, err := withContext(ctx, c.usageRepository).Save(daily, filter); err != nil {
+		return err
+	}
+
+	if record.LastUsedAt == 0 {
+		return nil
+	}
+
+	res, err = withContext(ctx, c.repository).GetOne(backends.NewFilter().Match("id", record.AppID), &ClientApp{})
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			// the app has been deleted in the meantime
+			return nil
+		}
+		return err
+	}
+	clientApp := res.(*ClientApp)
+	if clientApp.LastUsedAt >= record.LastUsedAt {
+		return nil
+	}
+
+	clientApp.LastUsedAt = record.LastUsedAt
+	clientApp.LastUsedIP = record.LastUsedIP
+	if clientApp.Status == StatusFlagged {
+		// the app is in use again
+		clientApp.Status = StatusActive
+		clientApp.StatusChangedAt = time.Now().Unix()
+	}
+
+	_, err = withContext(ctx, c.repository).Save(clientApp, backends.NewFilter().Match("id", record.AppID))
+	return err
+}
+
+// recordUsage increments the daily counters with an upsert, and sets the last use only if it is newer
+// than the stored one. A flagged app is reactivated with a separate update that only matches flagged apps.
+func (s *mongoStore) recordUsage(ctx context.Context, record *UsageRecord) error {
+	err := s.run(ctx, "apps-usage", func(usage *mgo.Collection) error {
+		return upsert(usage, bson.M{"key": usageKey(record)}, bson.M{
+			"$inc": bson.M{
+				"success": record.Success,
+				"failure": record.Failure,
+			},
+			"$setOnInsert": bson.M{
+				"appId": record.AppID,
+				"day":   record.Day,
+			},
+		})
+	})
+	if err != nil || record.LastUsedAt == 0 {
+		return err
+	}
+
+	err = s.run(ctx, "apps-management", func(apps *mgo.Collection) error {
+		err := apps.Update(bson.M{
+			"_id": mongoID(record.AppID),
+			"$or": []bson.M{
+				{"lastUsedAt": bson.M{"$lt": record.LastUsedAt}},
+				{"lastUsedAt": bson.M{"$exists": false}},
+			},
+		}, bson.M{"$set": bson.M{
+			"lastUsedAt": record.LastUsedAt,
+			"lastUsedIP": record.LastUsedIP,
+		}})
+		if err != nil && err != mgo.ErrNotFound {
+			return err
+		}
+
+		// the app is in use again
+		return apps.Update(bson.M{
+			"_id":    mongoID(record.AppID),
+			"status": StatusFlagged,
+		}, bson.M{"$set": bson.M{
+			"status":          StatusActive,
+			"statusChangedAt": time.Now().Unix(),
+		}})
+	})
+	if err == mgo.ErrNotFound {
+		// the app has been deleted in the meantime, or is not flagged
+		return nil
+	}
+	return err
+}
+
+// usageKey returns the key of the daily usage record of the app for the day of the usage record.
+func usageKey(record *UsageRecord) string {
+	return record.AppID + "/" + record.Day
+}
+
+// GetAppUsage returns the last use and the daily verification counts of an app between
+// the given days (inclusive). Days without any verifications are reported with zero counts.
+func (c *BackendAppsManagementStore) GetAppUsage(ctx context.Context, appID string, from, to time.Time) (*app.AppUsage, error) {
+	days, err := usageDays(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	clientApp, err := c.getClientApp(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	var typeHint map[string]interface{}
+	records, err := withContext(ctx, c.usageRepository).GetAll(backends.NewFilter().Match("appId", appID), typeHint, "day", "asc", 0, 0)
+	if err != nil && !backends.IsErrNotFound(err) {
+		return nil, err
+	}
+
+	dailyUsage := []*DailyUsage{}
+	if records != nil {
+		data, err := json.Marshal(records)
+		if err != nil {
+			return nil, goa.ErrInternal(err)
+		}
+		if err := json.Unmarshal(data, &dailyUsage); err != nil {
+			return nil, goa.ErrInternal(err)
+		}
+	}
+
+	return buildAppUsage(clientApp, days, dailyUsage), nil
+}
+
+// usageDays returns the list of days between from and to (inclusive).
+func usageDays(from, to time.Time) ([]string, error) {
+	from = from.UTC().Truncate(24 * time.Hour)
+	to = to.UTC().Truncate(24 * time.Hour)
+
+	if from.After(to) {
+		return nil, backends.ErrInvalidInput("the start day must not be after the end day")
+	}
+
+	days := []string{}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if len(days) == maxUsageDays {
+			return nil, backends.ErrInvalidInput("the time series must not be longer than 366 days")
+		}
+		days = append(days, day.Format(DayFormat))
+	}
+
+	return days, nil
+}
+
+// buildAppUsage creates the usage media for the app from the stored daily usage records,
+// using zero counts for the days without a record.
+func buildAppUsage(clientApp *ClientApp, days []string, dailyUsage []*DailyUsage) *app.AppUsage {
+	byDay := map[string]*DailyUsage{}
+	for _, daily := range dailyUsage {
+		byDay[daily.Day] = daily
+	}
+
+	usage := &app.AppUsage{
+		AppID: clientApp.ID,
+		Daily: []*app.DailyUsage{},
+	}
+	if clientApp.LastUsedAt > 0 {
+		lastUsedAt := int(clientApp.LastUsedAt)
+		lastUsedIP := clientApp.LastUsedIP
+		usage.LastUsedAt = &lastUsedAt
+		usage.LastUsedIP = &lastUsedIP
+	}
+
+	for _, day := range days {
+		entry := &app.DailyUsage{Day: day}
+		if daily, ok := byDay[day]; ok {
+			entry.Success = daily.Success
+			entry.Failure = daily.Failure
+		}
+		usage.Daily = append(usage.Daily, entry)
+	}
+
+	return usage
+}
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/Microkubes/backends"
+	"github.com/keitaroinc/goa"
+)
+
+// VerificationResult holds the result of a check of the domain verification token.
+type VerificationResult struct {
+	// Method is the verification method used for the check.
+	Method string
+	// Verified tells whether the domain is considered verified after the check.
+	Verified bool
+	// CheckedAt is the time of the check.
+	CheckedAt int64
+	// Error is the reason why the check failed. Empty if the token has been found.
+	Error string
+	// Failures is the number of consecutive failed checks.
+	Failures int
+}
+
+// GetClientApp returns an app by its ID. Soft-deleted apps are reported as not found.
+func (c *BackendAppsManagementStore) GetClientApp(ctx context.Context, appID string) (*ClientApp, error) {
+	return c.getClientApp(ctx, appID)
+}
+
+// IssueVerificationToken creates the domain verification token for an app, if the app does not have one yet.
+func (c *BackendAppsManagementStore) IssueVerificationToken(ctx context.Context, appID string) (*ClientApp, error) {
+	existing, err := c.getClientApp(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.VerificationToken != "" {
+		return existing, nil
+	}
+	if existing.Domain == "" {
+		return nil, backends.ErrInvalidInput("the app has no domain")
+	}
+
+	token, err := GenerateRandomString(24)
+	if err != nil {
+		return nil, goa.ErrInternal(err)
+	}
+	existing.VerificationToken = token
+
+	return c.saveApp(ctx, appID, existing, "verificationToken")
+}
+
+// SaveVerificationResult stores the result of a check of the domain verification token.
+func (c *BackendAppsManagementStore) SaveVerificationResult(ctx context.Context, appID string, result *VerificationResult) (*ClientApp, error) {
+	existing, err := c.getClientApp(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	applyVerificationResult(existing, result)
+
+	return c.saveApp(ctx, appID, existing, verificationFields...)
+}
+
+// GetAppsToReverify returns the apps with a verified domain that have not been checked since the given time.
+func (c *BackendAppsManagementStore) GetAppsToReverify(ctx context.Context, checkedBefore time.Time) ([]*ClientApp, error) {
+	var typeHint map[string]interface{}
+	apps, err := withContext(ctx, c.repository).GetAll(backends.NewFilter().Match("domainVerified", true), typeHint, "registeredat", "asc", 0, 0)
+	if err != nil {
+		if backends.IsErrNotFound(err) {
+			return []*ClientApp{}, nil
+		}
+		return nil, err
+	}
+
+	clientApps, err := decodeClientApps(apps)
+	if err != nil {
+		return nil, err
+	}
+
+	toReverify := []*ClientApp{}
+	for _, clientApp := range clientApps {
+		if clientApp.CurrentStatus() != StatusDeleted && clientApp.VerificationCheckedAt < checkedBefore.Unix() {
+			toReverify = append(toReverify, clientApp)
+		}
+	}
+
+	return toReverify, nil
+}
+
+// verificationFields are the names of the fields that applyVerificationResult sets.
+var verificationFields = []string{"domainVerified", "verificationCheckedAt", "verificationError", "verificationFailures",
+	"verifiedAt", "verificationMethod"}
+
+// applyVerificationResult sets the verification fields of the app from the result of a check.
+func applyVerificationResult(clientApp *ClientApp, result *VerificationResult) {
+	clientApp.DomainVerified = result.Verified
+	clientApp.VerificationCheckedAt = result.CheckedAt
+	clientApp.VerificationError = result.Error
+	clientApp.VerificationFailures = result.Failures
+	if result.Verified && result.Error == "" {
+		clientApp.VerifiedAt = result.CheckedAt
+		clientApp.VerificationMethod = result.Method
+	}
+}
+
+// resetDomainVerification clears the verification of the app when its domain changes.
+func resetDomainVerification(clientApp *ClientApp) {
+	clientApp.DomainVerified = false
+	clientApp.VerifiedAt = 0
+	clientApp.VerificationToken = ""
+	clientApp.VerificationMethod = ""
+	clientApp.VerificationCheckedAt = 0
+	clientApp.VerificationError = ""
+	clientApp.VerificationFailures = 0
+}
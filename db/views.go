@@ -0,0 +1,167 @@
+package db
+
+import (
+	"github.com/Microkubes/microservice-apps-management/app"
+)
+
+// The functions in this file render the apps to their media type views. The client secret is copied
+// to a response only by ToMediaWithSecret and ToRegMedia, so these two functions are the only place
+// to audit when checking which endpoints can return a secret.
+
+// ToMedia converts the app to the default view of its media type, without the secret.
+func (ca *ClientApp) ToMedia() *app.Apps {
+	status := ca.CurrentStatus()
+	exempt := ca.ReapingExempt
+	verified := ca.DomainVerified
+	var verifiedAt *int
+	if ca.VerifiedAt > 0 {
+		at := int(ca.VerifiedAt)
+		verifiedAt = &at
+	}
+	logoURL, logoThumbnailURL := ca.logoURLs()
+	return &app.Apps{
+		Description:       ca.Description,
+		Domain:            ca.Domain,
+		ID:                ca.ID,
+		Name:              ca.Name,
+		Owner:             ca.Owner,
+		Origins:           ca.Origins,
+		Labels:            ca.Labels,
+		Annotations:       ca.Annotations,
+		LogoURL:           logoURL,
+		LogoThumbnailURL:  logoThumbnailURL,
+		PrivacyPolicyURL:  optionalString(ca.PrivacyPolicyURL),
+		TermsOfServiceURL: optionalString(ca.TermsOfServiceURL),
+		SupportEmail:      optionalString(ca.SupportEmail),
+		Contacts:          ca.Contacts,
+		RegisteredAt:      int(ca.RegisteredAt),
+		RateLimit:         ca.RateLimit.ToMedia(),
+		Status:            &status,
+		ReapingExempt:     &exempt,
+		DomainVerified:    &verified,
+		VerifiedAt:        verifiedAt,
+	}
+}
+
+// ToAdminMedia converts the app to the admin view of its media type. The admin view adds the usage and
+// the lifecycle data of the app, but not the secret.
+func (ca *ClientApp) ToAdminMedia() *app.AppsAdmin {
+	mt := ca.ToMedia()
+	return &app.AppsAdmin{
+		Description:       mt.Description,
+		Domain:            mt.Domain,
+		ID:                mt.ID,
+		Name:              mt.Name,
+		Owner:             mt.Owner,
+		Origins:           mt.Origins,
+		Labels:            mt.Labels,
+		Annotations:       mt.Annotations,
+		LogoURL:           mt.LogoURL,
+		LogoThumbnailURL:  mt.LogoThumbnailURL,
+		PrivacyPolicyURL:  mt.PrivacyPolicyURL,
+		TermsOfServiceURL: mt.TermsOfServiceURL,
+		SupportEmail:      mt.SupportEmail,
+		Contacts:          mt.Contacts,
+		RegisteredAt:      mt.RegisteredAt,
+		RateLimit:         mt.RateLimit,
+		Status:            mt.Status,
+		ReapingExempt:     mt.ReapingExempt,
+		DomainVerified:    mt.DomainVerified,
+		VerifiedAt:        mt.VerifiedAt,
+		LastUsedAt:        optionalInt(ca.LastUsedAt),
+		LastUsedIP:        optionalString(ca.LastUsedIP),
+		StatusChangedAt:   optionalInt(ca.StatusChangedAt),
+	}
+}
+
+// ToMediaWithSecret converts the app to the withSecret view of its media type, which is the default view
+// with the client secret. Use it only for the responses that must return the secret to the owner.
+func (ca *ClientApp) ToMediaWithSecret() *app.AppsWithSecret {
+	mt := ca.ToMedia()
+	return &app.AppsWithSecret{
+		Description:       mt.Description,
+		Domain:            mt.Domain,
+		ID:                mt.ID,
+		Name:              mt.Name,
+		Owner:             mt.Owner,
+		Origins:           mt.Origins,
+		Labels:            mt.Labels,
+		Annotations:       mt.Annotations,
+		LogoURL:           mt.LogoURL,
+		LogoThumbnailURL:  mt.LogoThumbnailURL,
+		PrivacyPolicyURL:  mt.PrivacyPolicyURL,
+		TermsOfServiceURL: mt.TermsOfServiceURL,
+		SupportEmail:      mt.SupportEmail,
+		Contacts:          mt.Contacts,
+		RegisteredAt:      mt.RegisteredAt,
+		RateLimit:         mt.RateLimit,
+		Status:            mt.Status,
+		ReapingExempt:     mt.ReapingExempt,
+		DomainVerified:    mt.DomainVerified,
+		VerifiedAt:        mt.VerifiedAt,
+		Secret:            optionalString(ca.Secret),
+	}
+}
+
+// ToRegMedia converts the newly registered app to the registration response, which holds the ID and the secret.
+func (ca *ClientApp) ToRegMedia() *app.RegApps {
+	return &app.RegApps{
+		ID:     ca.ID,
+		Secret: ca.Secret,
+	}
+}
+
+// ToPublicMedia converts the app to the display-safe representation shown on consent screens.
+// The owner, the secret and the management data of the app are left out.
+func (ca *ClientApp) ToPublicMedia() *app.PublicApp {
+	verified := ca.DomainVerified
+	logoURL, logoThumbnailURL := ca.logoURLs()
+	return &app.PublicApp{
+		ID:                ca.ID,
+		Name:              ca.Name,
+		Description:       ca.Description,
+		Domain:            ca.Domain,
+		DomainVerified:    &verified,
+		LogoURL:           logoURL,
+		LogoThumbnailURL:  logoThumbnailURL,
+		PrivacyPolicyURL:  optionalString(ca.PrivacyPolicyURL),
+		TermsOfServiceURL: optionalString(ca.TermsOfServiceURL),
+		SupportEmail:      optionalString(ca.SupportEmail),
+		Contacts:          ca.Contacts,
+	}
+}
+
+// ToMediaCollection converts the apps to the default view of their media type.
+func ToMediaCollection(clientApps []*ClientApp) app.AppsCollection {
+	res := app.AppsCollection{}
+	for _, clientApp := range clientApps {
+		res = append(res, clientApp.ToMedia())
+	}
+	return res
+}
+
+// ToAdminMediaCollection converts the apps to the admin view of their media type.
+func ToAdminMediaCollection(clientApps []*ClientApp) app.AppsAdminCollection {
+	res := app.AppsAdminCollection{}
+	for _, clientApp := range clientApps {
+		res = append(res, clientApp.ToAdminMedia())
+	}
+	return res
+}
+
+// optionalString returns a pointer to the value, or nil for an empty value.
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// optionalInt returns a pointer to the value as an int, or nil for zero.
+func optionalInt(value int64) *int {
+	if value == 0 {
+		return nil
+	}
+	v := int(value)
+	return &v
+}
@@ -39,8 +39,12 @@ var _ = Resource("apps", func() {
 	Action("getMyApps", func() {
 		Description("Get all user's apps")
 		Routing(GET("/my"))
-		Response(OK)
+		Params(func() {
+			Param("selector", String, "Label selector, like env=prod,team in (payments,risk)")
+		})
+		Response(OK, CollectionOf(AppMedia))
 		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
 	})
 
@@ -49,8 +53,43 @@ var _ = Resource("apps", func() {
 		Routing(GET("/users/:userId/all"))
 		Params(func() {
 			Param("userId", String, "User ID")
+			Param("selector", String, "Label selector, like env=prod,team in (payments,risk)")
 		})
-		Response(OK)
+		Response(OK, CollectionOf(AppMedia))
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("getUserQuota", func() {
+		Description("Get the maximal and the current number of apps for a user. Admin only.")
+		Routing(GET("/users/:userId/quota"))
+		Params(func() {
+			Param("userId", String, "User ID")
+		})
+		Response(OK, AppQuotaMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("setUserQuota", func() {
+		Description("Override the maximal number of apps for a user. Admin only.")
+		Routing(PUT("/users/:userId/quota"))
+		Params(func() {
+			Param("userId", String, "User ID")
+		})
+		Payload(AppQuotaPayload)
+		Response(OK, AppQuotaMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("deleteUserQuota", func() {
+		Description("Remove the override of the maximal number of apps for a user, so the default applies. Admin only.")
+		Routing(DELETE("/users/:userId/quota"))
+		Params(func() {
+			Param("userId", String, "User ID")
+		})
+		Response(OK, AppQuotaMedia)
 		Response(NotFound, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
 	})
@@ -58,16 +97,19 @@ var _ = Resource("apps", func() {
 	Action("registerApp", func() {
 		Description("Register new app")
 		Routing(POST(""))
+		Headers(IdempotencyKey)
 		Payload(AppPayload)
 		Response(Created, RegAppMedia)
 		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(Conflict, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
 	})
 
 	Action("deleteApp", func() {
 		Description("Delete an app")
 		Routing(DELETE("/:appId"))
-		Response(OK)
+		Response(NoContent)
 		Response(NotFound, ErrorMedia)
 		Response(BadRequest, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
@@ -80,13 +122,14 @@ var _ = Resource("apps", func() {
 		Response(OK, AppMedia)
 		Response(NotFound, ErrorMedia)
 		Response(BadRequest, ErrorMedia)
+		Response(Conflict, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
 	})
 
 	Action("regenerateClientSecret", func() {
 		Description("Regenerate client secret")
 		Routing(PUT("/:appId/regenerate-secret"))
-		Response(OK)
+		Response(OK, AppMedia)
 		Response(NotFound, ErrorMedia)
 		Response(BadRequest, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
@@ -100,6 +143,282 @@ var _ = Resource("apps", func() {
 		Response(NotFound, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
 	})
+
+	Action("getUsage", func() {
+		Description("Get the daily verification counts and the last use of an app")
+		Routing(GET("/:appId/usage"))
+		Params(func() {
+			Param("appId", String, "App ID")
+			Param("from", String, "First day of the time series (YYYY-MM-DD). Defaults to 30 days ago.", func() {
+				Pattern(`^\d{4}-\d{2}-\d{2}$`)
+			})
+			Param("to", String, "Last day of the time series (YYYY-MM-DD). Defaults to today.", func() {
+				Pattern(`^\d{4}-\d{2}-\d{2}$`)
+			})
+		})
+		Response(OK, AppUsageMedia)
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("getRateLimit", func() {
+		Description("Get the effective rate limit and quota policy for an app")
+		Routing(GET("/:appId/rate-limit"))
+		Params(func() {
+			Param("appId", String, "App ID")
+		})
+		Response(OK, RateLimitMedia)
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("setRateLimit", func() {
+		Description("Set the rate limit and quota policy for an app. Admin only.")
+		Routing(PUT("/:appId/rate-limit"))
+		Params(func() {
+			Param("appId", String, "App ID")
+		})
+		Payload(RateLimitPayload)
+		Response(OK, RateLimitMedia)
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("deleteRateLimit", func() {
+		Description("Remove the rate limit and quota policy of an app, so the default policy applies. Admin only.")
+		Routing(DELETE("/:appId/rate-limit"))
+		Params(func() {
+			Param("appId", String, "App ID")
+		})
+		Response(NoContent)
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("issueDomainVerification", func() {
+		Description("Start the verification of the app domain. Returns the token to publish in a DNS TXT record or in a file under /.well-known/ on the domain.")
+		Routing(POST("/:appId/domain-verification"))
+		Params(func() {
+			Param("appId", String, "App ID")
+		})
+		Headers(IdempotencyKey)
+		Response(OK, DomainVerificationMedia)
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(Conflict, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("checkDomainVerification", func() {
+		Description("Check the published verification token and mark the app domain as verified")
+		Routing(PUT("/:appId/domain-verification"))
+		Params(func() {
+			Param("appId", String, "App ID")
+			Param("method", String, "Where the token is published", func() {
+				Enum("dns", "http")
+				Default("dns")
+			})
+		})
+		Response(OK, DomainVerificationMedia)
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("checkOrigin", func() {
+		Description("Check if an origin is allowed for an app, for CORS decisions")
+		Routing(GET("/:appId/origins/check"))
+		Params(func() {
+			Param("appId", String, "App ID")
+			Param("origin", String, "The origin, like https://app.example.com")
+			Required("origin")
+		})
+		Response(OK, OriginCheckMedia)
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("searchApps", func() {
+		Description("Search the apps by name, description, domain and labels. Admin only.")
+		Routing(GET("/search"))
+		Params(func() {
+			Param("q", String, "Words to search for in the name, description, domain and labels of the apps")
+			Param("status", String, "Only apps with this status. Deleted apps are found only with status=deleted.", func() {
+				Enum("active", "flagged", "suspended", "deleted")
+			})
+			Param("owner", String, "Only apps of this user")
+			Param("registeredFrom", String, "Only apps registered on or after this day (YYYY-MM-DD)", func() {
+				Pattern(`^\d{4}-\d{2}-\d{2}$`)
+			})
+			Param("registeredTo", String, "Only apps registered on or before this day (YYYY-MM-DD)", func() {
+				Pattern(`^\d{4}-\d{2}-\d{2}$`)
+			})
+			Param("limit", Integer, "Maximal number of apps to return", func() {
+				Minimum(1)
+				Maximum(500)
+				Default(50)
+			})
+		})
+		Response(OK, CollectionOf(AppMedia))
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("listApps", func() {
+		Description("List the apps of all users, ordered by the time of registration. Admin only.")
+		Routing(GET("/all"))
+		Params(func() {
+			Param("owner", String, "Only the apps of the user")
+			Param("status", String, "Only the apps with the status. Deleted apps are listed only with status=deleted.", func() {
+				Enum("active", "flagged", "suspended", "deleted")
+			})
+			Param("domain", String, "Only the apps with the domain")
+			Param("registeredFrom", String, "Only the apps registered on or after the day (YYYY-MM-DD)", func() {
+				Pattern(`^\d{4}-\d{2}-\d{2}$`)
+			})
+			Param("registeredTo", String, "Only the apps registered on or before the day (YYYY-MM-DD)", func() {
+				Pattern(`^\d{4}-\d{2}-\d{2}$`)
+			})
+			Param("page", Integer, "Number of the page, starting from 1", func() {
+				Minimum(1)
+				Default(1)
+			})
+			Param("pageSize", Integer, "Maximal number of apps on a page", func() {
+				Minimum(1)
+				Maximum(500)
+				Default(50)
+			})
+		})
+		Response(OK, AppPageMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("exportApps", func() {
+		Description("Export all apps of all users that match the filters as a CSV or a JSON file. Admin only.")
+		Routing(GET("/all/export"))
+		Params(func() {
+			Param("owner", String, "Only the apps of the user")
+			Param("status", String, "Only the apps with the status. Deleted apps are listed only with status=deleted.", func() {
+				Enum("active", "flagged", "suspended", "deleted")
+			})
+			Param("domain", String, "Only the apps with the domain")
+			Param("registeredFrom", String, "Only the apps registered on or after the day (YYYY-MM-DD)", func() {
+				Pattern(`^\d{4}-\d{2}-\d{2}$`)
+			})
+			Param("registeredTo", String, "Only the apps registered on or before the day (YYYY-MM-DD)", func() {
+				Pattern(`^\d{4}-\d{2}-\d{2}$`)
+			})
+			Param("format", String, "Format of the export", func() {
+				Enum("csv", "json")
+				Default("csv")
+			})
+		})
+		Response(OK)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("bulkApps", func() {
+		Description("Apply an operation to many apps at once, selected by their IDs or by a filter. Admin only.")
+		Routing(POST("/bulk"))
+		Headers(IdempotencyKey)
+		Payload(BulkPayload)
+		Response(OK, BulkResultMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(Conflict, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("uploadLogo", func() {
+		Description("Upload the logo of an app as the \"logo\" file of a multipart/form-data request. PNG, JPEG and GIF images are accepted.")
+		Routing(PUT("/:appId/logo"))
+		Params(func() {
+			Param("appId", String, "App ID")
+		})
+		Response(OK, AppMedia)
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(RequestEntityTooLarge, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("getLogo", func() {
+		Description("Get the logo of an app or its thumbnail")
+		Routing(GET("/:appId/logo"))
+		Params(func() {
+			Param("appId", String, "App ID")
+			Param("size", String, "The original logo or the normalized thumbnail", func() {
+				Enum("original", "thumbnail")
+				Default("original")
+			})
+		})
+		Headers(func() {
+			Header("If-None-Match", String, "ETag of the cached logo")
+		})
+		Response(OK)
+		Response(NotModified)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("deleteLogo", func() {
+		Description("Delete the logo of an app")
+		Routing(DELETE("/:appId/logo"))
+		Params(func() {
+			Param("appId", String, "App ID")
+		})
+		Response(OK, AppMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("getPublic", func() {
+		Description("Get the display-safe fields of an app for consent screens. Does not require authentication.")
+		Routing(GET("/:appId/public"))
+		Params(func() {
+			Param("appId", String, "App ID")
+		})
+		Response(OK, PublicAppMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("getReapingCandidates", func() {
+		Description("Get the unused apps and what the next run of the reaping job would do with them. Admin only.")
+		Routing(GET("/reaping/candidates"))
+		Response(OK, ReapingReportMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("exemptFromReaping", func() {
+		Description("Exempt an app from the reaping job and restore it if it was suspended or deleted. Admin only.")
+		Routing(PUT("/:appId/reaping-exemption"))
+		Params(func() {
+			Param("appId", String, "App ID")
+		})
+		Response(OK, AppMedia)
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("removeReapingExemption", func() {
+		Description("Remove the reaping exemption of an app. Admin only.")
+		Routing(DELETE("/:appId/reaping-exemption"))
+		Params(func() {
+			Param("appId", String, "App ID")
+		})
+		Response(OK, AppMedia)
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
 })
 
 // AppMedia defines the media type used to render client apps.
@@ -115,6 +434,25 @@ var AppMedia = MediaType("application/vnd.goa.apps+json", func() {
 		Attribute("owner", String, "User ID")
 		Attribute("secret", String, "Client secret")
 		Attribute("registeredAt", Integer, "Time when app is registered")
+		Attribute("origins")
+		Attribute("labels")
+		Attribute("annotations")
+		Attribute("rateLimit", RateLimitMedia, "Rate limit and quota policy for the app")
+		Attribute("status", String, "Lifecycle status of the app", func() {
+			Enum("active", "flagged", "suspended", "deleted")
+		})
+		Attribute("reapingExempt", Boolean, "Whether the app is exempt from the reaping job")
+		Attribute("domainVerified", Boolean, "Whether the owner has proven control over the app domain")
+		Attribute("verifiedAt", Integer, "Time of the last successful domain verification")
+		Attribute("logoUrl", String, "Link to the logo of the app")
+		Attribute("logoThumbnailUrl", String, "Link to the normalized thumbnail of the logo")
+		Attribute("privacyPolicyUrl")
+		Attribute("termsOfServiceUrl")
+		Attribute("supportEmail")
+		Attribute("contacts")
+		Attribute("lastUsedAt", Integer, "Time of the last use of the app")
+		Attribute("lastUsedIp", String, "IP address of the last use of the app")
+		Attribute("statusChangedAt", Integer, "Time of the last change of the lifecycle status")
 		Required("id", "name", "description", "domain", "owner", "registeredAt")
 	})
 
@@ -125,6 +463,130 @@ var AppMedia = MediaType("application/vnd.goa.apps+json", func() {
 		Attribute("domain")
 		Attribute("owner")
 		Attribute("registeredAt")
+		Attribute("origins")
+		Attribute("labels")
+		Attribute("annotations")
+		Attribute("rateLimit")
+		Attribute("status")
+		Attribute("reapingExempt")
+		Attribute("domainVerified")
+		Attribute("verifiedAt")
+		Attribute("logoUrl")
+		Attribute("logoThumbnailUrl")
+		Attribute("privacyPolicyUrl")
+		Attribute("termsOfServiceUrl")
+		Attribute("supportEmail")
+		Attribute("contacts")
+	})
+
+	// The admin view adds the usage and lifecycle data of the app. Used by the admin only endpoints.
+	View("admin", func() {
+		Attribute("id")
+		Attribute("name")
+		Attribute("description")
+		Attribute("domain")
+		Attribute("owner")
+		Attribute("registeredAt")
+		Attribute("origins")
+		Attribute("labels")
+		Attribute("annotations")
+		Attribute("rateLimit")
+		Attribute("status")
+		Attribute("reapingExempt")
+		Attribute("domainVerified")
+		Attribute("verifiedAt")
+		Attribute("logoUrl")
+		Attribute("logoThumbnailUrl")
+		Attribute("privacyPolicyUrl")
+		Attribute("termsOfServiceUrl")
+		Attribute("supportEmail")
+		Attribute("contacts")
+		Attribute("lastUsedAt")
+		Attribute("lastUsedIp")
+		Attribute("statusChangedAt")
+	})
+
+	// The withSecret view is the only view that contains the client secret. Used only when the secret is regenerated.
+	View("withSecret", func() {
+		Attribute("id")
+		Attribute("name")
+		Attribute("description")
+		Attribute("domain")
+		Attribute("owner")
+		Attribute("registeredAt")
+		Attribute("origins")
+		Attribute("labels")
+		Attribute("annotations")
+		Attribute("rateLimit")
+		Attribute("status")
+		Attribute("reapingExempt")
+		Attribute("domainVerified")
+		Attribute("verifiedAt")
+		Attribute("logoUrl")
+		Attribute("logoThumbnailUrl")
+		Attribute("privacyPolicyUrl")
+		Attribute("termsOfServiceUrl")
+		Attribute("supportEmail")
+		Attribute("contacts")
+		Attribute("secret")
+	})
+})
+
+// PublicAppMedia defines the media type used to render the display-safe fields of an app on consent screens.
+// It must never contain the owner or the secret of the app.
+var PublicAppMedia = MediaType("application/vnd.goa.public.app+json", func() {
+	TypeName("public-app")
+	Reference(AppMedia)
+
+	Attributes(func() {
+		Attribute("id")
+		Attribute("name")
+		Attribute("description")
+		Attribute("domain")
+		Attribute("domainVerified")
+		Attribute("logoUrl")
+		Attribute("logoThumbnailUrl")
+		Attribute("privacyPolicyUrl")
+		Attribute("termsOfServiceUrl")
+		Attribute("supportEmail")
+		Attribute("contacts")
+		Required("id", "name", "description", "domain")
+	})
+
+	View("default", func() {
+		Attribute("id")
+		Attribute("name")
+		Attribute("description")
+		Attribute("domain")
+		Attribute("domainVerified")
+		Attribute("logoUrl")
+		Attribute("logoThumbnailUrl")
+		Attribute("privacyPolicyUrl")
+		Attribute("termsOfServiceUrl")
+		Attribute("supportEmail")
+		Attribute("contacts")
+	})
+})
+
+// AppPageMedia defines the media type used to render a page of a listing of apps.
+var AppPageMedia = MediaType("application/vnd.goa.app.page+json", func() {
+	TypeName("app-page")
+
+	Attributes(func() {
+		Attribute("apps", CollectionOf(AppMedia), "The apps on the page")
+		Attribute("total", Integer, "Number of apps that match the filters, on all pages")
+		Attribute("page", Integer, "Number of the page, starting from 1")
+		Attribute("pageSize", Integer, "Maximal number of apps on a page")
+		Required("apps", "total", "page", "pageSize")
+	})
+
+	View("default", func() {
+		Attribute("apps", func() {
+			View("admin")
+		})
+		Attribute("total")
+		Attribute("page")
+		Attribute("pageSize")
 	})
 })
 
@@ -144,6 +606,232 @@ var RegAppMedia = MediaType("application/vnd.goa.reg.apps+json", func() {
 	})
 })
 
+// RateLimitMedia defines the media type used to render the rate limit and quota policy of an app.
+var RateLimitMedia = MediaType("application/vnd.goa.rate.limit+json", func() {
+	TypeName("rate-limit")
+	Reference(RateLimitPayload)
+
+	Attributes(func() {
+		Attribute("requestsPerSecond")
+		Attribute("requestsPerMinute")
+		Attribute("requestsPerDay")
+		Attribute("burst")
+		Attribute("monthlyQuota")
+		Required("requestsPerSecond", "requestsPerMinute", "requestsPerDay", "burst", "monthlyQuota")
+	})
+
+	View("default", func() {
+		Attribute("requestsPerSecond")
+		Attribute("requestsPerMinute")
+		Attribute("requestsPerDay")
+		Attribute("burst")
+		Attribute("monthlyQuota")
+	})
+})
+
+// AppUsageMedia defines the media type used to render the usage of an app.
+var AppUsageMedia = MediaType("application/vnd.goa.app.usage+json", func() {
+	TypeName("app-usage")
+
+	Attributes(func() {
+		Attribute("appId", String, "App ID")
+		Attribute("lastUsedAt", Integer, "Time of the last successful verification of the app")
+		Attribute("lastUsedIP", String, "IP address from which the app was last successfully verified")
+		Attribute("daily", ArrayOf(DailyUsageMedia), "Verification counts per day")
+		Required("appId", "daily")
+	})
+
+	View("default", func() {
+		Attribute("appId")
+		Attribute("lastUsedAt")
+		Attribute("lastUsedIP")
+		Attribute("daily")
+	})
+})
+
+// DailyUsageMedia defines the media type used to render the verification counts of an app for a single day.
+var DailyUsageMedia = MediaType("application/vnd.goa.daily.usage+json", func() {
+	TypeName("daily-usage")
+
+	Attributes(func() {
+		Attribute("day", String, "The day (YYYY-MM-DD)")
+		Attribute("success", Integer, "Number of successful verifications")
+		Attribute("failure", Integer, "Number of failed verifications")
+		Required("day", "success", "failure")
+	})
+
+	View("default", func() {
+		Attribute("day")
+		Attribute("success")
+		Attribute("failure")
+	})
+})
+
+// DomainVerificationMedia defines the media type used to render the domain verification status of an app.
+var DomainVerificationMedia = MediaType("application/vnd.goa.domain.verification+json", func() {
+	TypeName("domain-verification")
+
+	Attributes(func() {
+		Attribute("appId", String, "App ID")
+		Attribute("domain", String, "App domain")
+		Attribute("verified", Boolean, "Whether the domain is verified")
+		Attribute("verifiedAt", Integer, "Time of the last successful verification")
+		Attribute("method", String, "Method of the last successful verification (dns or http)")
+		Attribute("checkedAt", Integer, "Time of the last check")
+		Attribute("error", String, "Reason why the last check failed")
+		Attribute("token", String, "Verification token")
+		Attribute("dnsRecordName", String, "Name of the DNS TXT record for the dns method")
+		Attribute("dnsRecordValue", String, "Value of the DNS TXT record for the dns method")
+		Attribute("httpUrl", String, "URL of the file with the token for the http method")
+		Required("appId", "domain", "verified", "token", "dnsRecordName", "dnsRecordValue", "httpUrl")
+	})
+
+	View("default", func() {
+		Attribute("appId")
+		Attribute("domain")
+		Attribute("verified")
+		Attribute("verifiedAt")
+		Attribute("method")
+		Attribute("checkedAt")
+		Attribute("error")
+		Attribute("token")
+		Attribute("dnsRecordName")
+		Attribute("dnsRecordValue")
+		Attribute("httpUrl")
+	})
+})
+
+// OriginCheckMedia defines the media type used to render whether an origin is allowed for an app.
+var OriginCheckMedia = MediaType("application/vnd.goa.origin.check+json", func() {
+	TypeName("origin-check")
+
+	Attributes(func() {
+		Attribute("appId", String, "App ID")
+		Attribute("origin", String, "The checked origin")
+		Attribute("allowed", Boolean, "Whether the origin is allowed for the app")
+		Required("appId", "origin", "allowed")
+	})
+
+	View("default", func() {
+		Attribute("appId")
+		Attribute("origin")
+		Attribute("allowed")
+	})
+})
+
+// ReapingReportMedia defines the media type used to render the report of the reaping job.
+var ReapingReportMedia = MediaType("application/vnd.goa.reaping.report+json", func() {
+	TypeName("reaping-report")
+
+	Attributes(func() {
+		Attribute("generatedAt", Integer, "Time when the report was generated")
+		Attribute("dryRun", Boolean, "Whether the reaping job only reports and does not change the apps")
+		Attribute("candidates", ArrayOf(ReapingCandidateMedia), "Unused apps that are not exempt from reaping")
+		Required("generatedAt", "dryRun", "candidates")
+	})
+
+	View("default", func() {
+		Attribute("generatedAt")
+		Attribute("dryRun")
+		Attribute("candidates")
+	})
+})
+
+// ReapingCandidateMedia defines the media type used to render an unused app and the planned reaping action.
+var ReapingCandidateMedia = MediaType("application/vnd.goa.reaping.candidate+json", func() {
+	TypeName("reaping-candidate")
+
+	Attributes(func() {
+		Attribute("appId", String, "App ID")
+		Attribute("name", String, "Name of the app")
+		Attribute("owner", String, "User ID of the owner")
+		Attribute("status", String, "Current lifecycle status of the app")
+		Attribute("lastActivityAt", Integer, "Time of the last use, registration or restore of the app")
+		Attribute("idleDays", Integer, "Number of days since the last activity")
+		Attribute("action", String, "Action of the next run of the reaping job", func() {
+			Enum("none", "flag", "suspend", "delete")
+		})
+		Required("appId", "name", "owner", "status", "lastActivityAt", "idleDays", "action")
+	})
+
+	View("default", func() {
+		Attribute("appId")
+		Attribute("name")
+		Attribute("owner")
+		Attribute("status")
+		Attribute("lastActivityAt")
+		Attribute("idleDays")
+		Attribute("action")
+	})
+})
+
+// BulkResultMedia defines the media type used to render the result of a bulk operation.
+var BulkResultMedia = MediaType("application/vnd.goa.bulk.result+json", func() {
+	TypeName("bulk-result")
+
+	Attributes(func() {
+		Attribute("operation", String, "Operation applied to the apps")
+		Attribute("dryRun", Boolean, "Whether the operation was only planned, without changing the apps")
+		Attribute("total", Integer, "Number of selected apps")
+		Attribute("succeeded", Integer, "Number of apps that were changed, or would be changed in a dry run")
+		Attribute("failed", Integer, "Number of apps for which the operation failed")
+		Attribute("items", ArrayOf(BulkItemMedia), "Results for the selected apps")
+		Required("operation", "dryRun", "total", "succeeded", "failed", "items")
+	})
+
+	View("default", func() {
+		Attribute("operation")
+		Attribute("dryRun")
+		Attribute("total")
+		Attribute("succeeded")
+		Attribute("failed")
+		Attribute("items")
+	})
+})
+
+// BulkItemMedia defines the media type used to render the result of a bulk operation for a single app.
+var BulkItemMedia = MediaType("application/vnd.goa.bulk.item+json", func() {
+	TypeName("bulk-item")
+
+	Attributes(func() {
+		Attribute("id", String, "App ID")
+		Attribute("result", String, "Outcome of the operation for the app", func() {
+			Enum("applied", "planned", "unchanged", "failed")
+		})
+		Attribute("status", String, "Lifecycle status of the app after the operation")
+		Attribute("error", String, "Why the operation failed for the app")
+		Required("id", "result")
+	})
+
+	View("default", func() {
+		Attribute("id")
+		Attribute("result")
+		Attribute("status")
+		Attribute("error")
+	})
+})
+
+// AppQuotaMedia defines the media type used to render the maximal and the current number of apps for a user.
+var AppQuotaMedia = MediaType("application/vnd.goa.app.quota+json", func() {
+	TypeName("app-quota")
+	Reference(AppQuotaPayload)
+
+	Attributes(func() {
+		Attribute("userId", String, "User ID")
+		Attribute("maxApps")
+		Attribute("used", Integer, "Number of apps the user has registered")
+		Attribute("override", Boolean, "Whether the maximal number of apps is set for this user, instead of the default")
+		Required("userId", "maxApps", "used", "override")
+	})
+
+	View("default", func() {
+		Attribute("userId")
+		Attribute("maxApps")
+		Attribute("used")
+		Attribute("override")
+	})
+})
+
 // AppsPayload defines the payload for the client apps.
 var AppPayload = Type("AppPayload", func() {
 	Description("Payload for the client apps")
@@ -155,10 +843,92 @@ var AppPayload = Type("AppPayload", func() {
 		MaxLength(300)
 	})
 	Attribute("domain", String, "App domain")
+	Attribute("origins", ArrayOf(String), "Allowed origins of the app, like https://app.example.com or https://*.example.com", func() {
+		MaxLength(20)
+	})
+	Attribute("labels", HashOf(String, String), "Labels of the app, used to select apps, like env: prod")
+	Attribute("annotations", HashOf(String, String), "Annotations of the app, free-form metadata that is not used to select apps")
+	Attribute("privacyPolicyUrl", String, "Link to the privacy policy of the app, shown on the consent screen")
+	Attribute("termsOfServiceUrl", String, "Link to the terms of service of the app, shown on the consent screen")
+	Attribute("supportEmail", String, "Email address for support requests, shown on the consent screen")
+	Attribute("contacts", ArrayOf(String), "Email addresses of the people responsible for the app", func() {
+		MaxLength(10)
+	})
 
 	Required("name")
 })
 
+// RateLimitPayload defines the rate limit and quota policy for an app. Zero or missing values mean no limit.
+var RateLimitPayload = Type("RateLimitPayload", func() {
+	Description("Rate limit and quota policy for an app")
+
+	Attribute("requestsPerSecond", Integer, "Maximal number of requests per second", func() {
+		Minimum(0)
+	})
+	Attribute("requestsPerMinute", Integer, "Maximal number of requests per minute", func() {
+		Minimum(0)
+	})
+	Attribute("requestsPerDay", Integer, "Maximal number of requests per day", func() {
+		Minimum(0)
+	})
+	Attribute("burst", Integer, "Maximal number of requests allowed in a single burst", func() {
+		Minimum(0)
+	})
+	Attribute("monthlyQuota", Integer, "Maximal number of requests per calendar month", func() {
+		Minimum(0)
+	})
+})
+
+// AppQuotaPayload defines the payload for the maximal number of apps for a user.
+var AppQuotaPayload = Type("AppQuotaPayload", func() {
+	Description("Maximal number of apps for a user")
+
+	Attribute("maxApps", Integer, "Maximal number of apps the user can register. 0 means no limit.", func() {
+		Minimum(0)
+	})
+	Required("maxApps")
+})
+
+// BulkPayload defines the operation applied to many apps at once.
+var BulkPayload = Type("BulkPayload", func() {
+	Description("Operation applied to many apps at once")
+
+	Attribute("operation", String, "Operation applied to every selected app", func() {
+		Enum("suspend", "reactivate", "delete", "rotate-secret", "set-labels")
+	})
+	Attribute("ids", ArrayOf(String), "IDs of the apps")
+	Attribute("filter", AppFilterPayload, "Filter that selects the apps, used instead of the IDs")
+	Attribute("labels", HashOf(String, String), "Labels to add or change, for the set-labels operation")
+	Attribute("removeLabels", ArrayOf(String), "Keys of the labels to remove, for the set-labels operation")
+	Attribute("dryRun", Boolean, "Only report what the operation would do, without changing the apps")
+	Required("operation")
+})
+
+// AppFilterPayload selects apps by their owner, status, domain and registration period.
+var AppFilterPayload = Type("AppFilterPayload", func() {
+	Description("Filter that selects apps")
+
+	Attribute("owner", String, "Only the apps of the user")
+	Attribute("status", String, "Only the apps with the lifecycle status", func() {
+		Enum("active", "flagged", "suspended", "deleted")
+	})
+	Attribute("domain", String, "Only the apps with the domain")
+	Attribute("registeredFrom", String, "Only the apps registered on or after the day (YYYY-MM-DD)", func() {
+		Pattern(`^\d{4}-\d{2}-\d{2}$`)
+	})
+	Attribute("registeredTo", String, "Only the apps registered on or before the day (YYYY-MM-DD)", func() {
+		Pattern(`^\d{4}-\d{2}-\d{2}$`)
+	})
+})
+
+// IdempotencyKey defines the header that makes a POST request safe to retry. A retry with the same key
+// and payload gets the original response, and the same key with a different payload is rejected.
+var IdempotencyKey = func() {
+	Header("Idempotency-Key", String, "Key that makes the request safe to retry", func() {
+		Pattern(`^[A-Za-z0-9_.:-]{1,255}$`)
+	})
+}
+
 // AppCredentialsPayload holds the app credentials: app ID and app secret.
 var AppCredentialsPayload = Type("AppCredentialsPayload", func() {
 	Description("App ID+secret credentials")
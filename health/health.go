@@ -0,0 +1,191 @@
+// Package health implements the liveness and the readiness probes of the service.
+// Liveness only shows that the service handles requests. Readiness runs checks of the dependencies
+// of the service, so that traffic is routed only to instances that can serve it.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/keitaroinc/goa"
+)
+
+// CheckFunc checks a dependency of the service. It returns an error if the dependency cannot be used.
+// It should give up when the context is done.
+type CheckFunc func(ctx context.Context) error
+
+// Statuses of the readiness report and of the checks.
+const (
+	StatusReady    = "ready"
+	StatusNotReady = "not-ready"
+	StatusOK       = "ok"
+	StatusFailed   = "failed"
+	StatusTimeout  = "timeout"
+)
+
+// Result is the result of a single check.
+type Result struct {
+	Name       string  `json:"name"`
+	Status     string  `json:"status"`
+	Error      string  `json:"error,omitempty"`
+	DurationMs float64 `json:"durationMs"`
+}
+
+// Report is the result of the readiness checks.
+type Report struct {
+	Status string `json:"status"`
+	// Reason explains why the service is not ready when no checks were run, for example while shutting down.
+	Reason string    `json:"reason,omitempty"`
+	Checks []*Result `json:"checks"`
+}
+
+type check struct {
+	name  string
+	check CheckFunc
+}
+
+// Checker runs the readiness checks.
+type Checker struct {
+	// Timeout is the time limit of every check.
+	Timeout time.Duration
+
+	mutex    sync.Mutex
+	checks   []*check
+	notReady int32
+}
+
+// NewChecker creates a Checker without checks, with a time limit for every check.
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{Timeout: timeout}
+}
+
+// Add adds a named readiness check.
+func (c *Checker) Add(name string, checkFunc CheckFunc) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.checks = append(c.checks, &check{name: name, check: checkFunc})
+}
+
+// SetReady marks the service as ready or not ready, regardless of the checks. The service is marked
+// as not ready when it starts shutting down, so that no new traffic is routed to it.
+func (c *Checker) SetReady(ready bool) {
+	value := int32(1)
+	if ready {
+		value = 0
+	}
+	atomic.StoreInt32(&c.notReady, value)
+}
+
+// Check runs all checks concurrently and reports the results in the order the checks were added.
+// A check that does not finish within the timeout is reported as timed out, without waiting for it.
+func (c *Checker) Check(ctx context.Context) *Report {
+	if atomic.LoadInt32(&c.notReady) == 1 {
+		return &Report{Status: StatusNotReady, Reason: "shutting down", Checks: []*Result{}}
+	}
+
+	c.mutex.Lock()
+	checks := append([]*check{}, c.checks...)
+	c.mutex.Unlock()
+
+	results := make([]chan *Result, len(checks))
+	for i, ch := range checks {
+		results[i] = make(chan *Result, 1)
+		go func(ch *check, done chan *Result) {
+			done <- c.run(ctx, ch)
+		}(ch, results[i])
+	}
+
+	report := &Report{Status: StatusReady, Checks: []*Result{}}
+	timeout := time.NewTimer(c.Timeout)
+	defer timeout.Stop()
+	expired := false
+	start := time.Now()
+	for i, done := range results {
+		var result *Result
+		if !expired {
+			select {
+			case result = <-done:
+			case <-timeout.C:
+				expired = true
+			}
+		}
+		if result == nil {
+			select {
+			case result = <-done:
+			default:
+				result = &Result{
+					Name:       checks[i].name,
+					Status:     StatusTimeout,
+					Error:      fmt.Sprintf("no result within %s", c.Timeout),
+					DurationMs: milliseconds(time.Since(start)),
+				}
+			}
+		}
+		if result.Status != StatusOK {
+			report.Status = StatusNotReady
+		}
+		report.Checks = append(report.Checks, result)
+	}
+	return report
+}
+
+func (c *Checker) run(ctx context.Context, ch *check) *Result {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := ch.check(ctx)
+	result := &Result{
+		Name:       ch.name,
+		Status:     StatusOK,
+		DurationMs: milliseconds(time.Since(start)),
+	}
+	if err != nil {
+		result.Status = StatusFailed
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Middleware answers the liveness probe on livePath and the readiness probe on readyPath. The readiness
+// probe answers with 200 when the service is ready and 503 otherwise, with the report as JSON.
+// It should be mounted before the security chain, so that the probes do not need credentials.
+func (c *Checker) Middleware(livePath, readyPath string) goa.Middleware {
+	return func(h goa.Handler) goa.Handler {
+		return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+			if req.Method != http.MethodGet {
+				return h(ctx, rw, req)
+			}
+			switch req.URL.Path {
+			case livePath:
+				writeJSON(rw, http.StatusOK, map[string]string{"status": "alive"})
+				return nil
+			case readyPath:
+				report := c.Check(ctx)
+				status := http.StatusOK
+				if report.Status != StatusReady {
+					status = http.StatusServiceUnavailable
+				}
+				writeJSON(rw, status, report)
+				return nil
+			}
+			return h(ctx, rw, req)
+		}
+	}
+}
+
+func writeJSON(rw http.ResponseWriter, status int, body interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Header().Set("Cache-Control", "no-store")
+	rw.WriteHeader(status)
+	json.NewEncoder(rw).Encode(body)
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
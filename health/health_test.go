@@ -0,0 +1,91 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func serve(t *testing.T, c *Checker, path string) (int, map[string]interface{}) {
+	next := func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		rw.WriteHeader(http.StatusTeapot)
+		return nil
+	}
+	rw := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", path, nil)
+	if err := c.Middleware("/livez", "/readyz")(next)(context.Background(), rw, req); err != nil {
+		t.Fatal(err)
+	}
+	body := map[string]interface{}{}
+	json.Unmarshal(rw.Body.Bytes(), &body)
+	return rw.Code, body
+}
+
+func TestReadiness(t *testing.T) {
+	c := NewChecker(50 * time.Millisecond)
+	c.Add("store", func(ctx context.Context) error { return nil })
+	c.Add("keys", func(ctx context.Context) error { return nil })
+
+	report := c.Check(context.Background())
+	if report.Status != StatusReady || len(report.Checks) != 2 || report.Checks[0].Name != "store" || report.Checks[1].Status != StatusOK {
+		t.Fatalf("Expected the service to be ready, got %+v", report)
+	}
+	if code, body := serve(t, c, "/readyz"); code != http.StatusOK || body["status"] != StatusReady {
+		t.Fatalf("Expected 200 and ready, got %d %v", code, body)
+	}
+
+	c.Add("gateway", func(ctx context.Context) error { return fmt.Errorf("not registered") })
+	report = c.Check(context.Background())
+	if report.Status != StatusNotReady || report.Checks[2].Status != StatusFailed || report.Checks[2].Error != "not registered" {
+		t.Fatalf("Expected a failed check, got %+v", report.Checks[2])
+	}
+	if code, _ := serve(t, c, "/readyz"); code != http.StatusServiceUnavailable {
+		t.Fatalf("Expected 503, got %d", code)
+	}
+}
+
+func TestReadinessTimeout(t *testing.T) {
+	c := NewChecker(20 * time.Millisecond)
+	block := make(chan struct{})
+	defer close(block)
+	c.Add("store", func(ctx context.Context) error {
+		<-block
+		return nil
+	})
+	c.Add("keys", func(ctx context.Context) error { return nil })
+
+	start := time.Now()
+	report := c.Check(context.Background())
+	if time.Since(start) > time.Second {
+		t.Fatal("Expected the check not to wait for the blocked check")
+	}
+	if report.Status != StatusNotReady || report.Checks[0].Status != StatusTimeout || report.Checks[1].Status != StatusOK {
+		t.Fatalf("Expected the blocked check to time out, got %+v %+v", report.Checks[0], report.Checks[1])
+	}
+}
+
+func TestShutdownAndLiveness(t *testing.T) {
+	c := NewChecker(time.Second)
+	c.Add("store", func(ctx context.Context) error { return nil })
+	c.SetReady(false)
+
+	code, body := serve(t, c, "/readyz")
+	if code != http.StatusServiceUnavailable || body["reason"] != "shutting down" {
+		t.Fatalf("Expected the service not to be ready while shutting down, got %d %v", code, body)
+	}
+	if code, body := serve(t, c, "/livez"); code != http.StatusOK || body["status"] != "alive" {
+		t.Fatalf("Expected the service to be alive, got %d %v", code, body)
+	}
+	if code, _ := serve(t, c, "/apps"); code != http.StatusTeapot {
+		t.Fatalf("Expected the other paths to be passed on, got %d", code)
+	}
+
+	c.SetReady(true)
+	if code, _ := serve(t, c, "/readyz"); code != http.StatusOK {
+		t.Fatalf("Expected the service to be ready again, got %d", code)
+	}
+}
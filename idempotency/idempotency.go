@@ -0,0 +1,157 @@
+// Package idempotency remembers the results of the requests sent with an idempotency key, so
+// that a retried request gets the original result instead of being executed again.
+package idempotency
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInProgress is returned when a request with the same key is still being processed, or when
+	// another request has taken over the lease of the key.
+	ErrInProgress = errors.New("a request with the same idempotency key is in progress")
+	// ErrMismatch is returned when the key has already been used for a different request.
+	ErrMismatch = errors.New("the idempotency key has already been used for a different request")
+)
+
+// Record is the saved result of a request.
+type Record struct {
+	// Status is the HTTP status code of the response.
+	Status int
+	// Body is the encoded response.
+	Body []byte
+}
+
+// Store saves the results of the requests for a limited time. Every operation takes the context of the request.
+type Store interface {
+	// Begin reserves the key for a request with the fingerprint. It returns the record of the
+	// earlier request if the key has already been used for a completed request with the same
+	// fingerprint. Otherwise the request should be processed, and Begin returns the lease that
+	// identifies the reservation of the key.
+	Begin(ctx context.Context, key, fingerprint string) (*Record, string, error)
+	// Complete saves the result of the request that holds the lease on the key. It returns
+	// ErrInProgress if another request has taken the key over in the meantime.
+	Complete(ctx context.Context, key, lease string, record *Record) error
+	// Release frees a key that has not been completed, so that the request can be retried. The
+	// key is only freed if the request still holds the lease.
+	Release(ctx context.Context, key, lease string)
+}
+
+// entry is a reserved or completed key of a MemoryStore. The record is nil while the request is in progress.
+type entry struct {
+	fingerprint string
+	lease       string
+	record      *Record
+	expiresAt   time.Time
+}
+
+// MemoryStore is a Store that keeps the records in memory, so they are not shared between the
+// instances of the service and are lost on restart.
+type MemoryStore struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mutex   sync.Mutex
+	entries map[string]*entry
+}
+
+// NewMemoryStore creates a new MemoryStore that keeps every record for the given time.
+func NewMemoryStore(ttl time.Duration) *MemoryStore {
+	return &MemoryStore{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]*entry{},
+	}
+}
+
+// Begin reserves the key for a request with the fingerprint.
+func (s *MemoryStore) Begin(ctx context.Context, key, fingerprint string) (*Record, string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	s.purge(now)
+
+	e, ok := s.entries[key]
+	if !ok {
+		lease, err := NewLease()
+		if err != nil {
+			return nil, "", err
+		}
+		s.entries[key] = &entry{
+			fingerprint: fingerprint,
+			lease:       lease,
+			expiresAt:   now.Add(s.ttl),
+		}
+		return nil, lease, nil
+	}
+	if e.fingerprint != fingerprint {
+		return nil, "", ErrMismatch
+	}
+	if e.record == nil {
+		return nil, "", ErrInProgress
+	}
+	return e.record, "", nil
+}
+
+// Complete saves the result of the request that holds the lease on the key.
+func (s *MemoryStore) Complete(ctx context.Context, key, lease string, record *Record) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		return errors.New("the idempotency key has not been reserved")
+	}
+	if e.lease != lease || e.record != nil {
+		return ErrInProgress
+	}
+	e.record = record
+	e.expiresAt = s.now().Add(s.ttl)
+	return nil
+}
+
+// Release frees a key that has not been completed, if the request still holds the lease.
+func (s *MemoryStore) Release(ctx context.Context, key, lease string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if e, ok := s.entries[key]; ok && e.lease == lease && e.record == nil {
+		delete(s.entries, key)
+	}
+}
+
+// purge removes the expired entries.
+func (s *MemoryStore) purge(now time.Time) {
+	for key, e := range s.entries {
+		if now.After(e.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// NewLease returns a random lease that identifies the reservation of a key.
+func NewLease() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Fingerprint returns a hash that identifies a request by the given values, like the action and its payload.
+func Fingerprint(values ...interface{}) (string, error) {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,74 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+var ctx = context.Background()
+
+func TestMemoryStore(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryStore(time.Hour)
+	store.now = func() time.Time { return now }
+
+	record, lease, err := store.Begin(ctx, "key", "request-1")
+	if err != nil || record != nil || lease == "" {
+		t.Fatalf("Expected the key to be reserved, got %v, %q, %v", record, lease, err)
+	}
+	if _, _, err := store.Begin(ctx, "key", "request-1"); err != ErrInProgress {
+		t.Fatalf("Expected the request to be in progress, got %v", err)
+	}
+
+	if err := store.Complete(ctx, "key", lease, &Record{Status: 200, Body: []byte("result")}); err != nil {
+		t.Fatal(err)
+	}
+	record, _, err = store.Begin(ctx, "key", "request-1")
+	if err != nil || record == nil || string(record.Body) != "result" {
+		t.Fatalf("Expected the saved record, got %v, %v", record, err)
+	}
+	if _, _, err := store.Begin(ctx, "key", "request-2"); err != ErrMismatch {
+		t.Fatalf("Expected a mismatch for a different request, got %v", err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	record, newLease, err := store.Begin(ctx, "key", "request-2")
+	if err != nil || record != nil {
+		t.Fatalf("Expected the expired key to be reserved again, got %v, %v", record, err)
+	}
+	if err := store.Complete(ctx, "key", lease, &Record{Status: 200}); err != ErrInProgress {
+		t.Fatalf("Expected the old lease to be lost, got %v", err)
+	}
+	if err := store.Complete(ctx, "key", newLease, &Record{Status: 200}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemoryStoreRelease(t *testing.T) {
+	store := NewMemoryStore(time.Hour)
+
+	_, lease, _ := store.Begin(ctx, "key", "request-1")
+	store.Release(ctx, "key", lease)
+	record, lease, err := store.Begin(ctx, "key", "request-2")
+	if err != nil || record != nil {
+		t.Fatalf("Expected the released key to be reserved again, got %v, %v", record, err)
+	}
+	store.Release(ctx, "key", "other-lease")
+	if _, _, err := store.Begin(ctx, "key", "request-2"); err != ErrInProgress {
+		t.Fatalf("Expected the key to be kept for the request that holds the lease, got %v", err)
+	}
+
+	if err := store.Complete(ctx, "other", lease, &Record{}); err == nil {
+		t.Fatal("Expected an error for a key that has not been reserved")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	a, _ := Fingerprint("bulkApps", map[string]string{"operation": "suspend"})
+	b, _ := Fingerprint("bulkApps", map[string]string{"operation": "suspend"})
+	c, _ := Fingerprint("bulkApps", map[string]string{"operation": "delete"})
+	if a != b || a == c {
+		t.Fatalf("Expected equal fingerprints only for equal requests: %s %s %s", a, b, c)
+	}
+}
@@ -0,0 +1,205 @@
+// Package invalidation sends the invalidations of the app cache to the other replicas of the service over HTTP,
+// so that a changed app, like one with a regenerated secret, is not served from a stale cache.
+package invalidation
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/keitaroinc/goa"
+)
+
+// TokenHeader is the header that carries the shared token of the replicas.
+const TokenHeader = "X-Invalidation-Token"
+
+// maxQueued is the number of invalidations waiting to be sent. Publish fails when the queue is full.
+const maxQueued = 1024
+
+// Peers returns the base URLs of the replicas of the service.
+type Peers func() ([]string, error)
+
+// DNSPeers returns the replicas that the host resolves to, for example a headless Kubernetes service.
+// The replica that publishes is among them, and invalidates its cache a second time.
+func DNSPeers(host string, port int) Peers {
+	return func() ([]string, error) {
+		addrs, err := net.LookupHost(host)
+		if err != nil {
+			return nil, err
+		}
+		peers := make([]string, len(addrs))
+		for i, addr := range addrs {
+			peers[i] = "http://" + net.JoinHostPort(addr, strconv.Itoa(port))
+		}
+		return peers, nil
+	}
+}
+
+// message is the body of an invalidation request.
+type message struct {
+	AppIDs []string `json:"appIds"`
+}
+
+// Bus sends the invalidations to the peers in the background, and receives the invalidations of the peers.
+type Bus struct {
+	// ErrorHandler is called with the errors of sending the invalidations.
+	ErrorHandler func(err error)
+
+	peers  Peers
+	path   string
+	token  string
+	client *http.Client
+	queue  chan string
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// New creates a Bus that sends the invalidations to the path of the peers, with the shared token.
+func New(peers Peers, path, token string, client *http.Client) *Bus {
+	return &Bus{
+		peers:  peers,
+		path:   path,
+		token:  token,
+		client: client,
+		queue:  make(chan string, maxQueued),
+	}
+}
+
+// Publish queues the invalidation of the app for the peers. It does not wait for the invalidation to be sent.
+func (b *Bus) Publish(appID string) error {
+	select {
+	case b.queue <- appID:
+		return nil
+	default:
+		return fmt.Errorf("invalidation queue is full, dropping the invalidation of %s", appID)
+	}
+}
+
+// Start starts sending the queued invalidations. The invalidations queued together are sent in one request.
+func (b *Bus) Start() {
+	b.stop = make(chan struct{})
+	b.done = make(chan struct{})
+
+	go func() {
+		defer close(b.done)
+		for {
+			select {
+			case appID := <-b.queue:
+				b.handle(b.send(b.drain(appID)))
+			case <-b.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops sending the invalidations. The queued invalidations are sent before it returns.
+func (b *Bus) Stop() {
+	if b.stop == nil {
+		return
+	}
+	close(b.stop)
+	<-b.done
+	b.stop = nil
+
+	select {
+	case appID := <-b.queue:
+		b.handle(b.send(b.drain(appID)))
+	default:
+	}
+}
+
+// drain returns the app ID with the other queued app IDs.
+func (b *Bus) drain(appID string) []string {
+	appIDs := []string{appID}
+	for {
+		select {
+		case next := <-b.queue:
+			appIDs = append(appIDs, next)
+		default:
+			return appIDs
+		}
+	}
+}
+
+// send posts the invalidations to all peers, and returns the first error.
+func (b *Bus) send(appIDs []string) error {
+	peers, err := b.peers()
+	if err != nil {
+		return fmt.Errorf("failed to find the peers: %s", err)
+	}
+	body, err := json.Marshal(&message{AppIDs: appIDs})
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, peer := range peers {
+		if err := b.post(peer+b.path, body); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to send the invalidations to %s: %s", peer, err)
+		}
+	}
+	return firstErr
+}
+
+func (b *Bus) post(url string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TokenHeader, b.token)
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (b *Bus) handle(err error) {
+	if err != nil && b.ErrorHandler != nil {
+		b.ErrorHandler(err)
+	}
+}
+
+// Middleware receives the invalidations of the peers on POST requests to the path of the bus, and calls
+// invalidate for every app. Requests without the shared token are refused, and so are all requests if the
+// bus has no token.
+func (b *Bus) Middleware(invalidate func(appID string)) goa.Middleware {
+	return func(h goa.Handler) goa.Handler {
+		return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+			if req.URL.Path != b.path {
+				return h(ctx, rw, req)
+			}
+			if req.Method != http.MethodPost {
+				rw.WriteHeader(http.StatusMethodNotAllowed)
+				return nil
+			}
+			if b.token == "" || subtle.ConstantTimeCompare([]byte(req.Header.Get(TokenHeader)), []byte(b.token)) != 1 {
+				rw.WriteHeader(http.StatusForbidden)
+				return nil
+			}
+
+			msg := &message{}
+			if err := json.NewDecoder(req.Body).Decode(msg); err != nil {
+				rw.WriteHeader(http.StatusBadRequest)
+				return nil
+			}
+			for _, appID := range msg.AppIDs {
+				invalidate(appID)
+			}
+			rw.WriteHeader(http.StatusNoContent)
+			return nil
+		}
+	}
+}
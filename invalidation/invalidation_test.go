@@ -0,0 +1,113 @@
+package invalidation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type replica struct {
+	mutex       sync.Mutex
+	invalidated []string
+}
+
+func (r *replica) invalidate(appID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.invalidated = append(r.invalidated, appID)
+}
+
+func (r *replica) get() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]string{}, r.invalidated...)
+}
+
+func serveReplica(bus *Bus, r *replica) *httptest.Server {
+	handler := bus.Middleware(r.invalidate)(func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		rw.WriteHeader(http.StatusTeapot)
+		return nil
+	})
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		handler(req.Context(), rw, req)
+	}))
+}
+
+func TestBus(t *testing.T) {
+	receivers := []*replica{{}, {}}
+	servers := []*httptest.Server{}
+	for _, r := range receivers {
+		server := serveReplica(New(nil, "/internal/cache/invalidate", "shared-token", nil), r)
+		defer server.Close()
+		servers = append(servers, server)
+	}
+
+	peers := func() ([]string, error) {
+		return []string{servers[0].URL, servers[1].URL}, nil
+	}
+	bus := New(peers, "/internal/cache/invalidate", "shared-token", &http.Client{Timeout: time.Second})
+	bus.ErrorHandler = func(err error) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	bus.Start()
+	if err := bus.Publish("app-1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Publish("app-2"); err != nil {
+		t.Fatal(err)
+	}
+	bus.Stop()
+
+	for i, r := range receivers {
+		if strings.Join(r.get(), ",") != "app-1,app-2" {
+			t.Errorf("Expected replica %d to invalidate both apps, got %v", i, r.get())
+		}
+	}
+}
+
+func TestBusRefusesWrongToken(t *testing.T) {
+	r := &replica{}
+	server := serveReplica(New(nil, "/internal/cache/invalidate", "shared-token", nil), r)
+	defer server.Close()
+
+	peers := func() ([]string, error) {
+		return []string{server.URL}, nil
+	}
+	bus := New(peers, "/internal/cache/invalidate", "wrong-token", &http.Client{Timeout: time.Second})
+	errs := []error{}
+	bus.ErrorHandler = func(err error) {
+		errs = append(errs, err)
+	}
+	bus.Start()
+	bus.Publish("app-1")
+	bus.Stop()
+
+	if len(r.get()) != 0 || len(errs) != 1 || !strings.Contains(errs[0].Error(), "unexpected status 403") {
+		t.Fatalf("Expected the invalidation to be refused, got %v, %v", r.get(), errs)
+	}
+
+	resp, err := http.Get(server.URL + "/apps")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTeapot {
+		t.Fatalf("Expected the other requests to pass through, got %d", resp.StatusCode)
+	}
+}
+
+func TestPublishQueueFull(t *testing.T) {
+	bus := New(nil, "/internal/cache/invalidate", "token", nil)
+	for i := 0; i < maxQueued; i++ {
+		if err := bus.Publish("app"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := bus.Publish("app"); err == nil {
+		t.Fatal("Expected an error when the queue is full")
+	}
+}
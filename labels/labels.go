@@ -0,0 +1,86 @@
+// Package labels validates the labels and annotations of the apps and parses label selectors.
+// Labels and selectors follow the Kubernetes syntax: a label key is an optional DNS subdomain prefix
+// and a name separated by a slash, like example.com/team, and a selector is a comma separated list of
+// requirements, like env=prod,team in (payments,risk),!deprecated.
+package labels
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxLabels is the maximal number of labels on an app.
+const MaxLabels = 64
+
+// MaxAnnotationsSize is the maximal total size in bytes of the keys and values of the annotations on an app.
+const MaxAnnotationsSize = 64 * 1024
+
+const (
+	maxNameLength   = 63
+	maxPrefixLength = 253
+	maxValueLength  = 63
+)
+
+var (
+	namePattern   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	prefixPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// ValidateKey checks that the key is a valid label or annotation key.
+func ValidateKey(key string) error {
+	name := key
+	if i := strings.Index(key, "/"); i >= 0 {
+		prefix := key[:i]
+		name = key[i+1:]
+		if prefix == "" || len(prefix) > maxPrefixLength || !prefixPattern.MatchString(prefix) {
+			return fmt.Errorf("invalid key %q: the prefix must be a DNS subdomain", key)
+		}
+	}
+	if name == "" || len(name) > maxNameLength || !namePattern.MatchString(name) {
+		return fmt.Errorf("invalid key %q: the name must be at most %d alphanumeric characters, '-', '_' or '.'", key, maxNameLength)
+	}
+	return nil
+}
+
+// ValidateValue checks that the value is a valid label value. The value may be empty.
+func ValidateValue(value string) error {
+	if value == "" {
+		return nil
+	}
+	if len(value) > maxValueLength || !namePattern.MatchString(value) {
+		return fmt.Errorf("invalid value %q: must be at most %d alphanumeric characters, '-', '_' or '.'", value, maxValueLength)
+	}
+	return nil
+}
+
+// Validate checks the keys and the values of the labels.
+func Validate(labels map[string]string) error {
+	if len(labels) > MaxLabels {
+		return fmt.Errorf("too many labels: at most %d are allowed", MaxLabels)
+	}
+	for key, value := range labels {
+		if err := ValidateKey(key); err != nil {
+			return err
+		}
+		if err := ValidateValue(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateAnnotations checks the keys and the total size of the annotations. The values are free-form.
+func ValidateAnnotations(annotations map[string]string) error {
+	size := 0
+	for key, value := range annotations {
+		if err := ValidateKey(key); err != nil {
+			return err
+		}
+		size += len(key) + len(value)
+	}
+	if size > MaxAnnotationsSize {
+		return fmt.Errorf("annotations too large: at most %d bytes are allowed", MaxAnnotationsSize)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package labels
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	valid := map[string]string{
+		"env":                 "prod",
+		"example.com/team":    "payments",
+		"cost-centre":         "cc_1234",
+		"app.kubernetes.io_x": "",
+	}
+	if err := Validate(valid); err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := []map[string]string{
+		{"": "value"},
+		{"-env": "prod"},
+		{"env": "prod value"},
+		{"Example.com/team": "payments"},
+		{"/team": "payments"},
+		{"env": strings.Repeat("a", 64)},
+	}
+	for _, labels := range invalid {
+		if err := Validate(labels); err == nil {
+			t.Errorf("Expected %v to be invalid", labels)
+		}
+	}
+}
+
+func TestValidateAnnotations(t *testing.T) {
+	if err := ValidateAnnotations(map[string]string{"example.com/description": "Any text, even with spaces."}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateAnnotations(map[string]string{"not a key": "value"}); err == nil {
+		t.Fatal("Expected error for invalid key")
+	}
+	if err := ValidateAnnotations(map[string]string{"big": strings.Repeat("a", MaxAnnotationsSize)}); err == nil {
+		t.Fatal("Expected error for too large annotations")
+	}
+}
+
+func TestParse(t *testing.T) {
+	selector, err := Parse("env=prod, team in (payments, risk),tier notin (free),!deprecated,owner,region!=eu,zone==a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Requirement{
+		{Key: "env", Operator: Equals, Values: []string{"prod"}},
+		{Key: "team", Operator: In, Values: []string{"payments", "risk"}},
+		{Key: "tier", Operator: NotIn, Values: []string{"free"}},
+		{Key: "deprecated", Operator: DoesNotExist},
+		{Key: "owner", Operator: Exists},
+		{Key: "region", Operator: NotEquals, Values: []string{"eu"}},
+		{Key: "zone", Operator: Equals, Values: []string{"a"}},
+	}
+	if len(selector) != len(expected) {
+		t.Fatalf("Expected %d requirements, got %d", len(expected), len(selector))
+	}
+	for i, requirement := range selector {
+		if requirement.Key != expected[i].Key || requirement.Operator != expected[i].Operator ||
+			strings.Join(requirement.Values, ",") != strings.Join(expected[i].Values, ",") {
+			t.Errorf("Expected %+v, got %+v", expected[i], *requirement)
+		}
+	}
+
+	equalities := selector.Equalities()
+	if len(equalities) != 2 || equalities["env"] != "prod" || equalities["zone"] != "a" {
+		t.Errorf("Invalid equalities: %v", equalities)
+	}
+
+	if selector, err := Parse(""); err != nil || !selector.Empty() {
+		t.Errorf("Expected empty selector, got %v, %v", selector, err)
+	}
+
+	for _, invalid := range []string{"env=prod,", "team in payments", "team in (payments", "team like (a)", "env=prod value", "=prod"} {
+		if _, err := Parse(invalid); err == nil {
+			t.Errorf("Expected %q to be invalid", invalid)
+		}
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	selector, err := Parse("env=prod,team in (payments,risk),!deprecated")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !selector.Matches(map[string]string{"env": "prod", "team": "risk", "tier": "gold"}) {
+		t.Error("Expected the labels to match")
+	}
+	for _, labels := range []map[string]string{
+		{"env": "dev", "team": "risk"},
+		{"env": "prod", "team": "search"},
+		{"env": "prod"},
+		{"env": "prod", "team": "risk", "deprecated": ""},
+		nil,
+	} {
+		if selector.Matches(labels) {
+			t.Errorf("Expected %v not to match", labels)
+		}
+	}
+
+	notIn, _ := Parse("tier notin (free),region!=eu")
+	if !notIn.Matches(nil) || notIn.Matches(map[string]string{"tier": "free"}) {
+		t.Error("Invalid notin and != matching")
+	}
+}
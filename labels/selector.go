@@ -0,0 +1,194 @@
+package labels
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Operator is the operator of a selector requirement.
+type Operator string
+
+// Selector operators.
+const (
+	// Equals requires the label to have the value.
+	Equals Operator = "="
+	// NotEquals requires the label to be missing or to have a different value.
+	NotEquals Operator = "!="
+	// In requires the label to have one of the values.
+	In Operator = "in"
+	// NotIn requires the label to be missing or to have none of the values.
+	NotIn Operator = "notin"
+	// Exists requires the label to be set.
+	Exists Operator = "exists"
+	// DoesNotExist requires the label not to be set.
+	DoesNotExist Operator = "!"
+)
+
+// Requirement is a single condition on a label.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// Matches checks if the labels satisfy the requirement.
+func (r *Requirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+	switch r.Operator {
+	case Equals:
+		return ok && value == r.Values[0]
+	case NotEquals:
+		return !ok || value != r.Values[0]
+	case In:
+		return ok && contains(r.Values, value)
+	case NotIn:
+		return !ok || !contains(r.Values, value)
+	case Exists:
+		return ok
+	case DoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+// Selector selects apps by their labels. All requirements must be satisfied. An empty selector selects everything.
+type Selector []*Requirement
+
+// Matches checks if the labels satisfy all requirements of the selector.
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, requirement := range s {
+		if !requirement.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty checks if the selector has no requirements.
+func (s Selector) Empty() bool {
+	return len(s) == 0
+}
+
+// Equalities returns the labels required to have a single value. These requirements can be passed to the backend as filters.
+func (s Selector) Equalities() map[string]string {
+	equalities := map[string]string{}
+	for _, requirement := range s {
+		if requirement.Operator == Equals || (requirement.Operator == In && len(requirement.Values) == 1) {
+			equalities[requirement.Key] = requirement.Values[0]
+		}
+	}
+	return equalities
+}
+
+// Parse parses a label selector, like env=prod,team in (payments,risk),!deprecated.
+func Parse(selector string) (Selector, error) {
+	parsed := Selector{}
+	for _, part := range splitRequirements(selector) {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			if strings.TrimSpace(selector) == "" {
+				continue
+			}
+			return nil, fmt.Errorf("invalid selector %q: empty requirement", selector)
+		}
+		requirement, err := parseRequirement(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector %q: %s", selector, err)
+		}
+		parsed = append(parsed, requirement)
+	}
+	return parsed, nil
+}
+
+// splitRequirements splits the selector on the commas that are not within parentheses.
+func splitRequirements(selector string) []string {
+	parts := []string{}
+	depth := 0
+	start := 0
+	for i, r := range selector {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, selector[start:])
+}
+
+// parseRequirement parses a single requirement of a selector.
+func parseRequirement(part string) (*Requirement, error) {
+	if strings.HasPrefix(part, "!") && !strings.Contains(part, "=") {
+		return newRequirement(strings.TrimSpace(part[1:]), DoesNotExist, nil)
+	}
+
+	if i := strings.Index(part, "!="); i >= 0 {
+		return newRequirement(part[:i], NotEquals, []string{part[i+2:]})
+	}
+	if i := strings.Index(part, "=="); i >= 0 {
+		return newRequirement(part[:i], Equals, []string{part[i+2:]})
+	}
+	if i := strings.Index(part, "="); i >= 0 {
+		return newRequirement(part[:i], Equals, []string{part[i+1:]})
+	}
+
+	fields := strings.Fields(part)
+	if len(fields) == 1 {
+		return newRequirement(fields[0], Exists, nil)
+	}
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("invalid requirement %q", part)
+	}
+
+	key := fields[0]
+	rest := strings.TrimSpace(strings.TrimPrefix(part, key))
+	var operator Operator
+	switch {
+	case strings.HasPrefix(rest, string(NotIn)):
+		operator = NotIn
+	case strings.HasPrefix(rest, string(In)):
+		operator = In
+	default:
+		return nil, fmt.Errorf("invalid requirement %q: unknown operator", part)
+	}
+
+	list := strings.TrimSpace(strings.TrimPrefix(rest, string(operator)))
+	if !strings.HasPrefix(list, "(") || !strings.HasSuffix(list, ")") {
+		return nil, fmt.Errorf("invalid requirement %q: the values must be in parentheses", part)
+	}
+	values := []string{}
+	for _, value := range strings.Split(list[1:len(list)-1], ",") {
+		values = append(values, strings.TrimSpace(value))
+	}
+	return newRequirement(key, operator, values)
+}
+
+// newRequirement validates the key and the values and creates the requirement.
+func newRequirement(key string, operator Operator, values []string) (*Requirement, error) {
+	key = strings.TrimSpace(key)
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+	for i, value := range values {
+		values[i] = strings.TrimSpace(value)
+		if err := ValidateValue(values[i]); err != nil {
+			return nil, err
+		}
+	}
+	return &Requirement{Key: key, Operator: operator, Values: values}, nil
+}
+
+// contains checks if the value is in the list.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
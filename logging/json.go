@@ -0,0 +1,102 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/keitaroinc/goa"
+)
+
+// Formats of the log.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// NewJSONAdapter returns a goa.LogAdapter that writes every message as a JSON object on its own line, with the
+// time, the level, the message and the keyvals, for example:
+//
+//	{"time":"2020-05-04T10:00:00.000Z","level":"info","msg":"completed","req_id":"abc-1","status":200}
+//
+// The request logs of goa carry the request ID in req_id.
+func NewJSONAdapter(w io.Writer) goa.LogAdapter {
+	return &jsonAdapter{out: &syncWriter{w: w}}
+}
+
+// syncWriter writes every line at once, so that the lines of the concurrent requests do not interleave.
+type syncWriter struct {
+	mutex sync.Mutex
+	w     io.Writer
+}
+
+func (s *syncWriter) write(line []byte) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.w.Write(line)
+}
+
+type jsonAdapter struct {
+	out     *syncWriter
+	keyvals []interface{}
+}
+
+func (a *jsonAdapter) Info(msg string, keyvals ...interface{}) {
+	a.log(LevelInfo, msg, keyvals)
+}
+
+func (a *jsonAdapter) Error(msg string, keyvals ...interface{}) {
+	a.log(LevelError, msg, keyvals)
+}
+
+func (a *jsonAdapter) New(keyvals ...interface{}) goa.LogAdapter {
+	return &jsonAdapter{out: a.out, keyvals: append(append([]interface{}{}, a.keyvals...), keyvals...)}
+}
+
+func (a *jsonAdapter) log(level, msg string, keyvals []interface{}) {
+	buf := &bytes.Buffer{}
+	buf.WriteString(`{"time":`)
+	writeJSON(buf, time.Now().UTC().Format(time.RFC3339Nano))
+	buf.WriteString(`,"level":`)
+	writeJSON(buf, level)
+	buf.WriteString(`,"msg":`)
+	writeJSON(buf, msg)
+
+	all := append(append([]interface{}{}, a.keyvals...), keyvals...)
+	for i := 0; i < len(all); i += 2 {
+		var value interface{} = "MISSING"
+		if i+1 < len(all) {
+			value = all[i+1]
+		}
+		buf.WriteByte(',')
+		writeJSON(buf, fmt.Sprint(all[i]))
+		buf.WriteByte(':')
+		writeJSON(buf, jsonValue(value))
+	}
+	buf.WriteString("}\n")
+	a.out.write(buf.Bytes())
+}
+
+// jsonValue converts the values that have no useful JSON form, like errors, to strings.
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case error:
+		return v.Error()
+	case time.Duration:
+		return v.String()
+	case fmt.Stringer:
+		return v.String()
+	}
+	return value
+}
+
+func writeJSON(buf *bytes.Buffer, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		data, _ = json.Marshal(fmt.Sprint(value))
+	}
+	buf.Write(data)
+}
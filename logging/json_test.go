@@ -0,0 +1,37 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestJSONAdapter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewJSONAdapter(buf).New("service", "apps-management")
+	logger.Error("store failed", "err", fmt.Errorf("connection refused"), "duration", 1500*time.Millisecond, "attempts", 3, "odd")
+
+	entry := map[string]interface{}{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Expected a JSON line, got %s: %s", buf.String(), err)
+	}
+	expected := map[string]interface{}{
+		"level":    "error",
+		"msg":      "store failed",
+		"service":  "apps-management",
+		"err":      "connection refused",
+		"duration": "1.5s",
+		"attempts": float64(3),
+		"odd":      "MISSING",
+	}
+	for key, value := range expected {
+		if entry[key] != value {
+			t.Errorf("Expected %s to be %v, got %v", key, value, entry[key])
+		}
+	}
+	if _, err := time.Parse(time.RFC3339Nano, fmt.Sprint(entry["time"])); err != nil {
+		t.Errorf("Expected the time of the message, got %v", entry["time"])
+	}
+}
@@ -0,0 +1,84 @@
+// Package logging writes the log of the service: as JSON lines, with the secrets and the credentials redacted,
+// and filtered by a level that can be changed while the service runs.
+package logging
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/keitaroinc/goa"
+)
+
+// Levels of the log messages, from the lowest.
+const (
+	LevelInfo  = "info"
+	LevelError = "error"
+)
+
+// ParseLevel checks the name of a level.
+func ParseLevel(name string) (string, error) {
+	switch name {
+	case LevelInfo, LevelError:
+		return name, nil
+	}
+	return "", fmt.Errorf("unknown level %q, expected %s or %s", name, LevelInfo, LevelError)
+}
+
+// Level is the lowest level of the logged messages. It is safe to change concurrently with logging.
+type Level struct {
+	errorsOnly int32
+}
+
+// NewLevel creates a Level set to the named level.
+func NewLevel(name string) (*Level, error) {
+	level := &Level{}
+	return level, level.Set(name)
+}
+
+// Set changes the level.
+func (l *Level) Set(name string) error {
+	name, err := ParseLevel(name)
+	if err != nil {
+		return err
+	}
+	var errorsOnly int32
+	if name == LevelError {
+		errorsOnly = 1
+	}
+	atomic.StoreInt32(&l.errorsOnly, errorsOnly)
+	return nil
+}
+
+// String returns the name of the level.
+func (l *Level) String() string {
+	if atomic.LoadInt32(&l.errorsOnly) == 1 {
+		return LevelError
+	}
+	return LevelInfo
+}
+
+// NewAdapter returns a goa.LogAdapter that passes to the adapter the messages at or above the level.
+// The loggers created with New share the level.
+func NewAdapter(adapter goa.LogAdapter, level *Level) goa.LogAdapter {
+	return &leveledAdapter{adapter: adapter, level: level}
+}
+
+type leveledAdapter struct {
+	adapter goa.LogAdapter
+	level   *Level
+}
+
+func (a *leveledAdapter) Info(msg string, keyvals ...interface{}) {
+	if atomic.LoadInt32(&a.level.errorsOnly) == 1 {
+		return
+	}
+	a.adapter.Info(msg, keyvals...)
+}
+
+func (a *leveledAdapter) Error(msg string, keyvals ...interface{}) {
+	a.adapter.Error(msg, keyvals...)
+}
+
+func (a *leveledAdapter) New(keyvals ...interface{}) goa.LogAdapter {
+	return &leveledAdapter{adapter: a.adapter.New(keyvals...), level: a.level}
+}
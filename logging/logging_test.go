@@ -0,0 +1,43 @@
+package logging
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/keitaroinc/goa"
+)
+
+func TestLevel(t *testing.T) {
+	buf := &bytes.Buffer{}
+	level, err := NewLevel(LevelInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := NewAdapter(goa.NewLogger(log.New(buf, "", 0)), level).New("service", "apps")
+
+	logger.Info("first info")
+	if err := level.Set(LevelError); err != nil {
+		t.Fatal(err)
+	}
+	logger.Info("second info")
+	logger.Error("an error")
+
+	output := buf.String()
+	if !strings.Contains(output, "first info") || strings.Contains(output, "second info") || !strings.Contains(output, "an error") {
+		t.Fatalf("Expected only the messages at the level, got %s", output)
+	}
+	if level.String() != LevelError {
+		t.Fatalf("Expected the error level, got %s", level)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	if _, err := ParseLevel("debug"); err == nil {
+		t.Fatal("Expected an error for an unknown level")
+	}
+	if _, err := NewLevel("verbose"); err == nil {
+		t.Fatal("Expected an error for an unknown level")
+	}
+}
@@ -0,0 +1,170 @@
+package logging
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/keitaroinc/goa"
+)
+
+// Redacted replaces the values of the sensitive fields in the log.
+const Redacted = "REDACTED"
+
+// DefaultSensitiveFields are the fields that are always redacted: the app secrets, the credentials and the tokens.
+var DefaultSensitiveFields = []string{
+	"secret",
+	"client_secret",
+	"authorization",
+	"proxy-authorization",
+	"cookie",
+	"set-cookie",
+	"token",
+	"access_token",
+	"refresh_token",
+	"id_token",
+	"password",
+	"pass",
+	"api_key",
+	"x-api-key",
+}
+
+// Redactor removes the values of the sensitive fields from the log keyvals. The names of the fields are matched
+// ignoring the case, dashes and underscores, so "client_secret" also matches "clientSecret" and "Client-Secret".
+type Redactor struct {
+	fields map[string]bool
+}
+
+// NewRedactor creates a Redactor of the DefaultSensitiveFields and of the additional fields.
+func NewRedactor(fields ...string) *Redactor {
+	r := &Redactor{fields: map[string]bool{}}
+	for _, field := range append(append([]string{}, DefaultSensitiveFields...), fields...) {
+		r.fields[normalize(field)] = true
+	}
+	return r
+}
+
+func normalize(name string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(name))
+}
+
+// IsSensitive checks if the field is redacted.
+func (r *Redactor) IsSensitive(field string) bool {
+	return r.fields[normalize(field)]
+}
+
+// Keyvals returns a copy of the keyvals of a log message with the sensitive values redacted. The values of the
+// sensitive keys are redacted, and so are the sensitive fields nested in maps, structs, JSON strings, like
+// the raw request payloads, and URL query strings.
+func (r *Redactor) Keyvals(keyvals []interface{}) []interface{} {
+	redacted := make([]interface{}, len(keyvals))
+	for i := 0; i < len(keyvals); i += 2 {
+		redacted[i] = keyvals[i]
+		if i+1 == len(keyvals) {
+			break
+		}
+		if key, ok := keyvals[i].(string); ok && r.IsSensitive(key) {
+			redacted[i+1] = Redacted
+			continue
+		}
+		redacted[i+1] = r.Value(keyvals[i+1])
+	}
+	return redacted
+}
+
+// Value returns the value with the sensitive fields nested in it redacted.
+func (r *Redactor) Value(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return value
+	case string:
+		return r.redactString(v)
+	case error:
+		return r.redactString(v.Error())
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if r.IsSensitive(key) {
+				redacted[key] = Redacted
+				continue
+			}
+			redacted[key] = r.Value(item)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, item := range v {
+			redacted[i] = r.Value(item)
+		}
+		return redacted
+	}
+
+	switch reflect.Indirect(reflect.ValueOf(value)).Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		// Structs, like the decoded payloads, are redacted in their JSON form.
+		data, err := json.Marshal(value)
+		if err != nil {
+			return value
+		}
+		var generic interface{}
+		if err := json.Unmarshal(data, &generic); err != nil {
+			return value
+		}
+		return r.Value(generic)
+	}
+	return value
+}
+
+// redactString redacts a JSON document, also at the end of a text like a wrapped error, or the query string of a URL.
+func (r *Redactor) redactString(value string) string {
+	if start := strings.IndexAny(value, "{["); start >= 0 {
+		var generic interface{}
+		if err := json.Unmarshal([]byte(value[start:]), &generic); err == nil {
+			data, err := json.Marshal(r.Value(generic))
+			if err == nil {
+				return value[:start] + string(data)
+			}
+		}
+	}
+	if strings.Contains(value, "?") {
+		if parsed, err := url.Parse(value); err == nil && parsed.RawQuery != "" {
+			query := parsed.Query()
+			changed := false
+			for key := range query {
+				if r.IsSensitive(key) {
+					query.Set(key, Redacted)
+					changed = true
+				}
+			}
+			if changed {
+				parsed.RawQuery = query.Encode()
+				return parsed.String()
+			}
+		}
+	}
+	return value
+}
+
+// NewRedactingAdapter returns a goa.LogAdapter that redacts the keyvals of the messages before passing them
+// to the adapter.
+func NewRedactingAdapter(adapter goa.LogAdapter, redactor *Redactor) goa.LogAdapter {
+	return &redactingAdapter{adapter: adapter, redactor: redactor}
+}
+
+type redactingAdapter struct {
+	adapter  goa.LogAdapter
+	redactor *Redactor
+}
+
+func (a *redactingAdapter) Info(msg string, keyvals ...interface{}) {
+	a.adapter.Info(msg, a.redactor.Keyvals(keyvals)...)
+}
+
+func (a *redactingAdapter) Error(msg string, keyvals ...interface{}) {
+	a.adapter.Error(msg, a.redactor.Keyvals(keyvals)...)
+}
+
+func (a *redactingAdapter) New(keyvals ...interface{}) goa.LogAdapter {
+	return &redactingAdapter{adapter: a.adapter.New(a.redactor.Keyvals(keyvals)...), redactor: a.redactor}
+}
@@ -0,0 +1,87 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type verifyPayload struct {
+	ID     string `json:"id"`
+	Secret string `json:"secret"`
+}
+
+func TestSecretsNeverReachTheLog(t *testing.T) {
+	buf := &bytes.Buffer{}
+	level, err := NewLevel(LevelInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := NewAdapter(NewRedactingAdapter(NewJSONAdapter(buf), NewRedactor("verificationToken")), level)
+
+	secrets := []string{"s3cr3t-app", "bearer-token-value", "query-secret", "nested-secret", "refresh-me", "dns-token", "cookie-value"}
+
+	// The messages that middleware.LogRequest(true) of goa writes for POST /apps/verify
+	requestLogger := logger.New("req_id", "req-42")
+	requestLogger.Info("started", "POST", "/apps/verify?client_secret=query-secret&page=1", "from", "10.0.0.1")
+	requestLogger.Info("headers", "Authorization", "Bearer bearer-token-value", "Content-Type", "application/json", "Cookie", "session=cookie-value")
+	requestLogger.Info("payload", "raw", `{"id":"5e9d","secret":"s3cr3t-app"}`)
+	requestLogger.Info("payload", "id", "5e9d", "secret", "s3cr3t-app")
+	requestLogger.Info("payload", "payload", &verifyPayload{ID: "5e9d", Secret: "s3cr3t-app"})
+	requestLogger.Info("nested", "data", map[string]interface{}{
+		"app":    map[string]interface{}{"clientSecret": "nested-secret"},
+		"tokens": []interface{}{map[string]interface{}{"refresh_token": "refresh-me"}},
+	})
+	requestLogger.Error("verification failed", "err", fmt.Errorf(`store: {"verificationToken":"dns-token"}`))
+
+	output := buf.String()
+	for _, secret := range secrets {
+		if strings.Contains(output, secret) {
+			t.Errorf("Expected %q to be redacted, got:\n%s", secret, output)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 7 {
+		t.Fatalf("Expected 7 lines, got %d:\n%s", len(lines), output)
+	}
+	for _, line := range lines {
+		entry := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Expected a JSON line, got %s: %s", line, err)
+		}
+		if entry["req_id"] != "req-42" {
+			t.Errorf("Expected the request ID in every line, got %s", line)
+		}
+	}
+	if !strings.Contains(output, `/apps/verify?client_secret=REDACTED\u0026page=1`) {
+		t.Errorf("Expected the other query parameters to be kept, got %s", output)
+	}
+	if !strings.Contains(output, `"Content-Type":"application/json"`) || !strings.Contains(output, `"Authorization":"REDACTED"`) {
+		t.Errorf("Expected only the sensitive headers to be redacted, got %s", output)
+	}
+}
+
+func TestRedactorFieldNames(t *testing.T) {
+	redactor := NewRedactor("X-Internal-Key")
+	for _, field := range []string{"secret", "client_secret", "clientSecret", "Client-Secret", "AUTHORIZATION", "x_internal_key", "accessToken"} {
+		if !redactor.IsSensitive(field) {
+			t.Errorf("Expected %s to be sensitive", field)
+		}
+	}
+	for _, field := range []string{"id", "name", "secretRotatedAt", "Content-Type"} {
+		if redactor.IsSensitive(field) {
+			t.Errorf("Expected %s not to be sensitive", field)
+		}
+	}
+}
+
+func TestRedactorKeepsValues(t *testing.T) {
+	redactor := NewRedactor()
+	keyvals := redactor.Keyvals([]interface{}{"status", 200, "raw", "not {json", "odd"})
+	if keyvals[1] != 200 || keyvals[3] != "not {json" || keyvals[4] != "odd" || len(keyvals) != 5 {
+		t.Fatalf("Expected the values without secrets to be kept, got %v", keyvals)
+	}
+}
@@ -0,0 +1,177 @@
+// Package logo validates the uploaded app logos and generates their thumbnails.
+package logo
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"net/http"
+
+	// Register the decoders of the supported formats.
+	_ "image/gif"
+	_ "image/jpeg"
+)
+
+// ThumbnailContentType is the content type of the generated thumbnails.
+const ThumbnailContentType = "image/png"
+
+// contentTypes maps the supported content types to the format names of the image package.
+var contentTypes = map[string]string{
+	"image/png":  "png",
+	"image/jpeg": "jpeg",
+	"image/gif":  "gif",
+}
+
+// Limits restrict the uploaded logos.
+type Limits struct {
+	// MaxBytes is the maximal size of the logo file.
+	MaxBytes int
+	// MinSize is the minimal width and height of the logo in pixels.
+	MinSize int
+	// MaxSize is the maximal width and height of the logo in pixels.
+	MaxSize int
+	// ThumbnailSize is the width and height of the generated thumbnail in pixels.
+	ThumbnailSize int
+}
+
+// Error is returned when the uploaded logo is not acceptable.
+type Error struct {
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func invalid(format string, args ...interface{}) error {
+	return &Error{Message: fmt.Sprintf(format, args...)}
+}
+
+// Logo is a validated logo with its thumbnail.
+type Logo struct {
+	ContentType string
+	Width       int
+	Height      int
+	// Hash is the hex encoded SHA-256 hash of the logo, used as the ETag.
+	Hash      string
+	Thumbnail []byte
+}
+
+// Process validates the logo and generates its thumbnail. The content type is sniffed from the data,
+// and only PNG, JPEG and GIF images are accepted.
+func Process(data []byte, limits Limits) (*Logo, error) {
+	if len(data) == 0 {
+		return nil, invalid("the logo is empty")
+	}
+	if limits.MaxBytes > 0 && len(data) > limits.MaxBytes {
+		return nil, invalid("the logo is larger than %d bytes", limits.MaxBytes)
+	}
+
+	contentType := http.DetectContentType(data)
+	format, ok := contentTypes[contentType]
+	if !ok {
+		return nil, invalid("unsupported logo type %s, the logo must be a PNG, JPEG or GIF image", contentType)
+	}
+
+	config, configFormat, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || configFormat != format {
+		return nil, invalid("the logo is not a valid %s image", format)
+	}
+	if config.Width < limits.MinSize || config.Height < limits.MinSize {
+		return nil, invalid("the logo must be at least %dx%d pixels", limits.MinSize, limits.MinSize)
+	}
+	if limits.MaxSize > 0 && (config.Width > limits.MaxSize || config.Height > limits.MaxSize) {
+		return nil, invalid("the logo must be at most %dx%d pixels", limits.MaxSize, limits.MaxSize)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, invalid("the logo is not a valid %s image", format)
+	}
+
+	var thumbnail bytes.Buffer
+	if err := png.Encode(&thumbnail, Thumbnail(img, limits.ThumbnailSize)); err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(data)
+
+	return &Logo{
+		ContentType: contentType,
+		Width:       config.Width,
+		Height:      config.Height,
+		Hash:        hex.EncodeToString(hash[:]),
+		Thumbnail:   thumbnail.Bytes(),
+	}, nil
+}
+
+// Thumbnail scales the image to fit a size x size square, keeping the aspect ratio, and centers it on
+// a transparent background.
+func Thumbnail(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	width, height := size, size
+	if bounds.Dx() > bounds.Dy() {
+		height = max(1, bounds.Dy()*size/bounds.Dx())
+	} else {
+		width = max(1, bounds.Dx()*size/bounds.Dy())
+	}
+
+	thumbnail := image.NewNRGBA(image.Rect(0, 0, size, size))
+	offset := image.Pt((size-width)/2, (size-height)/2)
+	draw.Draw(thumbnail, image.Rect(offset.X, offset.Y, offset.X+width, offset.Y+height), scale(img, width, height), image.Point{}, draw.Src)
+	return thumbnail
+}
+
+// scale resizes the image to width x height. Each pixel of the result is the average of the pixels of the
+// source area it covers, which gives smooth results when scaling down.
+func scale(img image.Image, width, height int) image.Image {
+	bounds := img.Bounds()
+	scaled := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+			scaled.Set(x, y, average(img, x0, y0, x1, y1))
+		}
+	}
+	return scaled
+}
+
+// average returns the average color of the pixels in the rectangle, weighted by their alpha.
+func average(img image.Image, x0, y0, x1, y1 int) color.NRGBA {
+	var r, g, b, a, n uint64
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			pr, pg, pb, pa := img.At(x, y).RGBA()
+			r += uint64(pr)
+			g += uint64(pg)
+			b += uint64(pb)
+			a += uint64(pa)
+			n++
+		}
+	}
+	if a == 0 {
+		return color.NRGBA{}
+	}
+	// the RGBA values are alpha-premultiplied, so dividing by the total alpha gives the straight color
+	return color.NRGBA{
+		R: uint8(r * 0xff / a),
+		G: uint8(g * 0xff / a),
+		B: uint8(b * 0xff / a),
+		A: uint8((a / n) >> 8),
+	}
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,76 @@
+package logo
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+var limits = Limits{MaxBytes: 1 << 20, MinSize: 16, MaxSize: 1024, ThumbnailSize: 64}
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: 200, G: 10, B: 10, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestProcess(t *testing.T) {
+	logo, err := Process(encodePNG(t, 200, 100), limits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if logo.ContentType != "image/png" || logo.Width != 200 || logo.Height != 100 || len(logo.Hash) != 64 {
+		t.Fatalf("Invalid logo: %+v", logo)
+	}
+
+	thumbnail, err := png.Decode(bytes.NewReader(logo.Thumbnail))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thumbnail.Bounds().Dx() != 64 || thumbnail.Bounds().Dy() != 64 {
+		t.Fatalf("Invalid thumbnail size: %v", thumbnail.Bounds())
+	}
+	if _, _, _, a := thumbnail.At(32, 2).RGBA(); a != 0 {
+		t.Error("Expected transparent padding above the logo")
+	}
+	if r, _, _, a := thumbnail.At(32, 32).RGBA(); a != 0xffff || r>>8 != 200 {
+		t.Errorf("Expected the logo color in the center, got r=%d a=%d", r>>8, a)
+	}
+
+	var jpg bytes.Buffer
+	if err := jpeg.Encode(&jpg, image.NewRGBA(image.Rect(0, 0, 32, 32)), nil); err != nil {
+		t.Fatal(err)
+	}
+	if logo, err := Process(jpg.Bytes(), limits); err != nil || logo.ContentType != "image/jpeg" {
+		t.Fatalf("Expected a JPEG logo, got %+v, %v", logo, err)
+	}
+}
+
+func TestProcessInvalid(t *testing.T) {
+	cases := map[string][]byte{
+		"empty":          {},
+		"not an image":   []byte("<svg xmlns='http://www.w3.org/2000/svg'></svg>"),
+		"truncated":      encodePNG(t, 32, 32)[:40],
+		"too small":      encodePNG(t, 8, 8),
+		"too large":      encodePNG(t, 2000, 20),
+		"too many bytes": append(encodePNG(t, 32, 32), make([]byte, limits.MaxBytes)...),
+	}
+
+	for name, data := range cases {
+		_, err := Process(data, limits)
+		if _, ok := err.(*Error); !ok {
+			t.Errorf("%s: expected a logo error, got %v", name, err)
+		}
+	}
+}
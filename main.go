@@ -3,13 +3,33 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"os"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/Microkubes/microservice-apps-management/blob"
+	"github.com/Microkubes/microservice-apps-management/bulk"
 	"github.com/Microkubes/microservice-apps-management/db"
+	"github.com/Microkubes/microservice-apps-management/health"
+	"github.com/Microkubes/microservice-apps-management/invalidation"
+	"github.com/Microkubes/microservice-apps-management/logging"
+	"github.com/Microkubes/microservice-apps-management/metrics"
+	"github.com/Microkubes/microservice-apps-management/reaper"
+	"github.com/Microkubes/microservice-apps-management/registration"
+	"github.com/Microkubes/microservice-apps-management/reload"
+	"github.com/Microkubes/microservice-apps-management/shutdown"
+	"github.com/Microkubes/microservice-apps-management/tracing"
+	"github.com/Microkubes/microservice-apps-management/usage"
+	"github.com/Microkubes/microservice-apps-management/verification"
 	"github.com/Microkubes/microservice-security/chain"
 	"github.com/Microkubes/microservice-security/flow"
 	"github.com/Microkubes/microservice-tools/config"
@@ -27,62 +47,314 @@ func main() {
 	// Load configuration
 	gatewayAdminURL, configFile := loadGatewaySettings()
 
-	conf, err := config.LoadConfig(configFile)
+	settings, err := LoadSettings(os.Args[1:], os.Getenv, configFile)
+	if err == flag.ErrHelp {
+		return
+	}
 	if err != nil {
 		service.LogError("config", "err", err)
+		os.Exit(1)
+	}
+	if settings.PrintConfig {
+		printed, err := settings.Redacted()
+		if err != nil {
+			service.LogError("config", "err", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(printed))
 		return
 	}
+	conf, appsConfig := settings.Service, settings.Apps
 
-	// Gateway self-registration
-	unregisterService := registerMicroservice(gatewayAdminURL, conf)
-	defer unregisterService() // defer the unregister for after main exits
+	logLevel, err := logging.NewLevel(appsConfig.Logging.Level)
+	if err != nil {
+		service.LogError("config", "err", err)
+		os.Exit(1)
+	}
+	service.WithLogger(newLogger(&appsConfig.Logging, logLevel))
+
+	// Gateway self-registration, started once the service listens
+	registrar := registration.New(newGateway(gatewayAdminURL, conf),
+		time.Duration(appsConfig.GatewayRegistration.InitialBackoff),
+		time.Duration(appsConfig.GatewayRegistration.MaxBackoff),
+		time.Duration(appsConfig.GatewayRegistration.ReconcileInterval))
+	registrar.ErrorHandler = func(err error) {
+		service.LogError("gateway registration", "err", err)
+	}
+	registrar.RegisteredHandler = func() {
+		service.LogInfo("gateway registration", "registered", true)
+	}
 
 	// create security chain
-	securityChain, cleanup, err := flow.NewSecurityFromConfig(conf)
+	securityChain, securityCleanup, err := flow.NewSecurityFromConfig(conf)
 	if err != nil {
 		panic(err)
 	}
-	defer cleanup()
 
-	store, cleanup, err := db.NewAppsManagementStore(&conf.DBConfig)
+	store, storeCleanup, err := db.NewAppsManagementStore(&conf.DBConfig)
 	if err != nil {
 		log.Fatal("Failed to connect to db: ", err)
 	}
-	defer cleanup()
+
+	// The background jobs are stopped on shutdown, in the reverse order of starting.
+	backgroundJobs := []func(){}
+
+	// Every operation of the store times out, also when the context of the request has no deadline.
+	store = db.NewTimeoutStore(store, time.Duration(appsConfig.Store.Timeout), appsConfig.Store.OperationTimeouts())
+
+	serviceMetrics := metrics.New()
+	if appsConfig.Metrics.Enabled {
+		store = db.NewInstrumentedStore(store, serviceMetrics.ObserveStore)
+	}
+
+	// The app credentials used by the verifications are cached in front of the store, and invalidated on every
+	// write, here and on the peers.
+	var invalidationBus *invalidation.Bus
+	var cachedStore *db.CachedStore
+	if cacheConfig := appsConfig.Cache; cacheConfig.Enabled {
+		cachedStore = db.NewCachedStore(store, cacheConfig.MaxEntries, time.Duration(cacheConfig.TTL),
+			time.Duration(cacheConfig.NegativeTTL))
+		cachedStore.ErrorHandler = func(err error) {
+			service.LogError("app cache", "err", err)
+		}
+		if appsConfig.Metrics.Enabled {
+			cachedStore.Observer = serviceMetrics.ObserveCache
+		}
+		if invalidationConfig := cacheConfig.Invalidation; invalidationConfig.PeersHost != "" {
+			peersPort := invalidationConfig.PeersPort
+			if peersPort == 0 {
+				peersPort = conf.Service.MicroservicePort
+			}
+			invalidationBus = invalidation.New(invalidation.DNSPeers(invalidationConfig.PeersHost, peersPort),
+				invalidationConfig.Path, invalidationConfig.Token,
+				&http.Client{Timeout: time.Duration(invalidationConfig.Timeout)})
+			invalidationBus.ErrorHandler = func(err error) {
+				service.LogError("app cache invalidation", "err", err)
+			}
+			invalidationBus.Start()
+			backgroundJobs = append(backgroundJobs, invalidationBus.Stop)
+			cachedStore.Publisher = invalidationBus
+		}
+		store = cachedStore
+	}
+
+	// The store operations of the traced requests are traced as children of the request span.
+	store = db.NewTracedStore(store)
+
+	tracer := tracing.NewTracer(newSpanExporter(&appsConfig.Tracing))
+	tracer.Sampler = tracing.RatioSampler(appsConfig.Tracing.SampleRatio)
+	tracer.ErrorHandler = func(err error) {
+		service.LogError("tracing", "err", err)
+	}
+	tracer.Start(time.Duration(appsConfig.Tracing.ExportInterval))
+	backgroundJobs = append(backgroundJobs, tracer.Stop)
 
 	// Mount middleware
 	service.Use(middleware.RequestID())
+	if appsConfig.Tracing.Exporter != TracingExporterNone {
+		service.Use(tracer.Middleware(appsConfig.Tracing.SkipPaths...))
+	}
+	if appsConfig.Metrics.Enabled {
+		service.Use(serviceMetrics.Middleware())
+	}
 	service.Use(middleware.LogRequest(true))
 	service.Use(middleware.ErrorHandler(service, true))
 	service.Use(middleware.Recover())
 
-	service.Use(chain.AsGoaMiddleware(securityChain))
+	checker := health.NewChecker(time.Duration(appsConfig.Health.CheckTimeout))
+	checker.Add("store", func(ctx context.Context) error {
+		return store.Ping(ctx)
+	})
+	if !conf.SecurityConfig.Disable {
+		checker.Add("keys", keyMaterialCheck(&conf.SecurityConfig))
+	}
+	checker.Add("gateway", registrar.Check)
+	service.Use(checker.Middleware(appsConfig.Health.LivenessPath, appsConfig.Health.ReadinessPath))
+	if invalidationBus != nil {
+		// The peers authenticate with the shared token instead of the security chain.
+		service.Use(invalidationBus.Middleware(cachedStore.Invalidate))
+	}
+
+	// The security chain is rebuilt when the ACL policies are reloaded.
+	securityMiddleware := reload.NewMiddleware(chain.AsGoaMiddleware(securityChain), securityCleanup)
+	service.Use(tracing.Measure("security", securityMiddleware.Handler()))
 
 	service.Use(healthcheck.NewCheckMiddleware("/healthcheck"))
 
+	if appsConfig.Metrics.Enabled {
+		service.Use(serviceMetrics.ExposeMiddleware(appsConfig.Metrics.Path))
+	}
+
 	service.Use(version.NewVersionMiddleware(conf.Version, "/version"))
 
 	// Mount "apps" controller
-	c := NewAppsController(service, store)
+	c := NewAppsController(service, store, appsConfig)
+	meter := usage.NewMeter(store, time.Duration(appsConfig.Usage.FlushInterval))
+	meter.ErrorHandler = func(err error) {
+		service.LogError("usage", "err", err)
+	}
+	meter.Start()
+	backgroundJobs = append(backgroundJobs, meter.Stop)
+	c.Usage = meter
+
+	appsReaper := reaper.New(store, appsConfig.Reaping.Policy())
+	appsReaper.Notifier = reaper.NotifierFunc(func(candidate *reaper.Candidate) error {
+		service.LogInfo("reaping", "action", candidate.Action, "app", candidate.AppID, "owner", candidate.Owner, "idleDays", candidate.IdleDays)
+		return nil
+	})
+	appsReaper.ErrorHandler = func(err error) {
+		service.LogError("reaping", "err", err)
+	}
+	appsReaper.ReportHandler = func(report *reaper.Report) {
+		for _, candidate := range report.Candidates {
+			service.LogInfo("reaping candidate", "dryRun", report.DryRun, "action", candidate.Action, "app", candidate.AppID, "owner", candidate.Owner, "status", candidate.Status, "idleDays", candidate.IdleDays)
+		}
+	}
+	if appsConfig.Reaping.Enabled {
+		appsReaper.Start(time.Duration(appsConfig.Reaping.Interval))
+		backgroundJobs = append(backgroundJobs, appsReaper.Stop)
+	}
+	c.Reaper = appsReaper
+
+	domainVerifier := verification.NewVerifier(net.DefaultResolver, &verification.HTTPFetcher{
+		Client: verification.NewHTTPClient(time.Duration(appsConfig.DomainVerification.Timeout)),
+	})
+	if appsConfig.DomainVerification.ReverifyEnabled {
+		reverifier := verification.NewReverifier(store, domainVerifier,
+			time.Duration(appsConfig.DomainVerification.ReverifyAfter),
+			appsConfig.DomainVerification.MaxFailures,
+			time.Duration(appsConfig.DomainVerification.Timeout))
+		reverifier.ErrorHandler = func(err error) {
+			service.LogError("domain verification", "err", err)
+		}
+		reverifier.Start(time.Duration(appsConfig.DomainVerification.ReverifyInterval))
+		backgroundJobs = append(backgroundJobs, reverifier.Stop)
+	}
+	c.DomainVerifier = domainVerifier
+
+	logoStore, err := blob.NewFileStore(appsConfig.Logos.StorageDir)
+	if err != nil {
+		log.Fatal("Failed to create the logo storage: ", err)
+	}
+	c.Logos = logoStore
+	c.Bulk = bulk.New(store, appsConfig.Bulk.MaxApps)
+	c.Idempotency = store.IdempotencyStore(time.Duration(appsConfig.Idempotency.TTL), time.Duration(appsConfig.Idempotency.Lease))
+	if appsConfig.Metrics.Enabled {
+		serviceMetrics.ErrorHandler = func(err error) {
+			service.LogError("metrics", "err", err)
+		}
+		serviceMetrics.StartCountingApps(store, time.Duration(appsConfig.Metrics.AppsInterval))
+		backgroundJobs = append(backgroundJobs, serviceMetrics.Stop)
+		c.Metrics = serviceMetrics
+	}
 	app.MountAppsController(service, c)
+
+	// Hot reload of the settings that can change live, on changes of the config file and on SIGHUP
+	watcher := reload.NewWatcher(settings.ConfigFile, func() error {
+		next, err := LoadSettings(os.Args[1:], os.Getenv, configFile)
+		if err != nil {
+			return err
+		}
+		reloaded, applied, restartRequired, err := settings.Reload(next)
+		if err != nil {
+			return err
+		}
+		for _, path := range restartRequired {
+			service.LogError("config reload: the setting cannot change without a restart, keeping the running value", "setting", path)
+		}
+		if len(applied) == 0 {
+			settings = reloaded
+			return nil
+		}
+		for _, path := range applied {
+			if strings.HasPrefix(path, "security.acl") {
+				securityChain, cleanup, err := flow.NewSecurityFromConfig(reloaded.Service)
+				if err != nil {
+					return err
+				}
+				securityMiddleware.Swap(chain.AsGoaMiddleware(securityChain), cleanup)
+				break
+			}
+		}
+		logLevel.Set(reloaded.Apps.Logging.Level)
+		c.SetConfig(reloaded.Apps)
+		settings = reloaded
+		service.LogInfo("config reload", "applied", strings.Join(applied, ","))
+		return nil
+	})
+	watcher.ErrorHandler = func(err error) {
+		service.LogError("config reload", "err", err)
+	}
+	watcher.Start(time.Duration(appsConfig.Reload.WatchInterval), syscall.SIGHUP)
+	backgroundJobs = append(backgroundJobs, watcher.Stop)
 	// Mount "swagger" controller
 	c2 := NewSwaggerController(service)
 	app.MountSwaggerController(service, c2)
 
 	// Start service
-	if err := service.ListenAndServe(fmt.Sprintf(":%d", conf.Service.MicroservicePort)); err != nil {
+	server := &http.Server{Addr: fmt.Sprintf(":%d", conf.Service.MicroservicePort), Handler: service.Mux}
+	service.Server = server
+	serverErrors := make(chan error, 1)
+	go func() {
+		service.LogInfo("listen", "transport", "http", "addr", server.Addr)
+		serverErrors <- server.ListenAndServe()
+	}()
+	registrar.Start()
+
+	select {
+	case err := <-serverErrors:
 		service.LogError("startup", "err", err)
+	case sig := <-shutdown.Signals(syscall.SIGTERM, os.Interrupt):
+		service.LogInfo("shutdown", "signal", sig.String())
 	}
 
+	// Stop routing traffic to the service before the connections are drained, and release the resources last.
+	stopJobs := []func(){}
+	for i := len(backgroundJobs) - 1; i >= 0; i-- {
+		stopJobs = append(stopJobs, backgroundJobs[i])
+	}
+	sequence := shutdown.New(goa.ContextLogger(service.Context))
+	sequence.Add("mark not ready", shutdown.Func(func() { checker.SetReady(false) }))
+	sequence.Add("deregister from gateway", func(ctx context.Context) error {
+		return registrar.Unregister()
+	})
+	sequence.Add("wait for load balancers", shutdown.Delay(time.Duration(appsConfig.Shutdown.Delay)))
+	sequence.Add("drain requests", shutdown.Drain(server, time.Duration(appsConfig.Shutdown.DrainTimeout)))
+	sequence.Add("stop background jobs", shutdown.Func(stopJobs...))
+	sequence.Add("close store", shutdown.Func(storeCleanup))
+	sequence.Add("close security chain", shutdown.Func(securityMiddleware.Close))
+	sequence.Run(context.Background())
+}
+
+// newLogger creates the logger of the service in the format selected in the config. The sensitive fields are redacted
+// from all messages, including the request headers and payloads logged by goa.
+func newLogger(loggingConfig *LoggingConfig, level *logging.Level) goa.LogAdapter {
+	var adapter goa.LogAdapter
+	if loggingConfig.Format == logging.FormatText {
+		adapter = goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	} else {
+		adapter = logging.NewJSONAdapter(os.Stderr)
+	}
+	redactor := logging.NewRedactor(loggingConfig.RedactFields...)
+	return logging.NewAdapter(logging.NewRedactingAdapter(adapter, redactor), level)
+}
+
+// newSpanExporter creates the exporter of the spans selected in the config, or nil if tracing is off.
+func newSpanExporter(tracingConfig *TracingConfig) tracing.Exporter {
+	switch tracingConfig.Exporter {
+	case TracingExporterStdout:
+		return tracing.NewWriterExporter(os.Stdout)
+	case TracingExporterOTLP:
+		return tracing.NewOTLPExporter(tracingConfig.Endpoint, tracingConfig.ServiceName,
+			&http.Client{Timeout: time.Duration(tracingConfig.Timeout)})
+	}
+	return nil
 }
 
 func loadGatewaySettings() (string, string) {
 	gatewayURL := os.Getenv("API_GATEWAY_URL")
 	serviceConfigFile := os.Getenv("SERVICE_CONFIG_FILE")
 
-	if gatewayURL == "" {
-		gatewayURL = "http://localhost:8001"
-	}
 	if serviceConfigFile == "" {
 		serviceConfigFile = "/run/secrets/microservice_apps_management_config.json"
 	}
@@ -90,15 +362,39 @@ func loadGatewaySettings() (string, string) {
 	return gatewayURL, serviceConfigFile
 }
 
-func registerMicroservice(gatewayAdminURL string, conf *config.ServiceConfig) func() {
-	registration := gateway.NewKongGateway(gatewayAdminURL, &http.Client{}, conf.Service)
-
-	err := registration.SelfRegister()
-	if err != nil {
-		panic(err)
+// newGateway returns the gateway to register the service on, or a gateway that does nothing when
+// no gateway URL is set.
+func newGateway(gatewayAdminURL string, conf *config.ServiceConfig) registration.Gateway {
+	if gatewayAdminURL == "" {
+		return registration.None{}
 	}
+	return gateway.NewKongGateway(gatewayAdminURL, &http.Client{Timeout: 10 * time.Second}, conf.Service)
+}
 
-	return func() {
-		registration.Unregister()
+// keyMaterialCheck returns a readiness check that the keys of the security chain can be read:
+// the keys directory must hold at least one key, and the SAML certificate and key must exist when SAML is configured.
+func keyMaterialCheck(securityConfig *config.SecurityConfig) health.CheckFunc {
+	return func(ctx context.Context) error {
+		files, err := ioutil.ReadDir(securityConfig.KeysDir)
+		if err != nil {
+			return err
+		}
+		keys := 0
+		for _, file := range files {
+			if file.Mode().IsRegular() && file.Size() > 0 {
+				keys++
+			}
+		}
+		if keys == 0 {
+			return fmt.Errorf("no keys in %s", securityConfig.KeysDir)
+		}
+		if securityConfig.SAMLConfig != nil {
+			for _, file := range []string{securityConfig.SAMLConfig.CertFile, securityConfig.SAMLConfig.KeyFile} {
+				if _, err := os.Stat(file); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
 	}
 }
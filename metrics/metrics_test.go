@@ -0,0 +1,157 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keitaroinc/goa"
+)
+
+type fakeCounter struct {
+	counts map[string]int
+	err    error
+}
+
+func (f *fakeCounter) CountAppsByStatus(ctx context.Context) (map[string]int, error) {
+	return f.counts, f.err
+}
+
+func output(t *testing.T, r *Registry) string {
+	buf := &bytes.Buffer{}
+	if err := r.Write(buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func expectLines(t *testing.T, out string, lines ...string) {
+	for _, line := range lines {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("Expected %q in the output:\n%s", line, out)
+		}
+	}
+}
+
+func TestRegistryWrite(t *testing.T) {
+	r := NewRegistry()
+	counter := r.NewCounterVec("requests_total", "Number of requests.", "code")
+	gauge := r.NewGaugeVec("apps", "Number of apps.", "status")
+	histogram := r.NewHistogramVec("duration_seconds", "Duration.", []float64{1, 0.1}, "method")
+
+	counter.Inc("200")
+	counter.Add(2, "200")
+	counter.Inc("404")
+	gauge.Set(3, "active")
+	gauge.Set(1, "active")
+	gauge.Set(2, `quo"te`)
+	histogram.Observe(0.05, "GetApp")
+	histogram.Observe(0.5, "GetApp")
+	histogram.Observe(5, "GetApp")
+
+	expectLines(t, output(t, r),
+		"# HELP requests_total Number of requests.",
+		"# TYPE requests_total counter",
+		`requests_total{code="200"} 3`,
+		`requests_total{code="404"} 1`,
+		"# TYPE apps gauge",
+		`apps{status="active"} 1`,
+		`apps{status="quo\"te"} 2`,
+		"# TYPE duration_seconds histogram",
+		`duration_seconds_bucket{method="GetApp",le="0.1"} 1`,
+		`duration_seconds_bucket{method="GetApp",le="1"} 2`,
+		`duration_seconds_bucket{method="GetApp",le="+Inf"} 3`,
+		`duration_seconds_sum{method="GetApp"} 5.55`,
+		`duration_seconds_count{method="GetApp"} 3`,
+	)
+}
+
+func TestMiddleware(t *testing.T) {
+	m := New()
+	handler := func(status int) goa.Handler {
+		return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+			rw.WriteHeader(status)
+			return nil
+		}
+	}
+	for _, status := range []int{http.StatusOK, http.StatusOK, http.StatusNotFound} {
+		req := httptest.NewRequest("GET", "/apps/1", nil)
+		rw := httptest.NewRecorder()
+		ctx := goa.WithAction(goa.NewContext(context.Background(), rw, req, nil), "getApp")
+		if err := m.Middleware()(handler(status))(ctx, goa.ContextResponse(ctx), req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expectLines(t, output(t, m.Registry),
+		`apps_management_http_requests_total{action="getApp",code="200"} 2`,
+		`apps_management_http_requests_total{action="getApp",code="404"} 1`,
+		`apps_management_http_request_duration_seconds_count{action="getApp",code="200"} 2`,
+	)
+}
+
+func TestExposeMiddleware(t *testing.T) {
+	m := New()
+	m.RecordVerification(true)
+	m.RecordVerification(false)
+	m.RecordVerification(true)
+	m.ObserveStore("GetApp", 10*time.Millisecond, false)
+	m.ObserveStore("GetApp", 20*time.Millisecond, true)
+
+	next := func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		return fmt.Errorf("not the metrics path")
+	}
+	rw := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	if err := m.ExposeMiddleware("/metrics")(next)(context.Background(), rw, req); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(rw.Header().Get("Content-Type"), "text/plain") {
+		t.Errorf("Expected the text format, got %s", rw.Header().Get("Content-Type"))
+	}
+	expectLines(t, rw.Body.String(),
+		`apps_management_app_verifications_total{result="failure"} 1`,
+		`apps_management_app_verifications_total{result="success"} 2`,
+		`apps_management_store_operation_duration_seconds_count{method="GetApp"} 2`,
+		`apps_management_store_operation_errors_total{method="GetApp"} 1`,
+	)
+
+	req = httptest.NewRequest("GET", "/apps", nil)
+	if err := m.ExposeMiddleware("/metrics")(next)(context.Background(), httptest.NewRecorder(), req); err == nil {
+		t.Fatal("Expected the other paths to be passed to the next handler")
+	}
+}
+
+func TestCountApps(t *testing.T) {
+	m := New()
+	if err := m.CountApps(context.Background(), &fakeCounter{counts: map[string]int{"active": 4, "deleted": 0}}); err != nil {
+		t.Fatal(err)
+	}
+	expectLines(t, output(t, m.Registry),
+		`apps_management_apps{status="active"} 4`,
+		`apps_management_apps{status="deleted"} 0`,
+	)
+
+	if err := m.CountApps(context.Background(), &fakeCounter{err: fmt.Errorf("db down")}); err == nil {
+		t.Fatal("Expected the error of the counter")
+	}
+}
+
+func TestObserveCache(t *testing.T) {
+	m := New()
+	m.ObserveCache("miss", 1)
+	m.ObserveCache("hit", 1)
+	m.ObserveCache("hit", 1)
+	m.ObserveCache("invalidation", 0)
+	expectLines(t, output(t, m.Registry),
+		`apps_management_app_cache_lookups_total{result="hit"} 2`,
+		`apps_management_app_cache_lookups_total{result="miss"} 1`,
+		`apps_management_app_cache_invalidations_total 1`,
+		`apps_management_app_cache_entries 0`,
+	)
+}
@@ -0,0 +1,265 @@
+// Package metrics collects the metrics of the service and exposes them in the Prometheus text format.
+// It implements only the counters, gauges and histograms the service needs.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets are the upper bounds of the histogram buckets for latencies in seconds.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// collector is a metric family that writes itself in the text format.
+type collector interface {
+	write(w *bufio.Writer)
+}
+
+// Registry holds the metrics and writes them in the Prometheus text format.
+type Registry struct {
+	mutex      sync.Mutex
+	collectors []collector
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+func (r *Registry) register(c collector) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.collectors = append(r.collectors, c)
+}
+
+// Write writes all metrics to w in the Prometheus text format.
+func (r *Registry) Write(w io.Writer) error {
+	r.mutex.Lock()
+	collectors := append([]collector{}, r.collectors...)
+	r.mutex.Unlock()
+
+	buf := bufio.NewWriter(w)
+	for _, c := range collectors {
+		c.write(buf)
+	}
+	return buf.Flush()
+}
+
+// ServeHTTP writes the metrics as the response.
+func (r *Registry) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	r.Write(rw)
+}
+
+// family holds the children of a metric, one for every combination of the label values.
+type family struct {
+	name   string
+	help   string
+	kind   string
+	labels []string
+
+	mutex    sync.Mutex
+	children map[string]*child
+}
+
+// child is a metric with a single combination of label values.
+type child struct {
+	values  []string
+	value   float64
+	counts  []uint64
+	sum     float64
+	samples uint64
+}
+
+func newFamily(name, help, kind string, labels []string) *family {
+	return &family{
+		name:     name,
+		help:     help,
+		kind:     kind,
+		labels:   labels,
+		children: map[string]*child{},
+	}
+}
+
+// child returns the child for the label values. Must be called with the mutex locked.
+func (f *family) child(values []string) *child {
+	if len(values) != len(f.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", f.name, len(f.labels), len(values)))
+	}
+	key := strings.Join(values, "\xff")
+	c, ok := f.children[key]
+	if !ok {
+		c = &child{values: append([]string{}, values...)}
+		f.children[key] = c
+	}
+	return c
+}
+
+// sortedChildren returns the children ordered by their label values. Must be called with the mutex locked.
+func (f *family) sortedChildren() []*child {
+	keys := []string{}
+	for key := range f.children {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	children := []*child{}
+	for _, key := range keys {
+		children = append(children, f.children[key])
+	}
+	return children
+}
+
+func (f *family) writeHeader(w *bufio.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n", f.name, escape(f.help, false))
+	fmt.Fprintf(w, "# TYPE %s %s\n", f.name, f.kind)
+}
+
+// labelPairs formats the label values, with the extra label appended if not empty.
+func (f *family) labelPairs(values []string, extraName, extraValue string) string {
+	pairs := []string{}
+	for i, name := range f.labels {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", name, escape(values[i], true)))
+	}
+	if extraName != "" {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", extraName, extraValue))
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// CounterVec is a counter with labels. A counter only increases.
+type CounterVec struct {
+	*family
+}
+
+// NewCounterVec registers a new counter with the given label names.
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{newFamily(name, help, "counter", labels)}
+	r.register(c)
+	return c
+}
+
+// Inc increases the counter with the label values by 1.
+func (c *CounterVec) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// Add increases the counter with the label values by delta, which must not be negative.
+func (c *CounterVec) Add(delta float64, values ...string) {
+	if delta < 0 {
+		panic("metrics: a counter cannot decrease")
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.child(values).value += delta
+}
+
+func (c *CounterVec) write(w *bufio.Writer) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.writeHeader(w)
+	for _, ch := range c.sortedChildren() {
+		fmt.Fprintf(w, "%s%s %s\n", c.name, c.labelPairs(ch.values, "", ""), formatFloat(ch.value))
+	}
+}
+
+// GaugeVec is a gauge with labels. A gauge is set to the current value.
+type GaugeVec struct {
+	*family
+}
+
+// NewGaugeVec registers a new gauge with the given label names.
+func (r *Registry) NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	g := &GaugeVec{newFamily(name, help, "gauge", labels)}
+	r.register(g)
+	return g
+}
+
+// Set sets the gauge with the label values.
+func (g *GaugeVec) Set(value float64, values ...string) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.child(values).value = value
+}
+
+func (g *GaugeVec) write(w *bufio.Writer) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.writeHeader(w)
+	for _, ch := range g.sortedChildren() {
+		fmt.Fprintf(w, "%s%s %s\n", g.name, g.labelPairs(ch.values, "", ""), formatFloat(ch.value))
+	}
+}
+
+// HistogramVec is a histogram with labels, which counts the observed values in buckets.
+type HistogramVec struct {
+	*family
+	buckets []float64
+}
+
+// NewHistogramVec registers a new histogram with the given bucket upper bounds and label names.
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	sorted := append([]float64{}, buckets...)
+	sort.Float64s(sorted)
+	h := &HistogramVec{family: newFamily(name, help, "histogram", labels), buckets: sorted}
+	r.register(h)
+	return h
+}
+
+// Observe adds a value to the histogram with the label values.
+func (h *HistogramVec) Observe(value float64, values ...string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	ch := h.child(values)
+	if ch.counts == nil {
+		ch.counts = make([]uint64, len(h.buckets))
+	}
+	for i, bound := range h.buckets {
+		if value <= bound {
+			ch.counts[i]++
+		}
+	}
+	ch.sum += value
+	ch.samples++
+}
+
+func (h *HistogramVec) write(w *bufio.Writer) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.writeHeader(w)
+	for _, ch := range h.sortedChildren() {
+		for i, bound := range h.buckets {
+			fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, h.labelPairs(ch.values, "le", formatFloat(bound)), ch.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, h.labelPairs(ch.values, "le", "+Inf"), ch.samples)
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.name, h.labelPairs(ch.values, "", ""), formatFloat(ch.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.name, h.labelPairs(ch.values, "", ""), ch.samples)
+	}
+}
+
+func formatFloat(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// escape escapes the backslashes and the line feeds, and the double quotes in label values.
+func escape(value string, quotes bool) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, "\n", `\n`, -1)
+	if quotes {
+		value = strings.Replace(value, `"`, `\"`, -1)
+	}
+	return value
+}
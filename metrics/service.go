@@ -0,0 +1,179 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/keitaroinc/goa"
+)
+
+// AppCounter counts the apps by their lifecycle status. Implemented by db.AppsManagementStore.
+type AppCounter interface {
+	CountAppsByStatus(ctx context.Context) (map[string]int, error)
+}
+
+// Metrics holds the metrics of the apps-management service.
+type Metrics struct {
+	// Registry holds all metrics of the service.
+	Registry *Registry
+	// ErrorHandler is called with the errors that happen while counting the apps in the background.
+	ErrorHandler func(err error)
+
+	requests           *CounterVec
+	requestDuration    *HistogramVec
+	storeDuration      *HistogramVec
+	storeErrors        *CounterVec
+	verifications      *CounterVec
+	apps               *GaugeVec
+	cacheLookups       *CounterVec
+	cacheInvalidations *CounterVec
+	cacheEntries       *GaugeVec
+
+	stop   chan struct{}
+	done   chan struct{}
+	cancel context.CancelFunc
+}
+
+// New creates the metrics of the service in a new registry.
+func New() *Metrics {
+	r := NewRegistry()
+	return &Metrics{
+		Registry: r,
+		requests: r.NewCounterVec("apps_management_http_requests_total",
+			"Number of handled HTTP requests by action and status code.", "action", "code"),
+		requestDuration: r.NewHistogramVec("apps_management_http_request_duration_seconds",
+			"Latency of the HTTP requests by action and status code.", DefaultBuckets, "action", "code"),
+		storeDuration: r.NewHistogramVec("apps_management_store_operation_duration_seconds",
+			"Latency of the store operations by method.", DefaultBuckets, "method"),
+		storeErrors: r.NewCounterVec("apps_management_store_operation_errors_total",
+			"Number of failed store operations by method.", "method"),
+		verifications: r.NewCounterVec("apps_management_app_verifications_total",
+			"Number of app credential verifications by result.", "result"),
+		apps: r.NewGaugeVec("apps_management_apps",
+			"Number of apps by lifecycle status.", "status"),
+		cacheLookups: r.NewCounterVec("apps_management_app_cache_lookups_total",
+			"Number of lookups in the app cache by result: hit, negative_hit or miss.", "result"),
+		cacheInvalidations: r.NewCounterVec("apps_management_app_cache_invalidations_total",
+			"Number of apps invalidated in the app cache."),
+		cacheEntries: r.NewGaugeVec("apps_management_app_cache_entries",
+			"Number of entries in the app cache."),
+	}
+}
+
+// Middleware records the number and the latency of the requests handled by the goa actions. It must be
+// mounted before the error handler, so that it sees the status code of the error responses.
+func (m *Metrics) Middleware() goa.Middleware {
+	return func(h goa.Handler) goa.Handler {
+		return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+			start := time.Now()
+			err := h(ctx, rw, req)
+
+			action := goa.ContextAction(ctx)
+			status := http.StatusOK
+			if resp := goa.ContextResponse(ctx); resp != nil && resp.Status != 0 {
+				status = resp.Status
+			} else if err != nil {
+				status = http.StatusInternalServerError
+			}
+			code := strconv.Itoa(status)
+
+			m.requests.Inc(action, code)
+			m.requestDuration.Observe(time.Since(start).Seconds(), action, code)
+			return err
+		}
+	}
+}
+
+// ExposeMiddleware serves the metrics on GET requests to the path.
+func (m *Metrics) ExposeMiddleware(path string) goa.Middleware {
+	return func(h goa.Handler) goa.Handler {
+		return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+			if req.Method != http.MethodGet || req.URL.Path != path {
+				return h(ctx, rw, req)
+			}
+			m.Registry.ServeHTTP(rw, req)
+			return nil
+		}
+	}
+}
+
+// ObserveStore records the latency of a store operation and whether it failed.
+func (m *Metrics) ObserveStore(method string, duration time.Duration, failed bool) {
+	m.storeDuration.Observe(duration.Seconds(), method)
+	if failed {
+		m.storeErrors.Inc(method)
+	}
+}
+
+// ObserveCache records an event of the app cache: a lookup by its result, or an invalidation.
+func (m *Metrics) ObserveCache(event string, entries int) {
+	if event == "invalidation" {
+		m.cacheInvalidations.Inc()
+	} else {
+		m.cacheLookups.Inc(event)
+	}
+	m.cacheEntries.Set(float64(entries))
+}
+
+// RecordVerification counts a verification of app credentials.
+func (m *Metrics) RecordVerification(success bool) {
+	result := "failure"
+	if success {
+		result = "success"
+	}
+	m.verifications.Inc(result)
+}
+
+// CountApps sets the gauge of the apps by status from the counter.
+func (m *Metrics) CountApps(ctx context.Context, counter AppCounter) error {
+	counts, err := counter.CountAppsByStatus(ctx)
+	if err != nil {
+		return err
+	}
+	for status, count := range counts {
+		m.apps.Set(float64(count), status)
+	}
+	return nil
+}
+
+// StartCountingApps counts the apps now and then in the background on every interval.
+func (m *Metrics) StartCountingApps(counter AppCounter, interval time.Duration) {
+	m.stop = make(chan struct{})
+	m.done = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	go func() {
+		defer close(m.done)
+		m.countInBackground(ctx, counter)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.countInBackground(ctx, counter)
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops counting the apps in the background, canceling a count in progress.
+func (m *Metrics) Stop() {
+	if m.stop == nil {
+		return
+	}
+	m.cancel()
+	close(m.stop)
+	<-m.done
+	m.stop = nil
+}
+
+func (m *Metrics) countInBackground(ctx context.Context, counter AppCounter) {
+	if err := m.CountApps(ctx, counter); err != nil && m.ErrorHandler != nil {
+		m.ErrorHandler(err)
+	}
+}
@@ -0,0 +1,152 @@
+// Package origins validates and matches the origins an app is allowed to make requests from.
+// An origin is a scheme, a host and an optional port, like https://app.example.com:8443. The host
+// may start with a "*." wildcard that matches any subdomain, like https://*.example.com.
+package origins
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// wildcardPrefix marks a host pattern that matches any subdomain.
+const wildcardPrefix = "*."
+
+// defaultPorts are the ports implied by the scheme when the origin has no explicit port.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+// Normalize validates the origin and returns it in canonical form: lower-case scheme and host,
+// without the default port for the scheme.
+func Normalize(origin string) (string, error) {
+	scheme, host, port, err := parse(origin)
+	if err != nil {
+		return "", err
+	}
+	return format(scheme, host, port), nil
+}
+
+// Validate checks that the origin is a valid origin or origin pattern.
+func Validate(origin string) error {
+	_, err := Normalize(origin)
+	return err
+}
+
+// NormalizeAll validates and normalizes the origins and drops the duplicates.
+func NormalizeAll(origins []string) ([]string, error) {
+	normalized := []string{}
+	seen := map[string]bool{}
+	for _, origin := range origins {
+		o, err := Normalize(origin)
+		if err != nil {
+			return nil, err
+		}
+		if seen[o] {
+			continue
+		}
+		seen[o] = true
+		normalized = append(normalized, o)
+	}
+	return normalized, nil
+}
+
+// Matches checks if the origin matches the pattern. A wildcard pattern matches the subdomains
+// of its host, but not the host itself.
+func Matches(pattern, origin string) bool {
+	pScheme, pHost, pPort, err := parse(pattern)
+	if err != nil {
+		return false
+	}
+	scheme, host, port, err := parse(origin)
+	if err != nil || strings.HasPrefix(host, wildcardPrefix) {
+		return false
+	}
+	if pScheme != scheme || pPort != port {
+		return false
+	}
+	if strings.HasPrefix(pHost, wildcardPrefix) {
+		return strings.HasSuffix(host, pHost[1:])
+	}
+	return pHost == host
+}
+
+// Allowed checks if the origin matches any of the patterns.
+func Allowed(patterns []string, origin string) bool {
+	for _, pattern := range patterns {
+		if Matches(pattern, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// parse splits the origin into lower-case scheme, host and port. The port is empty when it is the
+// default port for the scheme.
+func parse(origin string) (scheme, host, port string, err error) {
+	u, err := url.Parse(strings.TrimSpace(origin))
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid origin %q: %s", origin, err)
+	}
+
+	scheme = strings.ToLower(u.Scheme)
+	if _, ok := defaultPorts[scheme]; !ok {
+		return "", "", "", fmt.Errorf("invalid origin %q: scheme must be http or https", origin)
+	}
+	if u.User != nil || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return "", "", "", fmt.Errorf("invalid origin %q: must contain only a scheme, a host and a port", origin)
+	}
+
+	host = strings.ToLower(u.Hostname())
+	port = u.Port()
+	if port == defaultPorts[scheme] {
+		port = ""
+	}
+
+	name := strings.TrimPrefix(host, wildcardPrefix)
+	if err := validateHost(name); err != nil {
+		return "", "", "", fmt.Errorf("invalid origin %q: %s", origin, err)
+	}
+	if name != host && net.ParseIP(name) != nil {
+		return "", "", "", fmt.Errorf("invalid origin %q: wildcard is not allowed for an IP address", origin)
+	}
+
+	return scheme, host, port, nil
+}
+
+// validateHost checks that the host is an IP address or a valid DNS name.
+func validateHost(host string) error {
+	if host == "" {
+		return fmt.Errorf("missing host")
+	}
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+	for _, label := range strings.Split(host, ".") {
+		if label == "" || len(label) > 63 {
+			return fmt.Errorf("invalid host name")
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-') {
+				return fmt.Errorf("invalid host name")
+			}
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("invalid host name")
+		}
+	}
+	return nil
+}
+
+// format builds the origin from its parts.
+func format(scheme, host, port string) string {
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port != "" {
+		return scheme + "://" + host + ":" + port
+	}
+	return scheme + "://" + host
+}
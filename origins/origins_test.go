@@ -0,0 +1,96 @@
+package origins
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := map[string]string{
+		"https://app.example.com":        "https://app.example.com",
+		"HTTPS://App.Example.COM/":       "https://app.example.com",
+		"https://app.example.com:443":    "https://app.example.com",
+		"http://localhost:8080":          "http://localhost:8080",
+		"https://*.example.com":          "https://*.example.com",
+		"http://127.0.0.1:3000":          "http://127.0.0.1:3000",
+		"  https://app.example.com:8443": "https://app.example.com:8443",
+	}
+
+	for origin, expected := range cases {
+		normalized, err := Normalize(origin)
+		if err != nil {
+			t.Errorf("Normalize(%q): %s", origin, err)
+			continue
+		}
+		if normalized != expected {
+			t.Errorf("Normalize(%q): expected %q, got %q", origin, expected, normalized)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := []string{
+		"",
+		"app.example.com",
+		"ftp://app.example.com",
+		"https://app.example.com/path",
+		"https://app.example.com?query",
+		"https://user@app.example.com",
+		"https://*",
+		"https://app.*.example.com",
+		"https://-app.example.com",
+		"https://*.127.0.0.1",
+	}
+
+	for _, origin := range invalid {
+		if err := Validate(origin); err == nil {
+			t.Errorf("Expected %q to be invalid", origin)
+		}
+	}
+}
+
+func TestNormalizeAll(t *testing.T) {
+	normalized, err := NormalizeAll([]string{"https://a.example.com", "https://A.example.com:443", "http://a.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(normalized) != 2 || normalized[0] != "https://a.example.com" || normalized[1] != "http://a.example.com" {
+		t.Fatalf("Invalid normalized origins: %v", normalized)
+	}
+
+	if _, err := NormalizeAll([]string{"https://a.example.com", "not an origin"}); err == nil {
+		t.Fatal("Expected error for invalid origin")
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	patterns := []string{"https://app.example.com", "https://*.example.org", "http://localhost:3000"}
+
+	allowed := []string{
+		"https://app.example.com",
+		"https://APP.example.com:443",
+		"https://a.example.org",
+		"https://a.b.example.org",
+		"http://localhost:3000",
+	}
+	for _, origin := range allowed {
+		if !Allowed(patterns, origin) {
+			t.Errorf("Expected %q to be allowed", origin)
+		}
+	}
+
+	denied := []string{
+		"http://app.example.com",
+		"https://app.example.com:8443",
+		"https://other.example.com",
+		"https://example.org",
+		"https://badexample.org",
+		"https://*.example.org",
+		"http://localhost",
+		"null",
+	}
+	for _, origin := range denied {
+		if Allowed(patterns, origin) {
+			t.Errorf("Expected %q to be denied", origin)
+		}
+	}
+}
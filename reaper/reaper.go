@@ -0,0 +1,325 @@
+// Package reaper implements the background job that finds the unused apps and, according to
+// the configured policy, flags them, suspends them and finally soft-deletes them.
+//
+// Every replica of the service can run the reaper. The status of an app is only changed if it is
+// still the status that the run has read, so that of concurrent runs only one changes an app and
+// notifies its owner, and an app moves at most one stage forward at a time.
+package reaper
+
+import (
+	"context"
+	"time"
+
+	"github.com/Microkubes/microservice-apps-management/app"
+	"github.com/Microkubes/microservice-apps-management/db"
+)
+
+// Actions that the reaper takes on an unused app.
+const (
+	// ActionNone means the app stays in its current status.
+	ActionNone = "none"
+	// ActionFlag flags the app and notifies its owner.
+	ActionFlag = "flag"
+	// ActionSuspend suspends the app, so it can no longer be verified.
+	ActionSuspend = "suspend"
+	// ActionDelete soft-deletes the app.
+	ActionDelete = "delete"
+)
+
+// Store gives access to the apps. Implemented by db.AppsManagementStore.
+type Store interface {
+	GetAppsUnusedSince(ctx context.Context, since time.Time) ([]*db.ClientApp, error)
+	TransitionAppStatus(ctx context.Context, appID string, from *db.StatusVersion, status string) error
+}
+
+// Notifier notifies the owner of an app about a reaping action taken on the app.
+type Notifier interface {
+	Notify(candidate *Candidate) error
+}
+
+// NotifierFunc is an adapter to use an ordinary function as a Notifier.
+type NotifierFunc func(candidate *Candidate) error
+
+// Notify calls f(candidate).
+func (f NotifierFunc) Notify(candidate *Candidate) error {
+	return f(candidate)
+}
+
+// Policy defines after how many days without activity an app is flagged, suspended and
+// deleted. A value of 0 disables that stage. An app also stays in every stage for at least
+// the difference of days to the next stage, even if it has been idle for longer.
+type Policy struct {
+	FlagAfterDays    int
+	SuspendAfterDays int
+	DeleteAfterDays  int
+	// DryRun only produces the report and does not change the apps.
+	DryRun bool
+}
+
+// Candidate is an unused app and the action the reaper takes on it.
+type Candidate struct {
+	AppID          string
+	Name           string
+	Owner          string
+	Status         string
+	LastActivityAt int64
+	IdleDays       int
+	Action         string
+
+	// statusVersion is the status of the app as it has been read.
+	statusVersion *db.StatusVersion
+}
+
+// ToMedia converts the candidate to its media type representation.
+func (c *Candidate) ToMedia() *app.ReapingCandidate {
+	return &app.ReapingCandidate{
+		AppID:          c.AppID,
+		Name:           c.Name,
+		Owner:          c.Owner,
+		Status:         c.Status,
+		LastActivityAt: int(c.LastActivityAt),
+		IdleDays:       c.IdleDays,
+		Action:         c.Action,
+	}
+}
+
+// Report holds the candidates found by a single run of the reaper.
+type Report struct {
+	GeneratedAt int64
+	DryRun      bool
+	Candidates  []*Candidate
+}
+
+// ToMedia converts the report to its media type representation.
+func (r *Report) ToMedia() *app.ReapingReport {
+	candidates := []*app.ReapingCandidate{}
+	for _, candidate := range r.Candidates {
+		candidates = append(candidates, candidate.ToMedia())
+	}
+	return &app.ReapingReport{
+		GeneratedAt: int(r.GeneratedAt),
+		DryRun:      r.DryRun,
+		Candidates:  candidates,
+	}
+}
+
+// stage is a step of the reaping policy.
+type stage struct {
+	status string
+	days   int
+	action string
+}
+
+// Reaper finds the unused apps and applies the reaping policy to them.
+type Reaper struct {
+	// Notifier is notified for every app that has been flagged, suspended or deleted.
+	Notifier Notifier
+	// ErrorHandler is called with the errors that happen while running in the background.
+	ErrorHandler func(err error)
+	// ReportHandler is called with the report of every background run.
+	ReportHandler func(report *Report)
+
+	store  Store
+	policy Policy
+	now    func() time.Time
+
+	stop   chan struct{}
+	done   chan struct{}
+	cancel context.CancelFunc
+}
+
+// New creates a new Reaper for the apps in the store, with the given policy.
+func New(store Store, policy Policy) *Reaper {
+	return &Reaper{
+		store:  store,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// Plan returns the report of what the next run would do, without changing any app.
+func (r *Reaper) Plan(ctx context.Context) (*Report, error) {
+	now := r.now()
+	report := &Report{
+		GeneratedAt: now.Unix(),
+		DryRun:      r.policy.DryRun,
+		Candidates:  []*Candidate{},
+	}
+
+	minDays := 0
+	for _, s := range r.stages() {
+		if s.days > 0 && (minDays == 0 || s.days < minDays) {
+			minDays = s.days
+		}
+	}
+	if minDays == 0 {
+		// all stages are disabled
+		return report, nil
+	}
+
+	clientApps, err := r.store.GetAppsUnusedSince(ctx, now.AddDate(0, 0, -minDays))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, clientApp := range clientApps {
+		if clientApp.ReapingExempt {
+			continue
+		}
+		lastActivity := clientApp.LastActivity()
+		idleDays := days(now, lastActivity)
+		statusDays := idleDays
+		if clientApp.StatusChangedAt > 0 {
+			statusDays = days(now, clientApp.StatusChangedAt)
+		}
+		report.Candidates = append(report.Candidates, &Candidate{
+			AppID:          clientApp.ID,
+			Name:           clientApp.Name,
+			Owner:          clientApp.Owner,
+			Status:         clientApp.CurrentStatus(),
+			LastActivityAt: lastActivity,
+			IdleDays:       idleDays,
+			Action:         r.nextAction(clientApp.CurrentStatus(), idleDays, statusDays),
+			statusVersion:  clientApp.CurrentStatusVersion(),
+		})
+	}
+
+	return report, nil
+}
+
+// Run applies the reaping policy to the unused apps and returns the report. In dry-run mode
+// the apps are not changed. An app moves at most one stage forward per run, so an app is
+// always flagged before it is suspended and suspended before it is deleted. The apps whose
+// status has changed since they were read are skipped, without notifying their owners.
+// Returns the first error that occurred, after processing all candidates.
+func (r *Reaper) Run(ctx context.Context) (*Report, error) {
+	report, err := r.Plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.policy.DryRun {
+		return report, nil
+	}
+
+	var firstErr error
+	for _, candidate := range report.Candidates {
+		status := statusForAction(candidate.Action)
+		if status == "" {
+			continue
+		}
+		if err := r.store.TransitionAppStatus(ctx, candidate.AppID, candidate.statusVersion, status); err != nil {
+			if db.IsErrStatusConflict(err) {
+				// used again, or changed by another run in the meantime
+				continue
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if r.Notifier != nil {
+			if err := r.Notifier.Notify(candidate); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return report, firstErr
+}
+
+// Start runs the reaper in the background on every interval.
+func (r *Reaper) Start(interval time.Duration) {
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.runInBackground(ctx)
+			case <-r.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background runs, canceling a run in progress.
+func (r *Reaper) Stop() {
+	if r.stop == nil {
+		return
+	}
+	r.cancel()
+	close(r.stop)
+	<-r.done
+	r.stop = nil
+}
+
+func (r *Reaper) runInBackground(ctx context.Context) {
+	report, err := r.Run(ctx)
+	if err != nil && r.ErrorHandler != nil {
+		r.ErrorHandler(err)
+	}
+	if report != nil && r.ReportHandler != nil {
+		r.ReportHandler(report)
+	}
+}
+
+func (r *Reaper) stages() []stage {
+	return []stage{
+		{status: db.StatusFlagged, days: r.policy.FlagAfterDays, action: ActionFlag},
+		{status: db.StatusSuspended, days: r.policy.SuspendAfterDays, action: ActionSuspend},
+		{status: db.StatusDeleted, days: r.policy.DeleteAfterDays, action: ActionDelete},
+	}
+}
+
+// nextAction returns the action for an app in the given status, idle for the given number of days
+// and in the status for the given number of days. Only the next enabled stage after the current
+// status is considered. An app that is not active moves to the next stage only after it has been in
+// its status for the days between the two stages.
+func (r *Reaper) nextAction(status string, idleDays, statusDays int) string {
+	stages := r.stages()
+
+	current := -1
+	currentDays := 0
+	for i, s := range stages {
+		if s.status == status {
+			current = i
+			currentDays = s.days
+		}
+	}
+
+	for _, s := range stages[current+1:] {
+		if s.days <= 0 {
+			continue
+		}
+		if idleDays >= s.days && (current < 0 || statusDays >= s.days-currentDays) {
+			return s.action
+		}
+		return ActionNone
+	}
+
+	return ActionNone
+}
+
+// days returns the number of whole days from the time (Unix seconds) until now.
+func days(now time.Time, since int64) int {
+	return int(now.Unix()-since) / (24 * 60 * 60)
+}
+
+func statusForAction(action string) string {
+	switch action {
+	case ActionFlag:
+		return db.StatusFlagged
+	case ActionSuspend:
+		return db.StatusSuspended
+	case ActionDelete:
+		return db.StatusDeleted
+	}
+	return ""
+}
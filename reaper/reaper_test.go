@@ -0,0 +1,166 @@
+package reaper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Microkubes/microservice-apps-management/db"
+)
+
+var now = time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+type storeMock struct {
+	apps      []*db.ClientApp
+	changed   map[string]string
+	conflicts map[string]bool
+}
+
+func (s *storeMock) GetAppsUnusedSince(ctx context.Context, since time.Time) ([]*db.ClientApp, error) {
+	unused := []*db.ClientApp{}
+	for _, clientApp := range s.apps {
+		if clientApp.CurrentStatus() != db.StatusDeleted && clientApp.LastActivity() < since.Unix() {
+			unused = append(unused, clientApp)
+		}
+	}
+	return unused, nil
+}
+
+func (s *storeMock) TransitionAppStatus(ctx context.Context, appID string, from *db.StatusVersion, status string) error {
+	if s.conflicts[appID] {
+		return db.ErrStatusConflict("the status of the app has changed in the meantime")
+	}
+	s.changed[appID] = status
+	return nil
+}
+
+func daysAgo(days int) int64 {
+	return now.AddDate(0, 0, -days).Unix()
+}
+
+func newStore() *storeMock {
+	return &storeMock{
+		apps: []*db.ClientApp{
+			{ID: "in-use", RegisteredAt: daysAgo(400), LastUsedAt: daysAgo(1)},
+			{ID: "idle", RegisteredAt: daysAgo(40)},
+			{ID: "flagged", RegisteredAt: daysAgo(70), Status: db.StatusFlagged, StatusChangedAt: daysAgo(35)},
+			{ID: "flagged-recently", RegisteredAt: daysAgo(40), Status: db.StatusFlagged, StatusChangedAt: daysAgo(10)},
+			{ID: "flagged-late", RegisteredAt: daysAgo(80), Status: db.StatusFlagged, StatusChangedAt: daysAgo(5)},
+			{ID: "suspended", RegisteredAt: daysAgo(100), Status: db.StatusSuspended},
+			{ID: "very-old", RegisteredAt: daysAgo(400)},
+			{ID: "exempt", RegisteredAt: daysAgo(400), ReapingExempt: true},
+		},
+		changed:   map[string]string{},
+		conflicts: map[string]bool{},
+	}
+}
+
+func newReaper(store Store, dryRun bool) *Reaper {
+	r := New(store, Policy{FlagAfterDays: 30, SuspendAfterDays: 60, DeleteAfterDays: 90, DryRun: dryRun})
+	r.now = func() time.Time { return now }
+	return r
+}
+
+func TestRun(t *testing.T) {
+	store := newStore()
+	notified := map[string]string{}
+	r := newReaper(store, false)
+	r.Notifier = NotifierFunc(func(candidate *Candidate) error {
+		notified[candidate.AppID] = candidate.Action
+		return nil
+	})
+
+	report, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Candidates) != 6 {
+		t.Fatalf("Expected 6 candidates, got %d", len(report.Candidates))
+	}
+
+	expected := map[string]string{
+		"idle":      db.StatusFlagged,
+		"flagged":   db.StatusSuspended,
+		"suspended": db.StatusDeleted,
+		"very-old":  db.StatusFlagged,
+	}
+	if len(store.changed) != len(expected) {
+		t.Fatalf("Expected %d status changes, got %v", len(expected), store.changed)
+	}
+	for appID, status := range expected {
+		if store.changed[appID] != status {
+			t.Errorf("Expected %s to be %s, got %s", appID, status, store.changed[appID])
+		}
+	}
+	if len(notified) != len(expected) {
+		t.Errorf("Expected %d notifications, got %v", len(expected), notified)
+	}
+}
+
+func TestRunDryRun(t *testing.T) {
+	store := newStore()
+	r := newReaper(store, true)
+
+	report, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !report.DryRun {
+		t.Error("Expected a dry-run report")
+	}
+	if len(store.changed) != 0 {
+		t.Fatalf("Expected no changes in dry-run mode, got %v", store.changed)
+	}
+
+	for _, candidate := range report.Candidates {
+		if candidate.AppID == "flagged-recently" && candidate.Action != ActionNone {
+			t.Errorf("Expected no action for a recently flagged app, got %s", candidate.Action)
+		}
+		if candidate.AppID == "flagged-late" && candidate.Action != ActionNone {
+			t.Errorf("Expected no action for an app flagged after a long time, got %s", candidate.Action)
+		}
+		if candidate.AppID == "idle" && candidate.IdleDays != 40 {
+			t.Errorf("Expected 40 idle days, got %d", candidate.IdleDays)
+		}
+	}
+}
+
+func TestRunSkipsConflicts(t *testing.T) {
+	store := newStore()
+	store.conflicts["idle"] = true
+	notified := map[string]string{}
+	r := newReaper(store, false)
+	r.Notifier = NotifierFunc(func(candidate *Candidate) error {
+		notified[candidate.AppID] = candidate.Action
+		return nil
+	})
+
+	if _, err := r.Run(context.Background()); err != nil {
+		t.Fatalf("Expected the conflict to be skipped, got %s", err)
+	}
+	if _, ok := store.changed["idle"]; ok {
+		t.Error("Expected the app changed in the meantime not to be changed")
+	}
+	if _, ok := notified["idle"]; ok {
+		t.Error("Expected no notification for the app changed in the meantime")
+	}
+	if notified["very-old"] != ActionFlag {
+		t.Errorf("Expected the other apps to be processed, got %v", notified)
+	}
+}
+
+func TestNextActionSkipsDisabledStages(t *testing.T) {
+	r := New(&storeMock{}, Policy{SuspendAfterDays: 60})
+
+	if action := r.nextAction(db.StatusActive, 70, 70); action != ActionSuspend {
+		t.Errorf("Expected suspend, got %s", action)
+	}
+	if action := r.nextAction(db.StatusActive, 50, 50); action != ActionNone {
+		t.Errorf("Expected none, got %s", action)
+	}
+	if action := r.nextAction(db.StatusSuspended, 500, 500); action != ActionNone {
+		t.Errorf("Expected none for a suspended app without delete stage, got %s", action)
+	}
+}
@@ -0,0 +1,186 @@
+// Package registration keeps the service registered on the API gateway. The registration is retried with
+// backoff in the background, so the service starts also when the gateway is not reachable, and it is
+// periodically repeated, so the service is registered again if the gateway loses it.
+package registration
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Gateway registers the service on an API gateway. SelfRegister must be safe to call when the service is
+// already registered. Implemented by gateway.KongGateway of microservice-tools.
+type Gateway interface {
+	SelfRegister() error
+	Unregister() error
+}
+
+// None is a Gateway for running without an API gateway. The service is always registered.
+type None struct{}
+
+// SelfRegister does nothing.
+func (None) SelfRegister() error {
+	return nil
+}
+
+// Unregister does nothing.
+func (None) Unregister() error {
+	return nil
+}
+
+// Registrar registers the service on the gateway in the background.
+type Registrar struct {
+	// ErrorHandler is called with the errors of the failed registrations.
+	ErrorHandler func(err error)
+	// RegisteredHandler is called when the service becomes registered, at the first registration
+	// and after failed registrations.
+	RegisteredHandler func()
+
+	gateway           Gateway
+	initialBackoff    time.Duration
+	maxBackoff        time.Duration
+	reconcileInterval time.Duration
+
+	mutex          sync.Mutex
+	registered     bool
+	everRegistered bool
+	lastErr        error
+	unregistered   bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// New creates a Registrar for the gateway. A failed registration is retried after initialBackoff, doubled after
+// every failure up to maxBackoff. Once registered, the registration is repeated on every reconcileInterval.
+func New(gateway Gateway, initialBackoff, maxBackoff, reconcileInterval time.Duration) *Registrar {
+	return &Registrar{
+		gateway:           gateway,
+		initialBackoff:    initialBackoff,
+		maxBackoff:        maxBackoff,
+		reconcileInterval: reconcileInterval,
+	}
+}
+
+// Register registers the service once and records the result.
+func (r *Registrar) Register() error {
+	err := r.gateway.SelfRegister()
+
+	r.mutex.Lock()
+	wasRegistered := r.registered
+	r.registered = err == nil
+	r.everRegistered = r.everRegistered || err == nil
+	r.lastErr = err
+	r.mutex.Unlock()
+
+	if err != nil {
+		if r.ErrorHandler != nil {
+			r.ErrorHandler(err)
+		}
+		return err
+	}
+	if !wasRegistered && r.RegisteredHandler != nil {
+		r.RegisteredHandler()
+	}
+	return nil
+}
+
+// Registered returns whether the last registration succeeded.
+func (r *Registrar) Registered() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.registered
+}
+
+// Check is the readiness check of the registration. It fails until the service is registered for the first time
+// and after the service is unregistered. A failed reconciliation does not fail the check: the gateway is shared
+// by all instances, so an outage of the gateway would otherwise take every instance out of service, although
+// the routes registered before keep working. The failure is reported to the ErrorHandler and retried.
+func (r *Registrar) Check(ctx context.Context) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	switch {
+	case r.unregistered:
+		return fmt.Errorf("unregistered from the gateway")
+	case r.everRegistered:
+		return nil
+	case r.lastErr != nil:
+		return fmt.Errorf("not registered on the gateway: %s", r.lastErr)
+	}
+	return fmt.Errorf("not registered on the gateway yet")
+}
+
+// Start registers the service in the background, retrying with backoff until it succeeds, and then
+// repeats the registration on every reconcile interval.
+func (r *Registrar) Start() {
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+		backoff := r.initialBackoff
+		for {
+			wait := r.reconcileInterval
+			if err := r.Register(); err != nil {
+				wait = jitter(backoff)
+				backoff *= 2
+				if backoff > r.maxBackoff {
+					backoff = r.maxBackoff
+				}
+			} else {
+				backoff = r.initialBackoff
+			}
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-r.stop:
+				timer.Stop()
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background registration. The service stays registered.
+func (r *Registrar) Stop() {
+	if r.stop == nil {
+		return
+	}
+	close(r.stop)
+	<-r.done
+	r.stop = nil
+}
+
+// Unregister stops the background registration and removes the service from the gateway, if it is registered.
+// Only the first call has an effect.
+func (r *Registrar) Unregister() error {
+	r.Stop()
+
+	r.mutex.Lock()
+	if r.unregistered {
+		r.mutex.Unlock()
+		return nil
+	}
+	registered := r.registered
+	r.registered = false
+	r.unregistered = true
+	r.mutex.Unlock()
+
+	if !registered {
+		return nil
+	}
+	return r.gateway.Unregister()
+}
+
+// jitter spreads the retries of the instances that failed at the same time, by up to 20% of the backoff.
+func jitter(backoff time.Duration) time.Duration {
+	spread := int64(backoff) / 5
+	if spread <= 0 {
+		return backoff
+	}
+	return backoff - time.Duration(spread) + time.Duration(rand.Int63n(2*spread))
+}
@@ -0,0 +1,140 @@
+package registration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeGateway struct {
+	mutex         sync.Mutex
+	failures      int
+	registrations int
+	unregistered  int
+}
+
+func (g *fakeGateway) SelfRegister() error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.registrations++
+	if g.failures > 0 {
+		g.failures--
+		return fmt.Errorf("connection refused")
+	}
+	return nil
+}
+
+func (g *fakeGateway) Unregister() error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.unregistered++
+	return nil
+}
+
+func (g *fakeGateway) count() int {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.registrations
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for the condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRetryAndReconcile(t *testing.T) {
+	gateway := &fakeGateway{failures: 3}
+	registrar := New(gateway, time.Millisecond, 4*time.Millisecond, 5*time.Millisecond)
+	errors := 0
+	registeredCalls := 0
+	registrar.ErrorHandler = func(err error) { errors++ }
+	registrar.RegisteredHandler = func() { registeredCalls++ }
+
+	if err := registrar.Check(context.Background()); err == nil {
+		t.Fatal("Expected the service not to be ready before the registration")
+	}
+
+	registrar.Start()
+	waitFor(t, registrar.Registered)
+	if err := registrar.Check(context.Background()); err != nil {
+		t.Fatalf("Expected the service to be ready once registered, got %v", err)
+	}
+
+	waitFor(t, func() bool { return gateway.count() >= 6 })
+	if err := registrar.Unregister(); err != nil {
+		t.Fatal(err)
+	}
+	if errors != 3 || registeredCalls != 1 {
+		t.Fatalf("Expected 3 errors and a single registered call, got %d and %d", errors, registeredCalls)
+	}
+	if gateway.unregistered != 1 {
+		t.Fatalf("Expected the service to be unregistered, got %d", gateway.unregistered)
+	}
+
+	registrations := gateway.count()
+	time.Sleep(20 * time.Millisecond)
+	if gateway.count() != registrations {
+		t.Fatal("Expected no registrations after unregistering")
+	}
+	if err := registrar.Unregister(); err != nil || gateway.unregistered != 1 {
+		t.Fatalf("Expected the second unregister to do nothing, got %v, %d", err, gateway.unregistered)
+	}
+	if err := registrar.Check(context.Background()); err == nil {
+		t.Fatal("Expected the service not to be ready after unregistering")
+	}
+}
+
+func TestFailedReconcile(t *testing.T) {
+	gateway := &fakeGateway{}
+	registrar := New(gateway, time.Hour, time.Hour, time.Hour)
+	if err := registrar.Register(); err != nil {
+		t.Fatal(err)
+	}
+
+	gateway.failures = 1
+	if err := registrar.Register(); err == nil {
+		t.Fatal("Expected the registration to fail")
+	}
+	if registrar.Registered() {
+		t.Fatal("Expected the service not to be registered after the failed reconciliation")
+	}
+	if err := registrar.Check(context.Background()); err != nil {
+		t.Fatalf("Expected the service to stay ready after a failed reconciliation, got %v", err)
+	}
+
+	if err := registrar.Unregister(); err != nil || gateway.unregistered != 0 {
+		t.Fatalf("Expected no unregistration of an unregistered service, got %v, %d", err, gateway.unregistered)
+	}
+}
+
+func TestFailedFirstRegistration(t *testing.T) {
+	gateway := &fakeGateway{failures: 1}
+	registrar := New(gateway, time.Hour, time.Hour, time.Hour)
+	if err := registrar.Register(); err == nil {
+		t.Fatal("Expected the registration to fail")
+	}
+	if err := registrar.Check(context.Background()); err == nil || err.Error() != "not registered on the gateway: connection refused" {
+		t.Fatalf("Expected the error of the registration, got %v", err)
+	}
+
+	if err := registrar.Register(); err != nil {
+		t.Fatal(err)
+	}
+	if err := registrar.Check(context.Background()); err != nil {
+		t.Fatalf("Expected the service to be ready once registered, got %v", err)
+	}
+}
+
+func TestNone(t *testing.T) {
+	registrar := New(None{}, time.Hour, time.Hour, time.Hour)
+	if err := registrar.Register(); err != nil || !registrar.Registered() {
+		t.Fatalf("Expected the service to be registered without a gateway, got %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+// Package reload applies the changes of the configuration while the service runs: Watcher notices that the
+// configuration file changed or that the service got a signal, and Middleware swaps a middleware without
+// dropping the requests that are passing through it.
+package reload
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/keitaroinc/goa"
+)
+
+// Watcher calls a reload function when the content of a file changes and when the process gets one of the signals.
+type Watcher struct {
+	// ErrorHandler is called with the errors of reading the file and of the reloads.
+	ErrorHandler func(err error)
+
+	path   string
+	reload func() error
+	hash   []byte
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewWatcher creates a Watcher of the file. The reload function is never called concurrently.
+func NewWatcher(path string, reload func() error) *Watcher {
+	return &Watcher{
+		path:   path,
+		reload: reload,
+	}
+}
+
+// Start records the current content of the file and starts checking it for changes on every interval,
+// and listening for the signals.
+func (w *Watcher) Start(interval time.Duration, signals ...os.Signal) {
+	w.hash, _ = w.fileHash()
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+
+	received := make(chan os.Signal, 1)
+	if len(signals) > 0 {
+		signal.Notify(received, signals...)
+	}
+
+	go func() {
+		defer close(w.done)
+		defer signal.Stop(received)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				w.checkFile()
+			case <-received:
+				w.hash, _ = w.fileHash()
+				w.handle(w.reload())
+			case <-w.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops watching the file and the signals.
+func (w *Watcher) Stop() {
+	if w.stop == nil {
+		return
+	}
+	close(w.stop)
+	<-w.done
+	w.stop = nil
+}
+
+// checkFile reloads if the content of the file changed. A change is reloaded once, also if the reload fails,
+// so an invalid file is reported once and not on every check.
+func (w *Watcher) checkFile() {
+	hash, err := w.fileHash()
+	if err != nil {
+		w.handle(err)
+		return
+	}
+	if bytes.Equal(hash, w.hash) {
+		return
+	}
+	w.hash = hash
+	w.handle(w.reload())
+}
+
+func (w *Watcher) fileHash() ([]byte, error) {
+	data, err := ioutil.ReadFile(w.path)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(data)
+	return hash[:], nil
+}
+
+func (w *Watcher) handle(err error) {
+	if err != nil && w.ErrorHandler != nil {
+		w.ErrorHandler(err)
+	}
+}
+
+// Middleware is a goa middleware that can be swapped while it serves requests. The requests that started
+// before a swap finish with the previous middleware, which is then cleaned up.
+type Middleware struct {
+	mutex   sync.RWMutex
+	current *generation
+}
+
+type generation struct {
+	middleware goa.Middleware
+	cleanup    func()
+	inFlight   sync.WaitGroup
+}
+
+// NewMiddleware creates a Middleware serving with the middleware. The cleanup function, which may be nil,
+// releases the resources of the middleware once it is swapped or closed.
+func NewMiddleware(middleware goa.Middleware, cleanup func()) *Middleware {
+	return &Middleware{current: &generation{middleware: middleware, cleanup: cleanup}}
+}
+
+// Handler returns the goa middleware to mount on the service.
+func (m *Middleware) Handler() goa.Middleware {
+	return func(h goa.Handler) goa.Handler {
+		return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+			m.mutex.RLock()
+			current := m.current
+			current.inFlight.Add(1)
+			m.mutex.RUnlock()
+			defer current.inFlight.Done()
+
+			return current.middleware(h)(ctx, rw, req)
+		}
+	}
+}
+
+// Swap replaces the middleware for the next requests. The previous middleware is cleaned up in the background,
+// once its requests are done.
+func (m *Middleware) Swap(middleware goa.Middleware, cleanup func()) {
+	m.mutex.Lock()
+	previous := m.current
+	m.current = &generation{middleware: middleware, cleanup: cleanup}
+	m.mutex.Unlock()
+
+	go previous.close()
+}
+
+// Close waits for the requests of the current middleware and cleans it up.
+func (m *Middleware) Close() {
+	m.mutex.RLock()
+	current := m.current
+	m.mutex.RUnlock()
+	current.close()
+}
+
+func (g *generation) close() {
+	g.inFlight.Wait()
+	if g.cleanup != nil {
+		g.cleanup()
+	}
+}
@@ -0,0 +1,138 @@
+package reload
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/keitaroinc/goa"
+)
+
+func waitFor(t *testing.T, condition func() bool) {
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for the condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.json")
+	if err := ioutil.WriteFile(path, []byte(`{"logging":{"level":"info"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var reloads, errors int32
+	watcher := NewWatcher(path, func() error {
+		if atomic.AddInt32(&reloads, 1) == 1 {
+			return fmt.Errorf("invalid configuration")
+		}
+		return nil
+	})
+	watcher.ErrorHandler = func(err error) {
+		atomic.AddInt32(&errors, 1)
+	}
+	watcher.Start(time.Millisecond, syscall.SIGHUP)
+	defer watcher.Stop()
+
+	time.Sleep(10 * time.Millisecond)
+	if atomic.LoadInt32(&reloads) != 0 {
+		t.Fatal("Expected no reload of an unchanged file")
+	}
+
+	if err := ioutil.WriteFile(path, []byte(`{"logging":{"level":"error"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return atomic.LoadInt32(&reloads) == 1 })
+	time.Sleep(10 * time.Millisecond)
+	if atomic.LoadInt32(&reloads) != 1 || atomic.LoadInt32(&errors) != 1 {
+		t.Fatalf("Expected a single failed reload of the change, got %d reloads and %d errors", reloads, errors)
+	}
+
+	if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return atomic.LoadInt32(&reloads) == 2 })
+
+	watcher.Stop()
+	if err := ioutil.WriteFile(path, []byte(`{}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if atomic.LoadInt32(&reloads) != 2 {
+		t.Fatal("Expected no reloads after stopping")
+	}
+}
+
+func tagging(tag string) goa.Middleware {
+	return func(h goa.Handler) goa.Handler {
+		return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+			rw.Header().Set("X-Tag", tag)
+			return h(ctx, rw, req)
+		}
+	}
+}
+
+func TestMiddlewareSwap(t *testing.T) {
+	var firstCleaned int32
+	m := NewMiddleware(tagging("first"), func() { atomic.StoreInt32(&firstCleaned, 1) })
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	slow := m.Handler()(func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		close(started)
+		<-release
+		return nil
+	})
+	fast := m.Handler()(func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		return nil
+	})
+
+	inFlight := httptest.NewRecorder()
+	done := make(chan error)
+	go func() {
+		done <- slow(context.Background(), inFlight, httptest.NewRequest("GET", "/", nil))
+	}()
+	<-started
+
+	secondCleaned := make(chan struct{})
+	m.Swap(tagging("second"), func() { close(secondCleaned) })
+	rw := httptest.NewRecorder()
+	if err := fast(context.Background(), rw, httptest.NewRequest("GET", "/", nil)); err != nil {
+		t.Fatal(err)
+	}
+	if rw.Header().Get("X-Tag") != "second" {
+		t.Fatalf("Expected the new middleware after the swap, got %q", rw.Header().Get("X-Tag"))
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	if atomic.LoadInt32(&firstCleaned) != 0 {
+		t.Fatal("Expected the previous middleware to be kept while it has requests")
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if inFlight.Header().Get("X-Tag") != "first" {
+		t.Fatalf("Expected the in-flight request to finish with the previous middleware, got %q", inFlight.Header().Get("X-Tag"))
+	}
+	waitFor(t, func() bool { return atomic.LoadInt32(&firstCleaned) == 1 })
+
+	m.Close()
+	<-secondCleaned
+}
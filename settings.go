@@ -0,0 +1,594 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Microkubes/microservice-tools/config"
+)
+
+// Settings is the complete configuration of the service: the common microservice configuration and the
+// configuration specific to apps-management, which are read from the same JSON file.
+//
+// The settings are loaded in layers, each overriding the previous one: the JSON file, the environment
+// variables and the command line flags. The documented defaults are used for the settings that none of
+// the layers sets.
+type Settings struct {
+	// Service is the common microservice configuration.
+	Service *config.ServiceConfig
+	// Apps is the configuration specific to apps-management.
+	Apps *AppsConfig
+	// ConfigFile is the JSON file the settings were read from.
+	ConfigFile string
+	// PrintConfig is set by the -print-config flag.
+	PrintConfig bool
+
+	// loaded are the settings last read from the configuration, when a reload kept some of the running settings.
+	loaded *Settings
+}
+
+// envPrefix is the prefix of the environment variables that override the settings. The rest of the name is the
+// path of the setting in the JSON file in upper snake case, for example APPS_DATABASE_DB_INFO_HOST.
+const envPrefix = "APPS_"
+
+// legacyEnv holds the environment variables that were documented before the layered configuration,
+// with the paths of the settings they override. The APPS_ variables take precedence over them.
+var legacyEnv = [][2]string{
+	{"MONGO_URL", "database.dbInfo.host"},
+	{"MS_USERNAME", "database.dbInfo.user"},
+	{"MS_PASSWORD", "database.dbInfo.pass"},
+	{"MS_DBNAME", "database.dbInfo.database"},
+}
+
+// secretSettings are the paths of the settings that are redacted when the configuration is printed.
+var secretSettings = []string{"database.dbInfo.pass", "systemKey", "cache.invalidation.token"}
+
+// Indexes of the roots of a setting.
+const (
+	serviceRoot = iota
+	appsRoot
+)
+
+// setting is a scalar field of the configuration that can be overridden by an environment variable and a flag.
+type setting struct {
+	path  string
+	env   string
+	root  int
+	index []int
+	typ   reflect.Type
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// rootTypes returns the types of the roots of the settings.
+func rootTypes() []reflect.Type {
+	return []reflect.Type{
+		serviceRoot: reflect.TypeOf(config.ServiceConfig{}),
+		appsRoot:    reflect.TypeOf(AppsConfig{}),
+	}
+}
+
+// allSettings returns the settings that can be overridden, ordered by path. Lists of strings are included,
+// lists of objects and maps can be set only in the JSON file.
+func allSettings() []*setting {
+	settings := []*setting{}
+	for root, t := range rootTypes() {
+		root := root
+		walkSettings(t, "", nil, func(path string, index []int, t reflect.Type) {
+			settings = append(settings, &setting{
+				path:  path,
+				env:   envName(path),
+				root:  root,
+				index: index,
+				typ:   t,
+			})
+		})
+	}
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].path < settings[j].path
+	})
+	return settings
+}
+
+// walkSettings calls visit for every scalar field of the struct type, with its JSON path and its field index.
+func walkSettings(t reflect.Type, prefix string, index []int, visit func(path string, index []int, t reflect.Type)) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := jsonName(field)
+		if !ok {
+			continue
+		}
+		fieldIndex := append(append([]int{}, index...), i)
+		fieldType := indirect(field.Type)
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			walkSettings(fieldType, prefix, fieldIndex, visit)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		path := joinPath(prefix, name)
+		if isScalar(fieldType) {
+			visit(path, fieldIndex, field.Type)
+		} else if fieldType.Kind() == reflect.Struct {
+			walkSettings(fieldType, path, fieldIndex, visit)
+		}
+	}
+}
+
+// jsonName returns the name of the field in the JSON tag, and false for the fields that are not in JSON.
+func jsonName(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" && !field.Anonymous {
+		return "", false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	return strings.Split(tag, ",")[0], true
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+// isScalar checks if a value of the type can be given as a single string.
+func isScalar(t reflect.Type) bool {
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.String
+	}
+	return false
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// envName returns the environment variable of the setting: the path in upper snake case, with the prefix.
+func envName(path string) string {
+	name := []rune{}
+	runes := []rune(path)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			name = append(name, '_')
+			continue
+		case unicode.IsUpper(r) && i > 0:
+			previous := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+				name = append(name, '_')
+			}
+		}
+		name = append(name, unicode.ToUpper(r))
+	}
+	return envPrefix + string(name)
+}
+
+// set parses the value and sets the setting in the roots. Lists are given as comma separated values.
+func (s *setting) set(roots []reflect.Value, value string) error {
+	t := indirect(s.typ)
+	var data []byte
+	switch {
+	case t.Kind() == reflect.Slice:
+		items := []string{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		data, _ = json.Marshal(items)
+	case t.Kind() == reflect.String:
+		data, _ = json.Marshal(value)
+	case reflect.PtrTo(t).Implements(unmarshalerType) && !json.Valid([]byte(value)):
+		data, _ = json.Marshal(value)
+	default:
+		data = []byte(value)
+	}
+
+	parsed := reflect.New(s.typ)
+	if err := json.Unmarshal(data, parsed.Interface()); err != nil {
+		return fmt.Errorf("%s: invalid value %q", s.path, value)
+	}
+	fieldByIndex(roots[s.root], s.index).Set(parsed.Elem())
+	return nil
+}
+
+// fieldByIndex returns the field of the struct value, allocating the nil pointers on the way.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v
+}
+
+// LoadSettings loads the settings from the configuration file, the environment variables read with getenv
+// and the command line arguments. The configuration file is defaultConfigFile, unless the -config flag is given.
+// It returns flag.ErrHelp if help was requested, and a *ValidationError listing all problems of an invalid
+// configuration.
+func LoadSettings(args []string, getenv func(string) string, defaultConfigFile string) (*Settings, error) {
+	settings := &Settings{
+		Service: &config.ServiceConfig{},
+		Apps:    &AppsConfig{},
+	}
+	all := allSettings()
+
+	flags := flag.NewFlagSet("apps-management", flag.ContinueOnError)
+	flags.StringVar(&settings.ConfigFile, "config", defaultConfigFile, "the JSON configuration `file`")
+	flags.BoolVar(&settings.PrintConfig, "print-config", false, "print the configuration, with the secrets redacted, and exit")
+	byPath := map[string]*setting{}
+	for _, s := range all {
+		byPath[s.path] = s
+		flags.String(s.path, "", fmt.Sprintf("overrides %s, also set by %s", s.path, s.env))
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(settings.ConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	if problems := unknownSettings(data); len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+	if err := json.Unmarshal(data, settings.Service); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, settings.Apps); err != nil {
+		return nil, err
+	}
+
+	roots := []reflect.Value{
+		serviceRoot: reflect.ValueOf(settings.Service).Elem(),
+		appsRoot:    reflect.ValueOf(settings.Apps).Elem(),
+	}
+	problems := []string{}
+	override := func(s *setting, source, value string) {
+		if err := s.set(roots, value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s (from %s)", err, source))
+		}
+	}
+	for _, legacy := range legacyEnv {
+		if value := getenv(legacy[0]); value != "" {
+			override(byPath[legacy[1]], legacy[0], value)
+		}
+	}
+	for _, s := range all {
+		if value := getenv(s.env); value != "" {
+			override(s, s.env, value)
+		}
+	}
+	flags.Visit(func(f *flag.Flag) {
+		if s, ok := byPath[f.Name]; ok {
+			override(s, "-"+f.Name, f.Value.String())
+		}
+	})
+
+	settings.Apps.setDefaults()
+	problems = append(problems, settings.validate()...)
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, &ValidationError{Problems: problems}
+	}
+	return settings, nil
+}
+
+// unknownSettings returns a problem for every key of the JSON configuration that is not a setting.
+func unknownSettings(data []byte) []string {
+	file := map[string]interface{}{}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return []string{fmt.Sprintf("invalid JSON: %s", err)}
+	}
+
+	problems := []string{}
+	for key, value := range file {
+		found := false
+		for _, t := range rootTypes() {
+			if fieldType, ok := findField(t, key); ok {
+				found = true
+				problems = append(problems, unknownKeys(value, fieldType, key)...)
+				break
+			}
+		}
+		if !found {
+			problems = append(problems, fmt.Sprintf("%s: unknown setting", key))
+		}
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+// unknownKeys returns a problem for every key of the JSON value that is not a field of the type.
+func unknownKeys(value interface{}, t reflect.Type, path string) []string {
+	t = indirect(t)
+	if isScalar(t) {
+		return nil
+	}
+	problems := []string{}
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		for key, item := range object {
+			fieldType, ok := findField(t, key)
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s: unknown setting", joinPath(path, key)))
+				continue
+			}
+			problems = append(problems, unknownKeys(item, fieldType, joinPath(path, key))...)
+		}
+	case reflect.Slice, reflect.Array:
+		items, _ := value.([]interface{})
+		for i, item := range items {
+			problems = append(problems, unknownKeys(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+	return problems
+}
+
+// findField returns the type of the field with the JSON name, matched like encoding/json does.
+func findField(t reflect.Type, key string) (reflect.Type, bool) {
+	var folded reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := jsonName(field)
+		if !ok {
+			continue
+		}
+		if field.Anonymous && name == "" && indirect(field.Type).Kind() == reflect.Struct {
+			if fieldType, ok := findField(indirect(field.Type), key); ok {
+				return fieldType, true
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if name == key {
+			return field.Type, true
+		}
+		if folded == nil && strings.EqualFold(name, key) {
+			folded = field.Type
+		}
+	}
+	return folded, folded != nil
+}
+
+// validate checks the settings and returns a description of every problem.
+func (s *Settings) validate() []string {
+	problems := s.Apps.validate()
+
+	if s.Service.Service == nil {
+		problems = append(problems, "service: missing")
+	} else {
+		if s.Service.Service.MicroserviceName == "" {
+			problems = append(problems, "service.name: missing")
+		}
+		if port := s.Service.Service.MicroservicePort; port < 1 || port > 65535 {
+			problems = append(problems, fmt.Sprintf("service.port: %d is not between 1 and 65535", port))
+		}
+	}
+
+	switch s.Service.DBConfig.DBName {
+	case "mongodb":
+		if s.Service.DBConfig.DBInfo.Host == "" {
+			problems = append(problems, "database.dbInfo.host: missing")
+		}
+		if s.Service.DBConfig.DBInfo.DatabaseName == "" {
+			problems = append(problems, "database.dbInfo.database: missing")
+		}
+	case "dynamodb":
+		if s.Service.DBConfig.DBInfo.AWSRegion == "" {
+			problems = append(problems, "database.dbInfo.awsRegion: missing")
+		}
+	default:
+		problems = append(problems, fmt.Sprintf("database.dbName: unknown database %q, expected mongodb or dynamodb", s.Service.DBConfig.DBName))
+	}
+
+	if !s.Service.SecurityConfig.Disable && s.Service.SecurityConfig.KeysDir == "" {
+		problems = append(problems, "security.keysDir: missing")
+	}
+	return problems
+}
+
+// Redacted returns the settings as indented JSON, in the format of the configuration file, with the secrets redacted.
+func (s *Settings) Redacted() ([]byte, error) {
+	values, err := s.values()
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range secretSettings {
+		redact(values, strings.Split(path, "."))
+	}
+	return json.MarshalIndent(values, "", "  ")
+}
+
+// values returns the settings in the format of the configuration file.
+func (s *Settings) values() (map[string]interface{}, error) {
+	merged := map[string]interface{}{}
+	for _, root := range []interface{}{s.Service, s.Apps} {
+		data, err := json.Marshal(root)
+		if err != nil {
+			return nil, err
+		}
+		values := map[string]interface{}{}
+		if err := json.Unmarshal(data, &values); err != nil {
+			return nil, err
+		}
+		for key, value := range values {
+			merged[key] = value
+		}
+	}
+	return merged, nil
+}
+
+// liveSettings are the paths of the settings, or of the groups of settings, that a reload applies to the running
+// service. The other settings take effect only after a restart.
+var liveSettings = []string{
+	"security.acl",
+	"logging.level",
+	"appQuotas",
+	"rateLimits",
+	"publicView",
+	"domainVerification.timeout",
+	"logos.maxBytes",
+	"logos.minSize",
+	"logos.maxSize",
+	"logos.thumbnailSize",
+	"logos.cacheMaxAge",
+}
+
+// Reload returns the settings to run with after the configuration changed to next: the settings that can change
+// live are taken from next, and the others are kept. It returns the paths of the applied settings, and of the
+// settings that differ from the running ones and require a restart. A setting that requires a restart is returned
+// only by the reload that reads the change, and not again by the later reloads, until it changes again.
+func (s *Settings) Reload(next *Settings) (reloaded *Settings, applied, restartRequired []string, err error) {
+	changed, err := s.changed(next)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	seen := s
+	if s.loaded != nil {
+		seen = s.loaded
+	}
+	changedSinceSeen, err := seen.changed(next)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	isNew := map[string]bool{}
+	for _, path := range changedSinceSeen {
+		isNew[path] = true
+	}
+
+	for _, path := range changed {
+		if isLive(path) {
+			applied = append(applied, path)
+		} else if isNew[path] {
+			restartRequired = append(restartRequired, path)
+		}
+	}
+
+	service := *s.Service
+	security := s.Service.SecurityConfig
+	security.ACLConfig = next.Service.SecurityConfig.ACLConfig
+	service.SecurityConfig = security
+
+	apps := *s.Apps
+	apps.Logging.Level = next.Apps.Logging.Level
+	apps.AppQuotas = next.Apps.AppQuotas
+	apps.RateLimits = next.Apps.RateLimits
+	apps.PublicView = next.Apps.PublicView
+	apps.DomainVerification.Timeout = next.Apps.DomainVerification.Timeout
+	apps.Logos.MaxBytes = next.Apps.Logos.MaxBytes
+	apps.Logos.MinSize = next.Apps.Logos.MinSize
+	apps.Logos.MaxSize = next.Apps.Logos.MaxSize
+	apps.Logos.ThumbnailSize = next.Apps.Logos.ThumbnailSize
+	apps.Logos.CacheMaxAge = next.Apps.Logos.CacheMaxAge
+
+	reloaded = &Settings{
+		Service:    &service,
+		Apps:       &apps,
+		ConfigFile: s.ConfigFile,
+		loaded:     next,
+	}
+	return reloaded, applied, restartRequired, nil
+}
+
+// changed returns the sorted paths of the settings that differ in other. Lists are compared as a whole.
+func (s *Settings) changed(other *Settings) ([]string, error) {
+	values, err := s.values()
+	if err != nil {
+		return nil, err
+	}
+	otherValues, err := other.values()
+	if err != nil {
+		return nil, err
+	}
+
+	flat, otherFlat := map[string]string{}, map[string]string{}
+	flatten(values, "", flat)
+	flatten(otherValues, "", otherFlat)
+	for path := range otherFlat {
+		if _, ok := flat[path]; !ok {
+			flat[path] = ""
+		}
+	}
+
+	changed := []string{}
+	for path, value := range flat {
+		if otherFlat[path] != value {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed, nil
+}
+
+// flatten sets the JSON of every value that is not an object in flat, by its path.
+func flatten(values map[string]interface{}, prefix string, flat map[string]string) {
+	for key, value := range values {
+		path := joinPath(prefix, key)
+		if nested, ok := value.(map[string]interface{}); ok {
+			flatten(nested, path, flat)
+			continue
+		}
+		data, _ := json.Marshal(value)
+		flat[path] = string(data)
+	}
+}
+
+// isLive checks if a reload applies the setting.
+func isLive(path string) bool {
+	for _, live := range liveSettings {
+		if path == live || strings.HasPrefix(path, live+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// redact replaces the value at the path with a placeholder, if it is set.
+func redact(values map[string]interface{}, path []string) {
+	value, ok := values[path[0]]
+	if !ok {
+		return
+	}
+	if len(path) > 1 {
+		if nested, ok := value.(map[string]interface{}); ok {
+			redact(nested, path[1:])
+		}
+		return
+	}
+	if value != "" && value != nil {
+		values[path[0]] = "REDACTED"
+	}
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func env(values map[string]string) func(string) string {
+	return func(name string) string {
+		return values[name]
+	}
+}
+
+func writeConfig(t *testing.T, content string) string {
+	file, err := ioutil.TempFile("", "apps-management-config-*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+	return file.Name()
+}
+
+func TestLoadSettings(t *testing.T) {
+	settings, err := LoadSettings(nil, env(nil), "config.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings.Service.Service.MicroservicePort != 8080 || settings.Service.DBConfig.DBInfo.Host != "mongo:27017" {
+		t.Errorf("Expected the values of the file, got %+v", settings.Service)
+	}
+	if settings.Apps.RateLimits.Default.RequestsPerSecond != 10 {
+		t.Errorf("Expected the apps settings of the file, got %+v", settings.Apps.RateLimits.Default)
+	}
+}
+
+func TestLoadSettingsPrecedence(t *testing.T) {
+	settings, err := LoadSettings(
+		[]string{"-service.port=9191", "-usage.flushInterval", "2m"},
+		env(map[string]string{
+			"APPS_SERVICE_PORT":             "9090",
+			"APPS_USAGE_FLUSH_INTERVAL":     "1m",
+			"APPS_METRICS_ENABLED":          "false",
+			"APPS_SECURITY_IGNORE_PATTERNS": "^/a$, ^/b$",
+			"MONGO_URL":                     "legacy:27017",
+			"APPS_DATABASE_DB_INFO_HOST":    "mongo-2:27017",
+			"MS_PASSWORD":                   "from-env",
+		}),
+		"config.json",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if settings.Service.Service.MicroservicePort != 9191 {
+		t.Errorf("Expected the flag to override the environment, got port %d", settings.Service.Service.MicroservicePort)
+	}
+	if time.Duration(settings.Apps.Usage.FlushInterval) != 2*time.Minute {
+		t.Errorf("Expected a 2m flush interval, got %s", time.Duration(settings.Apps.Usage.FlushInterval))
+	}
+	if settings.Apps.Metrics.Enabled {
+		t.Error("Expected the metrics to be disabled by the environment")
+	}
+	if !reflect.DeepEqual(settings.Service.SecurityConfig.IgnorePatterns, []string{"^/a$", "^/b$"}) {
+		t.Errorf("Expected the list from the environment, got %v", settings.Service.SecurityConfig.IgnorePatterns)
+	}
+	if settings.Service.DBConfig.DBInfo.Host != "mongo-2:27017" {
+		t.Errorf("Expected the APPS_ variable to override MONGO_URL, got %s", settings.Service.DBConfig.DBInfo.Host)
+	}
+	if settings.Service.DBConfig.DBInfo.Password != "from-env" {
+		t.Errorf("Expected MS_PASSWORD to set the password, got %s", settings.Service.DBConfig.DBInfo.Password)
+	}
+}
+
+func TestLoadSettingsConfigFlag(t *testing.T) {
+	if _, err := LoadSettings([]string{"-config", "does-not-exist.json"}, env(nil), "config.json"); !os.IsNotExist(err) {
+		t.Fatalf("Expected the file of the flag to be read, got %v", err)
+	}
+	if _, err := LoadSettings([]string{"-h"}, env(nil), "config.json"); err != flag.ErrHelp {
+		t.Fatalf("Expected flag.ErrHelp, got %v", err)
+	}
+}
+
+func TestLoadSettingsInvalid(t *testing.T) {
+	file := writeConfig(t, `{
+  "service": {"name": "apps", "port": 70000},
+  "database": {"dbName": "mongodb", "dbInfo": {"host": "mongo:27017", "hots": "typo"}},
+  "security": {"keysDir": "/run/secrets"},
+  "metricz": {}
+}`)
+	defer os.Remove(file)
+
+	_, err := LoadSettings(nil, env(nil), file)
+	validationErr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	expected := []string{"database.dbInfo.hots: unknown setting", "metricz: unknown setting"}
+	if !reflect.DeepEqual(validationErr.Problems, expected) {
+		t.Fatalf("Expected %v, got %v", expected, validationErr.Problems)
+	}
+
+	file2 := writeConfig(t, `{
+  "service": {"name": "apps", "port": 70000},
+  "database": {"dbName": "mongodb", "dbInfo": {"host": "mongo:27017"}},
+  "security": {"keysDir": "/run/secrets"},
+  "store": {"timeouts": {"FindApps": "1s"}}
+}`)
+	defer os.Remove(file2)
+
+	_, err = LoadSettings([]string{"-tracing.exporter=zipkin", "-cache.invalidation.peersHost=apps-headless"},
+		env(map[string]string{"APPS_USAGE_FLUSH_INTERVAL": "soon", "APPS_USAGE_TRUSTED_PROXIES": "10.0.0.0/8,gateway"}), file2)
+	validationErr, ok = err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	for _, problem := range []string{
+		"database.dbInfo.database: missing",
+		"service.port: 70000 is not between 1 and 65535",
+		`usage.flushInterval: invalid value "soon" (from APPS_USAGE_FLUSH_INTERVAL)`,
+		"tracing.exporter",
+		"cache.invalidation.token: required",
+		"store.timeouts.FindApps: unknown store operation",
+		`usage.trustedProxies: "gateway" is not an IP address or a CIDR range`,
+	} {
+		if !strings.Contains(validationErr.Error(), problem) {
+			t.Errorf("Expected %q in %s", problem, validationErr)
+		}
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	settings, err := LoadSettings(nil, env(map[string]string{"APPS_SYSTEM_KEY": "system-secret"}), "config.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	printed, err := settings.Redacted()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(printed), "system-secret") || strings.Contains(string(printed), `"pass": "restapi"`) {
+		t.Fatalf("Expected the secrets to be redacted, got %s", printed)
+	}
+
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(printed, &values); err != nil {
+		t.Fatal(err)
+	}
+	if values["systemKey"] != "REDACTED" || values["metrics"] == nil || values["service"] == nil {
+		t.Fatalf("Expected both configurations with the secrets redacted, got %s", printed)
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	for path, expected := range map[string]string{
+		"database.dbInfo.host":       "APPS_DATABASE_DB_INFO_HOST",
+		"service.virtual_host":       "APPS_SERVICE_VIRTUAL_HOST",
+		"security.saml.rootURL":      "APPS_SECURITY_SAML_ROOT_URL",
+		"security.ignoreHTTPMethods": "APPS_SECURITY_IGNORE_HTTP_METHODS",
+		"usage.flushInterval":        "APPS_USAGE_FLUSH_INTERVAL",
+	} {
+		if name := envName(path); name != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, path, name)
+		}
+	}
+}
+
+func TestReload(t *testing.T) {
+	running, err := LoadSettings(nil, env(nil), "config.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := LoadSettings(nil, env(nil), "config.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	next.Service.SecurityConfig.ACLConfig.Policies[0].Description = "changed"
+	next.Service.Service.MicroservicePort = 9090
+	next.Service.DBConfig.DBInfo.Host = "mongo-2:27017"
+	next.Apps.Logging.Level = "error"
+	next.Apps.AppQuotas.DefaultMaxApps = 5
+	next.Apps.Usage.FlushInterval = Duration(time.Minute)
+
+	reloaded, applied, restartRequired, err := running.Reload(next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedApplied := []string{"appQuotas.defaultMaxApps", "logging.level", "security.acl.policies"}
+	if !reflect.DeepEqual(applied, expectedApplied) {
+		t.Errorf("Expected %v to be applied, got %v", expectedApplied, applied)
+	}
+	expectedRestart := []string{"database.dbInfo.host", "service.port", "usage.flushInterval"}
+	if !reflect.DeepEqual(restartRequired, expectedRestart) {
+		t.Errorf("Expected %v to require a restart, got %v", expectedRestart, restartRequired)
+	}
+
+	if reloaded.Apps.AppQuotas.DefaultMaxApps != 5 || reloaded.Apps.Logging.Level != "error" ||
+		reloaded.Service.SecurityConfig.ACLConfig.Policies[0].Description != "changed" {
+		t.Errorf("Expected the live settings to be applied, got %+v", reloaded.Apps)
+	}
+	if running.Apps.AppQuotas.DefaultMaxApps != 20 || running.Service.SecurityConfig.ACLConfig.Policies[0].Description == "changed" {
+		t.Error("Expected the running settings not to change")
+	}
+
+	// Every setting that is not applied must be one that requires a restart.
+	remaining, err := reloaded.changed(next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(remaining, expectedRestart) {
+		t.Errorf("Expected only %v to differ after the reload, got %v", expectedRestart, remaining)
+	}
+
+	// The changes that require a restart are reported once, and again only when they change again.
+	again, applied, restartRequired, err := reloaded.Reload(next)
+	if err != nil || len(applied) != 0 || len(restartRequired) != 0 {
+		t.Fatalf("Expected nothing to report for the same configuration, got %v, %v, %v", applied, restartRequired, err)
+	}
+	if again.Service.Service.MicroservicePort == 9090 || again.Apps.Usage.FlushInterval == Duration(time.Minute) {
+		t.Error("Expected the running values of the settings that require a restart")
+	}
+
+	changedAgain, err := LoadSettings(nil, env(nil), "config.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	changedAgain.Service.DBConfig.DBInfo.Host = "mongo-2:27017"
+	changedAgain.Apps.Usage.FlushInterval = Duration(2 * time.Minute)
+	_, applied, restartRequired, err = again.Reload(changedAgain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"usage.flushInterval"}; !reflect.DeepEqual(restartRequired, expected) {
+		t.Errorf("Expected only %v to be reported again, got %v", expected, restartRequired)
+	}
+	if expected := []string{"appQuotas.defaultMaxApps", "logging.level", "security.acl.policies"}; !reflect.DeepEqual(applied, expected) {
+		t.Errorf("Expected the reverted live settings %v to be applied, got %v", expected, applied)
+	}
+}
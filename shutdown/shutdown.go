@@ -0,0 +1,97 @@
+// Package shutdown stops the service in ordered phases when it receives a termination signal.
+package shutdown
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/keitaroinc/goa"
+)
+
+// PhaseFunc runs a phase of the shutdown.
+type PhaseFunc func(ctx context.Context) error
+
+type phase struct {
+	name string
+	run  PhaseFunc
+}
+
+// Sequence runs the phases of the shutdown in the order they were added, logging every phase.
+type Sequence struct {
+	logger goa.LogAdapter
+	phases []*phase
+}
+
+// New creates an empty Sequence that logs to the logger.
+func New(logger goa.LogAdapter) *Sequence {
+	return &Sequence{logger: logger}
+}
+
+// Add adds a phase at the end of the sequence.
+func (s *Sequence) Add(name string, run PhaseFunc) {
+	s.phases = append(s.phases, &phase{name: name, run: run})
+}
+
+// Run runs all phases. A failed phase is logged and the next phases are still run, so that
+// the remaining resources are released.
+func (s *Sequence) Run(ctx context.Context) {
+	s.logger.Info("shutdown started", "phases", len(s.phases))
+	start := time.Now()
+	for _, p := range s.phases {
+		s.logger.Info("shutdown phase started", "phase", p.name)
+		phaseStart := time.Now()
+		if err := p.run(ctx); err != nil {
+			s.logger.Error("shutdown phase failed", "phase", p.name, "err", err, "duration", time.Since(phaseStart).String())
+			continue
+		}
+		s.logger.Info("shutdown phase completed", "phase", p.name, "duration", time.Since(phaseStart).String())
+	}
+	s.logger.Info("shutdown completed", "duration", time.Since(start).String())
+}
+
+// Signals returns a channel that receives the first of the signals the process gets.
+func Signals(signals ...os.Signal) <-chan os.Signal {
+	received := make(chan os.Signal, 1)
+	signal.Notify(received, signals...)
+	return received
+}
+
+// Delay returns a phase that waits for the duration, for example to give the load balancers time to
+// see that the service is not ready before the connections are closed.
+func Delay(d time.Duration) PhaseFunc {
+	return func(ctx context.Context) error {
+		select {
+		case <-time.After(d):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Drain returns a phase that stops the server from accepting connections and waits for the in-flight
+// requests to finish. The connections still open after the timeout are closed.
+func Drain(server *http.Server, timeout time.Duration) PhaseFunc {
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			server.Close()
+			return err
+		}
+		return nil
+	}
+}
+
+// Func returns a phase that calls the functions in order.
+func Func(fns ...func()) PhaseFunc {
+	return func(ctx context.Context) error {
+		for _, fn := range fns {
+			fn()
+		}
+		return nil
+	}
+}
@@ -0,0 +1,110 @@
+package shutdown
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keitaroinc/goa"
+)
+
+func TestSequence(t *testing.T) {
+	buf := &bytes.Buffer{}
+	sequence := New(goa.NewLogger(log.New(buf, "", 0)))
+
+	calls := []string{}
+	sequence.Add("first", Func(func() { calls = append(calls, "first") }))
+	sequence.Add("failing", func(ctx context.Context) error {
+		calls = append(calls, "failing")
+		return fmt.Errorf("gateway unreachable")
+	})
+	sequence.Add("last", Func(func() { calls = append(calls, "last-1") }, func() { calls = append(calls, "last-2") }))
+	sequence.Run(context.Background())
+
+	if strings.Join(calls, ",") != "first,failing,last-1,last-2" {
+		t.Fatalf("Expected all phases to run in order, got %v", calls)
+	}
+	if !strings.Contains(buf.String(), "gateway unreachable") {
+		t.Fatalf("Expected the failed phase to be logged, got %s", buf.String())
+	}
+}
+
+func TestDelay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := Delay(time.Hour)(ctx); err == nil {
+		t.Fatal("Expected the delay to end with the context")
+	}
+	if err := Delay(time.Millisecond)(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func serve(t *testing.T, handler http.HandlerFunc) (*http.Server, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &http.Server{Handler: handler}
+	go server.Serve(listener)
+	return server, "http://" + listener.Addr().String()
+}
+
+func TestDrain(t *testing.T) {
+	started := make(chan struct{})
+	server, url := serve(t, func(rw http.ResponseWriter, req *http.Request) {
+		close(started)
+		time.Sleep(50 * time.Millisecond)
+		rw.WriteHeader(http.StatusOK)
+	})
+
+	result := make(chan error, 1)
+	go func() {
+		resp, err := http.Get(url)
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				err = fmt.Errorf("unexpected status %d", resp.StatusCode)
+			}
+		}
+		result <- err
+	}()
+	<-started
+
+	if err := Drain(server, time.Second)(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-result; err != nil {
+		t.Fatalf("Expected the in-flight request to finish, got %v", err)
+	}
+	if _, err := http.Get(url); err == nil {
+		t.Fatal("Expected no new connections after draining")
+	}
+}
+
+func TestDrainTimeout(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	server, url := serve(t, func(rw http.ResponseWriter, req *http.Request) {
+		close(started)
+		<-release
+	})
+
+	go func() {
+		if resp, err := http.Get(url); err == nil {
+			resp.Body.Close()
+		}
+	}()
+	<-started
+
+	if err := Drain(server, 20*time.Millisecond)(context.Background()); err == nil {
+		t.Fatal("Expected the drain to time out")
+	}
+}
@@ -29,12 +29,84 @@ import (
 )
 
 type (
+	// BulkAppsAppsCommand is the command line data structure for the bulkApps action of apps
+	BulkAppsAppsCommand struct {
+		Payload     string
+		ContentType string
+		// Key that makes the request safe to retry
+		IdempotencyKey string
+		PrettyPrint    bool
+	}
+
+	// CheckDomainVerificationAppsCommand is the command line data structure for the checkDomainVerification action of apps
+	CheckDomainVerificationAppsCommand struct {
+		// App ID
+		AppID string
+		// Where the token is published
+		Method      string
+		PrettyPrint bool
+	}
+
+	// CheckOriginAppsCommand is the command line data structure for the checkOrigin action of apps
+	CheckOriginAppsCommand struct {
+		// App ID
+		AppID string
+		// The origin, like https://app.example.com
+		Origin      string
+		PrettyPrint bool
+	}
+
 	// DeleteAppAppsCommand is the command line data structure for the deleteApp action of apps
 	DeleteAppAppsCommand struct {
 		AppID       string
 		PrettyPrint bool
 	}
 
+	// DeleteLogoAppsCommand is the command line data structure for the deleteLogo action of apps
+	DeleteLogoAppsCommand struct {
+		// App ID
+		AppID       string
+		PrettyPrint bool
+	}
+
+	// DeleteRateLimitAppsCommand is the command line data structure for the deleteRateLimit action of apps
+	DeleteRateLimitAppsCommand struct {
+		// App ID
+		AppID       string
+		PrettyPrint bool
+	}
+
+	// DeleteUserQuotaAppsCommand is the command line data structure for the deleteUserQuota action of apps
+	DeleteUserQuotaAppsCommand struct {
+		// User ID
+		UserID      string
+		PrettyPrint bool
+	}
+
+	// ExemptFromReapingAppsCommand is the command line data structure for the exemptFromReaping action of apps
+	ExemptFromReapingAppsCommand struct {
+		// App ID
+		AppID       string
+		PrettyPrint bool
+	}
+
+	// ExportAppsAppsCommand is the command line data structure for the exportApps action of apps
+	ExportAppsAppsCommand struct {
+		// Only the apps with the domain
+		Domain string
+		// Format of the export
+		Format string
+		// Only the apps of the user
+		Owner string
+		// Only the apps registered on or after the day (YYYY-MM-DD)
+		RegisteredFrom string
+		// Only the apps registered on or before the day (YYYY-MM-DD)
+		RegisteredTo string
+		// Only the apps with the status. Deleted apps are listed only with status=deleted.
+		Status      string
+		PrettyPrint bool
+	}
+
 	// GetAppsCommand is the command line data structure for the get action of apps
 	GetAppsCommand struct {
 		// App ID
@@ -42,18 +114,98 @@ type (
 		PrettyPrint bool
 	}
 
+	// GetLogoAppsCommand is the command line data structure for the getLogo action of apps
+	GetLogoAppsCommand struct {
+		// App ID
+		AppID string
+		// The original logo or the normalized thumbnail
+		Size string
+		// ETag of the cached logo
+		IfNoneMatch string
+		PrettyPrint bool
+	}
+
 	// GetMyAppsAppsCommand is the command line data structure for the getMyApps action of apps
 	GetMyAppsAppsCommand struct {
+		// Label selector, like env=prod,team in (payments,risk)
+		Selector    string
+		PrettyPrint bool
+	}
+
+	// GetPublicAppsCommand is the command line data structure for the getPublic action of apps
+	GetPublicAppsCommand struct {
+		// App ID
+		AppID       string
+		PrettyPrint bool
+	}
+
+	// GetRateLimitAppsCommand is the command line data structure for the getRateLimit action of apps
+	GetRateLimitAppsCommand struct {
+		// App ID
+		AppID       string
+		PrettyPrint bool
+	}
+
+	// GetReapingCandidatesAppsCommand is the command line data structure for the getReapingCandidates action of apps
+	GetReapingCandidatesAppsCommand struct {
+		PrettyPrint bool
+	}
+
+	// GetUsageAppsCommand is the command line data structure for the getUsage action of apps
+	GetUsageAppsCommand struct {
+		// App ID
+		AppID string
+		// First day of the time series (YYYY-MM-DD). Defaults to 30 days ago.
+		From string
+		// Last day of the time series (YYYY-MM-DD). Defaults to today.
+		To          string
 		PrettyPrint bool
 	}
 
 	// GetUserAppsAppsCommand is the command line data structure for the getUserApps action of apps
 	GetUserAppsAppsCommand struct {
+		// User ID
+		UserID string
+		// Label selector, like env=prod,team in (payments,risk)
+		Selector    string
+		PrettyPrint bool
+	}
+
+	// GetUserQuotaAppsCommand is the command line data structure for the getUserQuota action of apps
+	GetUserQuotaAppsCommand struct {
 		// User ID
 		UserID      string
 		PrettyPrint bool
 	}
 
+	// IssueDomainVerificationAppsCommand is the command line data structure for the issueDomainVerification action of apps
+	IssueDomainVerificationAppsCommand struct {
+		// App ID
+		AppID string
+		// Key that makes the request safe to retry
+		IdempotencyKey string
+		PrettyPrint    bool
+	}
+
+	// ListAppsAppsCommand is the command line data structure for the listApps action of apps
+	ListAppsAppsCommand struct {
+		// Only the apps with the domain
+		Domain string
+		// Only the apps of the user
+		Owner string
+		// Number of the page, starting from 1
+		Page int
+		// Maximal number of apps on a page
+		PageSize int
+		// Only the apps registered on or after the day (YYYY-MM-DD)
+		RegisteredFrom string
+		// Only the apps registered on or before the day (YYYY-MM-DD)
+		RegisteredTo string
+		// Only the apps with the status. Deleted apps are listed only with status=deleted.
+		Status      string
+		PrettyPrint bool
+	}
+
 	// RegenerateClientSecretAppsCommand is the command line data structure for the regenerateClientSecret action of apps
 	RegenerateClientSecretAppsCommand struct {
 		AppID       string
@@ -64,6 +216,50 @@ type (
 	RegisterAppAppsCommand struct {
 		Payload     string
 		ContentType string
+		// Key that makes the request safe to retry
+		IdempotencyKey string
+		PrettyPrint    bool
+	}
+
+	// RemoveReapingExemptionAppsCommand is the command line data structure for the removeReapingExemption action of apps
+	RemoveReapingExemptionAppsCommand struct {
+		// App ID
+		AppID       string
+		PrettyPrint bool
+	}
+
+	// SearchAppsAppsCommand is the command line data structure for the searchApps action of apps
+	SearchAppsAppsCommand struct {
+		// Maximal number of apps to return
+		Limit int
+		// Only apps of this user
+		Owner string
+		// Words to search for in the name, description, domain and labels of the apps
+		Q string
+		// Only apps registered on or after this day (YYYY-MM-DD)
+		RegisteredFrom string
+		// Only apps registered on or before this day (YYYY-MM-DD)
+		RegisteredTo string
+		// Only apps with this status. Deleted apps are found only with status=deleted.
+		Status      string
+		PrettyPrint bool
+	}
+
+	// SetRateLimitAppsCommand is the command line data structure for the setRateLimit action of apps
+	SetRateLimitAppsCommand struct {
+		Payload     string
+		ContentType string
+		// App ID
+		AppID       string
+		PrettyPrint bool
+	}
+
+	// SetUserQuotaAppsCommand is the command line data structure for the setUserQuota action of apps
+	SetUserQuotaAppsCommand struct {
+		Payload     string
+		ContentType string
+		// User ID
+		UserID      string
 		PrettyPrint bool
 	}
 
@@ -75,6 +271,13 @@ type (
 		PrettyPrint bool
 	}
 
+	// UploadLogoAppsCommand is the command line data structure for the uploadLogo action of apps
+	UploadLogoAppsCommand struct {
+		// App ID
+		AppID       string
+		PrettyPrint bool
+	}
+
 	// VerifyAppAppsCommand is the command line data structure for the verifyApp action of apps
 	VerifyAppAppsCommand struct {
 		Payload     string
@@ -93,26 +296,52 @@ type (
 func RegisterCommands(app *cobra.Command, c *client.Client) {
 	var command, sub *cobra.Command
 	command = &cobra.Command{
-		Use:   "delete-app",
-		Short: `Delete an app`,
+		Use:   "bulk-apps",
+		Short: `Apply an operation to many apps at once, selected by their IDs or by a filter. Admin only.`,
 	}
-	tmp1 := new(DeleteAppAppsCommand)
+	tmp1 := new(BulkAppsAppsCommand)
 	sub = &cobra.Command{
-		Use:   `apps ["/apps/APPID"]`,
+		Use:   `apps ["/apps/bulk"]`,
 		Short: ``,
-		RunE:  func(cmd *cobra.Command, args []string) error { return tmp1.Run(c, args) },
+		Long: `
+
+Payload example:
+
+{
+   "dryRun": true,
+   "filter": {
+      "domain": "Deserunt odit corrupti tempora.",
+      "owner": "Provident molestiae harum.",
+      "registeredFrom": "9964-06-05",
+      "registeredTo": "5160-01-17",
+      "status": "deleted"
+   },
+   "ids": [
+      "Officia beatae dicta."
+   ],
+   "labels": {
+      "Quaerat et officia aperiam laboriosam.": "Recusandae quisquam aut dolorum.",
+      "Similique veniam voluptates aspernatur beatae.": "Quam ipsa laborum sed.",
+      "Vel reprehenderit maiores.": "Explicabo perferendis."
+   },
+   "operation": "reactivate",
+   "removeLabels": [
+      "Odit repellat enim voluptate."
+   ]
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp1.Run(c, args) },
 	}
 	tmp1.RegisterFlags(sub, c)
 	sub.PersistentFlags().BoolVar(&tmp1.PrettyPrint, "pp", false, "Pretty print response body")
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "get",
-		Short: `Get app by id`,
+		Use:   "check-domain-verification",
+		Short: `Check the published verification token and mark the app domain as verified`,
 	}
-	tmp2 := new(GetAppsCommand)
+	tmp2 := new(CheckDomainVerificationAppsCommand)
 	sub = &cobra.Command{
-		Use:   `apps ["/apps/APPID"]`,
+		Use:   `apps ["/apps/APPID/domain-verification"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp2.Run(c, args) },
 	}
@@ -121,12 +350,12 @@ func RegisterCommands(app *cobra.Command, c *client.Client) {
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "get-my-apps",
-		Short: `Get all user's apps`,
+		Use:   "check-origin",
+		Short: `Check if an origin is allowed for an app, for CORS decisions`,
 	}
-	tmp3 := new(GetMyAppsAppsCommand)
+	tmp3 := new(CheckOriginAppsCommand)
 	sub = &cobra.Command{
-		Use:   `apps ["/apps/my"]`,
+		Use:   `apps ["/apps/APPID/origins/check"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp3.Run(c, args) },
 	}
@@ -135,12 +364,12 @@ func RegisterCommands(app *cobra.Command, c *client.Client) {
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "get-user-apps",
-		Short: `Get app by id`,
+		Use:   "delete-app",
+		Short: `Delete an app`,
 	}
-	tmp4 := new(GetUserAppsAppsCommand)
+	tmp4 := new(DeleteAppAppsCommand)
 	sub = &cobra.Command{
-		Use:   `apps ["/apps/users/USERID/all"]`,
+		Use:   `apps ["/apps/APPID"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp4.Run(c, args) },
 	}
@@ -149,12 +378,12 @@ func RegisterCommands(app *cobra.Command, c *client.Client) {
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "regenerate-client-secret",
-		Short: `Regenerate client secret`,
+		Use:   "delete-logo",
+		Short: `Delete the logo of an app`,
 	}
-	tmp5 := new(RegenerateClientSecretAppsCommand)
+	tmp5 := new(DeleteLogoAppsCommand)
 	sub = &cobra.Command{
-		Use:   `apps ["/apps/APPID/regenerate-secret"]`,
+		Use:   `apps ["/apps/APPID/logo"]`,
 		Short: ``,
 		RunE:  func(cmd *cobra.Command, args []string) error { return tmp5.Run(c, args) },
 	}
@@ -163,158 +392,508 @@ func RegisterCommands(app *cobra.Command, c *client.Client) {
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "register-app",
-		Short: `Register new app`,
+		Use:   "delete-rate-limit",
+		Short: `Remove the rate limit and quota policy of an app, so the default policy applies. Admin only.`,
 	}
-	tmp6 := new(RegisterAppAppsCommand)
+	tmp6 := new(DeleteRateLimitAppsCommand)
 	sub = &cobra.Command{
-		Use:   `apps ["/apps"]`,
+		Use:   `apps ["/apps/APPID/rate-limit"]`,
 		Short: ``,
-		Long: `
-
-Payload example:
-
-{
-   "description": "dquu7sxd1b",
-   "domain": "Mollitia et quasi esse voluptate.",
-   "name": "zzr28p88rb"
-}`,
-		RunE: func(cmd *cobra.Command, args []string) error { return tmp6.Run(c, args) },
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp6.Run(c, args) },
 	}
 	tmp6.RegisterFlags(sub, c)
 	sub.PersistentFlags().BoolVar(&tmp6.PrettyPrint, "pp", false, "Pretty print response body")
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "update-app",
-		Short: `Register new app`,
+		Use:   "delete-user-quota",
+		Short: `Remove the override of the maximal number of apps for a user, so the default applies. Admin only.`,
 	}
-	tmp7 := new(UpdateAppAppsCommand)
+	tmp7 := new(DeleteUserQuotaAppsCommand)
 	sub = &cobra.Command{
-		Use:   `apps ["/apps/APPID"]`,
+		Use:   `apps ["/apps/users/USERID/quota"]`,
 		Short: ``,
-		Long: `
-
-Payload example:
-
-{
-   "description": "dquu7sxd1b",
-   "domain": "Mollitia et quasi esse voluptate.",
-   "name": "zzr28p88rb"
-}`,
-		RunE: func(cmd *cobra.Command, args []string) error { return tmp7.Run(c, args) },
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp7.Run(c, args) },
 	}
 	tmp7.RegisterFlags(sub, c)
 	sub.PersistentFlags().BoolVar(&tmp7.PrettyPrint, "pp", false, "Pretty print response body")
 	command.AddCommand(sub)
 	app.AddCommand(command)
 	command = &cobra.Command{
-		Use:   "verify-app",
-		Short: `Verify an application by its ID and secret`,
+		Use:   "exempt-from-reaping",
+		Short: `Exempt an app from the reaping job and restore it if it was suspended or deleted. Admin only.`,
 	}
-	tmp8 := new(VerifyAppAppsCommand)
+	tmp8 := new(ExemptFromReapingAppsCommand)
 	sub = &cobra.Command{
-		Use:   `apps ["/apps/verify"]`,
+		Use:   `apps ["/apps/APPID/reaping-exemption"]`,
 		Short: ``,
-		Long: `
-
-Payload example:
-
-{
-   "id": "Itaque magnam consequatur doloribus.",
-   "secret": "Inventore est."
-}`,
-		RunE: func(cmd *cobra.Command, args []string) error { return tmp8.Run(c, args) },
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp8.Run(c, args) },
 	}
 	tmp8.RegisterFlags(sub, c)
 	sub.PersistentFlags().BoolVar(&tmp8.PrettyPrint, "pp", false, "Pretty print response body")
 	command.AddCommand(sub)
 	app.AddCommand(command)
-
-	dl := new(DownloadCommand)
-	dlc := &cobra.Command{
-		Use:   "download [PATH]",
-		Short: "Download file with given path",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			return dl.Run(c, args)
-		},
+	command = &cobra.Command{
+		Use:   "export-apps",
+		Short: `Export all apps of all users that match the filters as a CSV or a JSON file. Admin only.`,
 	}
-	dlc.Flags().StringVar(&dl.OutFile, "out", "", "Output file")
-	app.AddCommand(dlc)
-}
-
-func intFlagVal(name string, parsed int) *int {
-	if hasFlag(name) {
-		return &parsed
+	tmp9 := new(ExportAppsAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/all/export"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp9.Run(c, args) },
 	}
-	return nil
-}
-
-func float64FlagVal(name string, parsed float64) *float64 {
-	if hasFlag(name) {
-		return &parsed
+	tmp9.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp9.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "get",
+		Short: `Get app by id`,
 	}
-	return nil
-}
-
-func boolFlagVal(name string, parsed bool) *bool {
-	if hasFlag(name) {
-		return &parsed
+	tmp10 := new(GetAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/APPID"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp10.Run(c, args) },
 	}
-	return nil
-}
-
-func stringFlagVal(name string, parsed string) *string {
-	if hasFlag(name) {
-		return &parsed
+	tmp10.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp10.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "get-logo",
+		Short: `Get the logo of an app or its thumbnail`,
 	}
-	return nil
-}
-
-func hasFlag(name string) bool {
-	for _, arg := range os.Args[1:] {
-		if strings.HasPrefix(arg, "--"+name) {
-			return true
-		}
+	tmp11 := new(GetLogoAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/APPID/logo"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp11.Run(c, args) },
 	}
-	return false
-}
-
-func jsonVal(val string) (*interface{}, error) {
-	var t interface{}
-	err := json.Unmarshal([]byte(val), &t)
-	if err != nil {
-		return nil, err
+	tmp11.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp11.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "get-my-apps",
+		Short: `Get all user's apps`,
 	}
-	return &t, nil
-}
-
-func jsonArray(ins []string) ([]interface{}, error) {
-	if ins == nil {
-		return nil, nil
+	tmp12 := new(GetMyAppsAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/my"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp12.Run(c, args) },
 	}
-	var vals []interface{}
-	for _, id := range ins {
-		val, err := jsonVal(id)
-		if err != nil {
-			return nil, err
-		}
-		vals = append(vals, val)
+	tmp12.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp12.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "get-public",
+		Short: `Get the display-safe fields of an app for consent screens. Does not require authentication.`,
 	}
-	return vals, nil
-}
-
-func timeVal(val string) (*time.Time, error) {
-	t, err := time.Parse(time.RFC3339, val)
-	if err != nil {
-		return nil, err
+	tmp13 := new(GetPublicAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/APPID/public"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp13.Run(c, args) },
 	}
-	return &t, nil
-}
-
-func timeArray(ins []string) ([]time.Time, error) {
-	if ins == nil {
-		return nil, nil
+	tmp13.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp13.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "get-rate-limit",
+		Short: `Get the effective rate limit and quota policy for an app`,
+	}
+	tmp14 := new(GetRateLimitAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/APPID/rate-limit"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp14.Run(c, args) },
+	}
+	tmp14.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp14.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "get-reaping-candidates",
+		Short: `Get the unused apps and what the next run of the reaping job would do with them. Admin only.`,
+	}
+	tmp15 := new(GetReapingCandidatesAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/reaping/candidates"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp15.Run(c, args) },
+	}
+	tmp15.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp15.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "get-usage",
+		Short: `Get the daily verification counts and the last use of an app`,
+	}
+	tmp16 := new(GetUsageAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/APPID/usage"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp16.Run(c, args) },
+	}
+	tmp16.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp16.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "get-user-apps",
+		Short: `Get app by id`,
+	}
+	tmp17 := new(GetUserAppsAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/users/USERID/all"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp17.Run(c, args) },
+	}
+	tmp17.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp17.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "get-user-quota",
+		Short: `Get the maximal and the current number of apps for a user. Admin only.`,
+	}
+	tmp18 := new(GetUserQuotaAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/users/USERID/quota"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp18.Run(c, args) },
+	}
+	tmp18.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp18.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "issue-domain-verification",
+		Short: `Start the verification of the app domain. Returns the token to publish in a DNS TXT record or in a file under /.well-known/ on the domain.`,
+	}
+	tmp19 := new(IssueDomainVerificationAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/APPID/domain-verification"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp19.Run(c, args) },
+	}
+	tmp19.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp19.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "list-apps",
+		Short: `List the apps of all users, ordered by the time of registration. Admin only.`,
+	}
+	tmp20 := new(ListAppsAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/all"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp20.Run(c, args) },
+	}
+	tmp20.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp20.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "regenerate-client-secret",
+		Short: `Regenerate client secret`,
+	}
+	tmp21 := new(RegenerateClientSecretAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/APPID/regenerate-secret"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp21.Run(c, args) },
+	}
+	tmp21.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp21.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "register-app",
+		Short: `Register new app`,
+	}
+	tmp22 := new(RegisterAppAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps"]`,
+		Short: ``,
+		Long: `
+
+Payload example:
+
+{
+   "annotations": {
+      "A sint.": "Temporibus modi."
+   },
+   "contacts": [
+      "Quae eum."
+   ],
+   "description": "dquu7sxd1b",
+   "domain": "Mollitia et quasi esse voluptate.",
+   "labels": {
+      "Corrupti possimus iusto dolorum sequi.": "Aliquam id est.",
+      "Doloremque incidunt in.": "Doloremque quis cumque sapiente.",
+      "Voluptates nemo earum quo incidunt.": "Ipsa necessitatibus dolor suscipit."
+   },
+   "name": "zzr28p88rb",
+   "origins": [
+      "Impedit ipsa voluptate vel.",
+      "Impedit ipsa voluptate vel.",
+      "Impedit ipsa voluptate vel."
+   ],
+   "privacyPolicyUrl": "Doloremque ut.",
+   "supportEmail": "Quae nobis optio eveniet ex.",
+   "termsOfServiceUrl": "Impedit aut."
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp22.Run(c, args) },
+	}
+	tmp22.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp22.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "remove-reaping-exemption",
+		Short: `Remove the reaping exemption of an app. Admin only.`,
+	}
+	tmp23 := new(RemoveReapingExemptionAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/APPID/reaping-exemption"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp23.Run(c, args) },
+	}
+	tmp23.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp23.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "search-apps",
+		Short: `Search the apps by name, description, domain and labels. Admin only.`,
+	}
+	tmp24 := new(SearchAppsAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/search"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp24.Run(c, args) },
+	}
+	tmp24.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp24.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "set-rate-limit",
+		Short: `Set the rate limit and quota policy for an app. Admin only.`,
+	}
+	tmp25 := new(SetRateLimitAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/APPID/rate-limit"]`,
+		Short: ``,
+		Long: `
+
+Payload example:
+
+{
+   "burst": 7118806668375672065,
+   "monthlyQuota": 3655989645915619371,
+   "requestsPerDay": 7398109853329702519,
+   "requestsPerMinute": 7172729909116251696,
+   "requestsPerSecond": 4457768732193324882
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp25.Run(c, args) },
+	}
+	tmp25.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp25.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "set-user-quota",
+		Short: `Override the maximal number of apps for a user. Admin only.`,
+	}
+	tmp26 := new(SetUserQuotaAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/users/USERID/quota"]`,
+		Short: ``,
+		Long: `
+
+Payload example:
+
+{
+   "maxApps": 6451199122788889683
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp26.Run(c, args) },
+	}
+	tmp26.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp26.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "update-app",
+		Short: `Register new app`,
+	}
+	tmp27 := new(UpdateAppAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/APPID"]`,
+		Short: ``,
+		Long: `
+
+Payload example:
+
+{
+   "annotations": {
+      "A sint.": "Temporibus modi."
+   },
+   "contacts": [
+      "Quae eum."
+   ],
+   "description": "dquu7sxd1b",
+   "domain": "Mollitia et quasi esse voluptate.",
+   "labels": {
+      "Corrupti possimus iusto dolorum sequi.": "Aliquam id est.",
+      "Doloremque incidunt in.": "Doloremque quis cumque sapiente.",
+      "Voluptates nemo earum quo incidunt.": "Ipsa necessitatibus dolor suscipit."
+   },
+   "name": "zzr28p88rb",
+   "origins": [
+      "Impedit ipsa voluptate vel.",
+      "Impedit ipsa voluptate vel.",
+      "Impedit ipsa voluptate vel."
+   ],
+   "privacyPolicyUrl": "Doloremque ut.",
+   "supportEmail": "Quae nobis optio eveniet ex.",
+   "termsOfServiceUrl": "Impedit aut."
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp27.Run(c, args) },
+	}
+	tmp27.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp27.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "upload-logo",
+		Short: `Upload the logo of an app as the "logo" file of a multipart/form-data request. PNG, JPEG and GIF images are accepted.`,
+	}
+	tmp28 := new(UploadLogoAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/APPID/logo"]`,
+		Short: ``,
+		RunE:  func(cmd *cobra.Command, args []string) error { return tmp28.Run(c, args) },
+	}
+	tmp28.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp28.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+	command = &cobra.Command{
+		Use:   "verify-app",
+		Short: `Verify an application by its ID and secret`,
+	}
+	tmp29 := new(VerifyAppAppsCommand)
+	sub = &cobra.Command{
+		Use:   `apps ["/apps/verify"]`,
+		Short: ``,
+		Long: `
+
+Payload example:
+
+{
+   "id": "Itaque magnam consequatur doloribus.",
+   "secret": "Inventore est."
+}`,
+		RunE: func(cmd *cobra.Command, args []string) error { return tmp29.Run(c, args) },
+	}
+	tmp29.RegisterFlags(sub, c)
+	sub.PersistentFlags().BoolVar(&tmp29.PrettyPrint, "pp", false, "Pretty print response body")
+	command.AddCommand(sub)
+	app.AddCommand(command)
+
+	dl := new(DownloadCommand)
+	dlc := &cobra.Command{
+		Use:   "download [PATH]",
+		Short: "Download file with given path",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return dl.Run(c, args)
+		},
+	}
+	dlc.Flags().StringVar(&dl.OutFile, "out", "", "Output file")
+	app.AddCommand(dlc)
+}
+
+func intFlagVal(name string, parsed int) *int {
+	if hasFlag(name) {
+		return &parsed
+	}
+	return nil
+}
+
+func float64FlagVal(name string, parsed float64) *float64 {
+	if hasFlag(name) {
+		return &parsed
+	}
+	return nil
+}
+
+func boolFlagVal(name string, parsed bool) *bool {
+	if hasFlag(name) {
+		return &parsed
+	}
+	return nil
+}
+
+func stringFlagVal(name string, parsed string) *string {
+	if hasFlag(name) {
+		return &parsed
+	}
+	return nil
+}
+
+func hasFlag(name string) bool {
+	for _, arg := range os.Args[1:] {
+		if strings.HasPrefix(arg, "--"+name) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonVal(val string) (*interface{}, error) {
+	var t interface{}
+	err := json.Unmarshal([]byte(val), &t)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func jsonArray(ins []string) ([]interface{}, error) {
+	if ins == nil {
+		return nil, nil
+	}
+	var vals []interface{}
+	for _, id := range ins {
+		val, err := jsonVal(id)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
+
+func timeVal(val string) (*time.Time, error) {
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func timeArray(ins []string) ([]time.Time, error) {
+	if ins == nil {
+		return nil, nil
 	}
 	var vals []time.Time
 	for _, id := range ins {
@@ -324,136 +903,696 @@ func timeArray(ins []string) ([]time.Time, error) {
 		}
 		vals = append(vals, *val)
 	}
-	return vals, nil
+	return vals, nil
+}
+
+func uuidVal(val string) (*uuid.UUID, error) {
+	t, err := uuid.FromString(val)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func uuidArray(ins []string) ([]uuid.UUID, error) {
+	if ins == nil {
+		return nil, nil
+	}
+	var vals []uuid.UUID
+	for _, id := range ins {
+		val, err := uuidVal(id)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, *val)
+	}
+	return vals, nil
+}
+
+func float64Val(val string) (*float64, error) {
+	t, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func float64Array(ins []string) ([]float64, error) {
+	if ins == nil {
+		return nil, nil
+	}
+	var vals []float64
+	for _, id := range ins {
+		val, err := float64Val(id)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, *val)
+	}
+	return vals, nil
+}
+
+func boolVal(val string) (*bool, error) {
+	t, err := strconv.ParseBool(val)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func boolArray(ins []string) ([]bool, error) {
+	if ins == nil {
+		return nil, nil
+	}
+	var vals []bool
+	for _, id := range ins {
+		val, err := boolVal(id)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, *val)
+	}
+	return vals, nil
+}
+
+// Run downloads files with given paths.
+func (cmd *DownloadCommand) Run(c *client.Client, args []string) error {
+	var (
+		fnf func(context.Context, string) (int64, error)
+		fnd func(context.Context, string, string) (int64, error)
+
+		rpath   = args[0]
+		outfile = cmd.OutFile
+		logger  = goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+		ctx     = goa.WithLogger(context.Background(), logger)
+		err     error
+	)
+
+	if rpath[0] != '/' {
+		rpath = "/" + rpath
+	}
+	if rpath == "/swagger.json" {
+		fnf = c.DownloadSwaggerJSON
+		if outfile == "" {
+			outfile = "swagger.json"
+		}
+		goto found
+	}
+	if strings.HasPrefix(rpath, "/swagger-ui/") {
+		fnd = c.DownloadSwaggerUI
+		rpath = rpath[12:]
+		if outfile == "" {
+			_, outfile = path.Split(rpath)
+		}
+		goto found
+	}
+	return fmt.Errorf("don't know how to download %s", rpath)
+found:
+	ctx = goa.WithLogContext(ctx, "file", outfile)
+	if fnf != nil {
+		_, err = fnf(ctx, outfile)
+	} else {
+		_, err = fnd(ctx, rpath, outfile)
+	}
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// Run makes the HTTP request corresponding to the BulkAppsAppsCommand command.
+func (cmd *BulkAppsAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/apps/bulk"
+	}
+	var payload client.BulkPayload
+	if cmd.Payload != "" {
+		err := json.Unmarshal([]byte(cmd.Payload), &payload)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize payload: %s", err)
+		}
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.BulkAppsApps(ctx, path, &payload, stringFlagVal("Idempotency-Key", cmd.IdempotencyKey), cmd.ContentType)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *BulkAppsAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	cc.Flags().StringVar(&cmd.Payload, "payload", "", "Request body encoded in JSON")
+	cc.Flags().StringVar(&cmd.ContentType, "content", "", "Request content type override, e.g. 'application/x-www-form-urlencoded'")
+	var idempotencyKey string
+	cc.Flags().StringVar(&cmd.IdempotencyKey, "Idempotency-Key", idempotencyKey, `Key that makes the request safe to retry`)
+}
+
+// Run makes the HTTP request corresponding to the CheckDomainVerificationAppsCommand command.
+func (cmd *CheckDomainVerificationAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v/domain-verification", url.QueryEscape(cmd.AppID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.CheckDomainVerificationApps(ctx, path, stringFlagVal("method", cmd.Method))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *CheckDomainVerificationAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+	cc.Flags().StringVar(&cmd.Method, "method", "dns", `Where the token is published`)
+}
+
+// Run makes the HTTP request corresponding to the CheckOriginAppsCommand command.
+func (cmd *CheckOriginAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v/origins/check", url.QueryEscape(cmd.AppID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.CheckOriginApps(ctx, path, cmd.Origin)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *CheckOriginAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+	var origin string
+	cc.Flags().StringVar(&cmd.Origin, "origin", origin, `The origin, like https://app.example.com`)
+}
+
+// Run makes the HTTP request corresponding to the DeleteAppAppsCommand command.
+func (cmd *DeleteAppAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v", url.QueryEscape(cmd.AppID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.DeleteAppApps(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *DeleteAppAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, ``)
+}
+
+// Run makes the HTTP request corresponding to the DeleteLogoAppsCommand command.
+func (cmd *DeleteLogoAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v/logo", url.QueryEscape(cmd.AppID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.DeleteLogoApps(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *DeleteLogoAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+}
+
+// Run makes the HTTP request corresponding to the DeleteRateLimitAppsCommand command.
+func (cmd *DeleteRateLimitAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v/rate-limit", url.QueryEscape(cmd.AppID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.DeleteRateLimitApps(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *DeleteRateLimitAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+}
+
+// Run makes the HTTP request corresponding to the DeleteUserQuotaAppsCommand command.
+func (cmd *DeleteUserQuotaAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/users/%v/quota", url.QueryEscape(cmd.UserID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.DeleteUserQuotaApps(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *DeleteUserQuotaAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var userID string
+	cc.Flags().StringVar(&cmd.UserID, "userId", userID, `User ID`)
+}
+
+// Run makes the HTTP request corresponding to the ExemptFromReapingAppsCommand command.
+func (cmd *ExemptFromReapingAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v/reaping-exemption", url.QueryEscape(cmd.AppID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ExemptFromReapingApps(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ExemptFromReapingAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+}
+
+// Run makes the HTTP request corresponding to the ExportAppsAppsCommand command.
+func (cmd *ExportAppsAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/apps/all/export"
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ExportAppsApps(ctx, path, stringFlagVal("domain", cmd.Domain), stringFlagVal("format", cmd.Format), stringFlagVal("owner", cmd.Owner), stringFlagVal("registeredFrom", cmd.RegisteredFrom), stringFlagVal("registeredTo", cmd.RegisteredTo), stringFlagVal("status", cmd.Status))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ExportAppsAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var domain string
+	cc.Flags().StringVar(&cmd.Domain, "domain", domain, `Only the apps with the domain`)
+	cc.Flags().StringVar(&cmd.Format, "format", "csv", `Format of the export`)
+	var owner string
+	cc.Flags().StringVar(&cmd.Owner, "owner", owner, `Only the apps of the user`)
+	var registeredFrom string
+	cc.Flags().StringVar(&cmd.RegisteredFrom, "registeredFrom", registeredFrom, `Only the apps registered on or after the day (YYYY-MM-DD)`)
+	var registeredTo string
+	cc.Flags().StringVar(&cmd.RegisteredTo, "registeredTo", registeredTo, `Only the apps registered on or before the day (YYYY-MM-DD)`)
+	var status string
+	cc.Flags().StringVar(&cmd.Status, "status", status, `Only the apps with the status. Deleted apps are listed only with status=deleted.`)
+}
+
+// Run makes the HTTP request corresponding to the GetAppsCommand command.
+func (cmd *GetAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v", url.QueryEscape(cmd.AppID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetApps(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+}
+
+// Run makes the HTTP request corresponding to the GetLogoAppsCommand command.
+func (cmd *GetLogoAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v/logo", url.QueryEscape(cmd.AppID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetLogoApps(ctx, path, stringFlagVal("size", cmd.Size), stringFlagVal("If-None-Match", cmd.IfNoneMatch))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetLogoAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+	cc.Flags().StringVar(&cmd.Size, "size", "original", `The original logo or the normalized thumbnail`)
+	var ifNoneMatch string
+	cc.Flags().StringVar(&cmd.IfNoneMatch, "If-None-Match", ifNoneMatch, `ETag of the cached logo`)
+}
+
+// Run makes the HTTP request corresponding to the GetMyAppsAppsCommand command.
+func (cmd *GetMyAppsAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/apps/my"
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetMyAppsApps(ctx, path, stringFlagVal("selector", cmd.Selector))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetMyAppsAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var selector string
+	cc.Flags().StringVar(&cmd.Selector, "selector", selector, `Label selector, like env=prod,team in (payments,risk)`)
+}
+
+// Run makes the HTTP request corresponding to the GetPublicAppsCommand command.
+func (cmd *GetPublicAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v/public", url.QueryEscape(cmd.AppID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetPublicApps(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetPublicAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+}
+
+// Run makes the HTTP request corresponding to the GetRateLimitAppsCommand command.
+func (cmd *GetRateLimitAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v/rate-limit", url.QueryEscape(cmd.AppID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetRateLimitApps(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetRateLimitAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+}
+
+// Run makes the HTTP request corresponding to the GetReapingCandidatesAppsCommand command.
+func (cmd *GetReapingCandidatesAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = "/apps/reaping/candidates"
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetReapingCandidatesApps(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
 }
 
-func uuidVal(val string) (*uuid.UUID, error) {
-	t, err := uuid.FromString(val)
-	if err != nil {
-		return nil, err
-	}
-	return &t, nil
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetReapingCandidatesAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 }
 
-func uuidArray(ins []string) ([]uuid.UUID, error) {
-	if ins == nil {
-		return nil, nil
+// Run makes the HTTP request corresponding to the GetUsageAppsCommand command.
+func (cmd *GetUsageAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v/usage", url.QueryEscape(cmd.AppID))
 	}
-	var vals []uuid.UUID
-	for _, id := range ins {
-		val, err := uuidVal(id)
-		if err != nil {
-			return nil, err
-		}
-		vals = append(vals, *val)
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetUsageApps(ctx, path, stringFlagVal("from", cmd.From), stringFlagVal("to", cmd.To))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
 	}
-	return vals, nil
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
 }
 
-func float64Val(val string) (*float64, error) {
-	t, err := strconv.ParseFloat(val, 64)
-	if err != nil {
-		return nil, err
-	}
-	return &t, nil
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetUsageAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+	var from string
+	cc.Flags().StringVar(&cmd.From, "from", from, `First day of the time series (YYYY-MM-DD). Defaults to 30 days ago.`)
+	var to string
+	cc.Flags().StringVar(&cmd.To, "to", to, `Last day of the time series (YYYY-MM-DD). Defaults to today.`)
 }
 
-func float64Array(ins []string) ([]float64, error) {
-	if ins == nil {
-		return nil, nil
+// Run makes the HTTP request corresponding to the GetUserAppsAppsCommand command.
+func (cmd *GetUserAppsAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/users/%v/all", url.QueryEscape(cmd.UserID))
 	}
-	var vals []float64
-	for _, id := range ins {
-		val, err := float64Val(id)
-		if err != nil {
-			return nil, err
-		}
-		vals = append(vals, *val)
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetUserAppsApps(ctx, path, stringFlagVal("selector", cmd.Selector))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
 	}
-	return vals, nil
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
 }
 
-func boolVal(val string) (*bool, error) {
-	t, err := strconv.ParseBool(val)
-	if err != nil {
-		return nil, err
-	}
-	return &t, nil
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetUserAppsAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var userID string
+	cc.Flags().StringVar(&cmd.UserID, "userId", userID, `User ID`)
+	var selector string
+	cc.Flags().StringVar(&cmd.Selector, "selector", selector, `Label selector, like env=prod,team in (payments,risk)`)
 }
 
-func boolArray(ins []string) ([]bool, error) {
-	if ins == nil {
-		return nil, nil
+// Run makes the HTTP request corresponding to the GetUserQuotaAppsCommand command.
+func (cmd *GetUserQuotaAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/users/%v/quota", url.QueryEscape(cmd.UserID))
 	}
-	var vals []bool
-	for _, id := range ins {
-		val, err := boolVal(id)
-		if err != nil {
-			return nil, err
-		}
-		vals = append(vals, *val)
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.GetUserQuotaApps(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
 	}
-	return vals, nil
-}
 
-// Run downloads files with given paths.
-func (cmd *DownloadCommand) Run(c *client.Client, args []string) error {
-	var (
-		fnf func(context.Context, string) (int64, error)
-		fnd func(context.Context, string, string) (int64, error)
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
 
-		rpath   = args[0]
-		outfile = cmd.OutFile
-		logger  = goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
-		ctx     = goa.WithLogger(context.Background(), logger)
-		err     error
-	)
+// RegisterFlags registers the command flags with the command line.
+func (cmd *GetUserQuotaAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var userID string
+	cc.Flags().StringVar(&cmd.UserID, "userId", userID, `User ID`)
+}
 
-	if rpath[0] != '/' {
-		rpath = "/" + rpath
-	}
-	if rpath == "/swagger.json" {
-		fnf = c.DownloadSwaggerJSON
-		if outfile == "" {
-			outfile = "swagger.json"
-		}
-		goto found
+// Run makes the HTTP request corresponding to the IssueDomainVerificationAppsCommand command.
+func (cmd *IssueDomainVerificationAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v/domain-verification", url.QueryEscape(cmd.AppID))
 	}
-	if strings.HasPrefix(rpath, "/swagger-ui/") {
-		fnd = c.DownloadSwaggerUI
-		rpath = rpath[12:]
-		if outfile == "" {
-			_, outfile = path.Split(rpath)
-		}
-		goto found
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.IssueDomainVerificationApps(ctx, path, stringFlagVal("Idempotency-Key", cmd.IdempotencyKey))
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
 	}
-	return fmt.Errorf("don't know how to download %s", rpath)
-found:
-	ctx = goa.WithLogContext(ctx, "file", outfile)
-	if fnf != nil {
-		_, err = fnf(ctx, outfile)
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *IssueDomainVerificationAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+	var idempotencyKey string
+	cc.Flags().StringVar(&cmd.IdempotencyKey, "Idempotency-Key", idempotencyKey, `Key that makes the request safe to retry`)
+}
+
+// Run makes the HTTP request corresponding to the ListAppsAppsCommand command.
+func (cmd *ListAppsAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
 	} else {
-		_, err = fnd(ctx, rpath, outfile)
+		path = "/apps/all"
 	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.ListAppsApps(ctx, path, stringFlagVal("domain", cmd.Domain), stringFlagVal("owner", cmd.Owner), intFlagVal("page", cmd.Page), intFlagVal("pageSize", cmd.PageSize), stringFlagVal("registeredFrom", cmd.RegisteredFrom), stringFlagVal("registeredTo", cmd.RegisteredTo), stringFlagVal("status", cmd.Status))
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
 	}
 
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
 	return nil
 }
 
-// Run makes the HTTP request corresponding to the DeleteAppAppsCommand command.
-func (cmd *DeleteAppAppsCommand) Run(c *client.Client, args []string) error {
+// RegisterFlags registers the command flags with the command line.
+func (cmd *ListAppsAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var domain string
+	cc.Flags().StringVar(&cmd.Domain, "domain", domain, `Only the apps with the domain`)
+	var owner string
+	cc.Flags().StringVar(&cmd.Owner, "owner", owner, `Only the apps of the user`)
+	cc.Flags().IntVar(&cmd.Page, "page", 1, `Number of the page, starting from 1`)
+	cc.Flags().IntVar(&cmd.PageSize, "pageSize", 50, `Maximal number of apps on a page`)
+	var registeredFrom string
+	cc.Flags().StringVar(&cmd.RegisteredFrom, "registeredFrom", registeredFrom, `Only the apps registered on or after the day (YYYY-MM-DD)`)
+	var registeredTo string
+	cc.Flags().StringVar(&cmd.RegisteredTo, "registeredTo", registeredTo, `Only the apps registered on or before the day (YYYY-MM-DD)`)
+	var status string
+	cc.Flags().StringVar(&cmd.Status, "status", status, `Only the apps with the status. Deleted apps are listed only with status=deleted.`)
+}
+
+// Run makes the HTTP request corresponding to the RegenerateClientSecretAppsCommand command.
+func (cmd *RegenerateClientSecretAppsCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/apps/%v", url.QueryEscape(cmd.AppID))
+		path = fmt.Sprintf("/apps/%v/regenerate-secret", url.QueryEscape(cmd.AppID))
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.DeleteAppApps(ctx, path)
+	resp, err := c.RegenerateClientSecretApps(ctx, path)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -464,22 +1603,29 @@ func (cmd *DeleteAppAppsCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *DeleteAppAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *RegenerateClientSecretAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 	var appID string
 	cc.Flags().StringVar(&cmd.AppID, "appId", appID, ``)
 }
 
-// Run makes the HTTP request corresponding to the GetAppsCommand command.
-func (cmd *GetAppsCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the RegisterAppAppsCommand command.
+func (cmd *RegisterAppAppsCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/apps/%v", url.QueryEscape(cmd.AppID))
+		path = "/apps"
+	}
+	var payload client.AppPayload
+	if cmd.Payload != "" {
+		err := json.Unmarshal([]byte(cmd.Payload), &payload)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize payload: %s", err)
+		}
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.GetApps(ctx, path)
+	resp, err := c.RegisterAppApps(ctx, path, &payload, stringFlagVal("Idempotency-Key", cmd.IdempotencyKey), cmd.ContentType)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -490,22 +1636,24 @@ func (cmd *GetAppsCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *GetAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
-	var appID string
-	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+func (cmd *RegisterAppAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	cc.Flags().StringVar(&cmd.Payload, "payload", "", "Request body encoded in JSON")
+	cc.Flags().StringVar(&cmd.ContentType, "content", "", "Request content type override, e.g. 'application/x-www-form-urlencoded'")
+	var idempotencyKey string
+	cc.Flags().StringVar(&cmd.IdempotencyKey, "Idempotency-Key", idempotencyKey, `Key that makes the request safe to retry`)
 }
 
-// Run makes the HTTP request corresponding to the GetMyAppsAppsCommand command.
-func (cmd *GetMyAppsAppsCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the RemoveReapingExemptionAppsCommand command.
+func (cmd *RemoveReapingExemptionAppsCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = "/apps/my"
+		path = fmt.Sprintf("/apps/%v/reaping-exemption", url.QueryEscape(cmd.AppID))
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.GetMyAppsApps(ctx, path)
+	resp, err := c.RemoveReapingExemptionApps(ctx, path)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -516,20 +1664,22 @@ func (cmd *GetMyAppsAppsCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *GetMyAppsAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *RemoveReapingExemptionAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
 }
 
-// Run makes the HTTP request corresponding to the GetUserAppsAppsCommand command.
-func (cmd *GetUserAppsAppsCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the SearchAppsAppsCommand command.
+func (cmd *SearchAppsAppsCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/apps/users/%v/all", url.QueryEscape(cmd.UserID))
+		path = "/apps/search"
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.GetUserAppsApps(ctx, path)
+	resp, err := c.SearchAppsApps(ctx, path, intFlagVal("limit", cmd.Limit), stringFlagVal("owner", cmd.Owner), stringFlagVal("q", cmd.Q), stringFlagVal("registeredFrom", cmd.RegisteredFrom), stringFlagVal("registeredTo", cmd.RegisteredTo), stringFlagVal("status", cmd.Status))
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -540,22 +1690,38 @@ func (cmd *GetUserAppsAppsCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *GetUserAppsAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
-	var userID string
-	cc.Flags().StringVar(&cmd.UserID, "userId", userID, `User ID`)
+func (cmd *SearchAppsAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	cc.Flags().IntVar(&cmd.Limit, "limit", 50, `Maximal number of apps to return`)
+	var owner string
+	cc.Flags().StringVar(&cmd.Owner, "owner", owner, `Only apps of this user`)
+	var q string
+	cc.Flags().StringVar(&cmd.Q, "q", q, `Words to search for in the name, description, domain and labels of the apps`)
+	var registeredFrom string
+	cc.Flags().StringVar(&cmd.RegisteredFrom, "registeredFrom", registeredFrom, `Only apps registered on or after this day (YYYY-MM-DD)`)
+	var registeredTo string
+	cc.Flags().StringVar(&cmd.RegisteredTo, "registeredTo", registeredTo, `Only apps registered on or before this day (YYYY-MM-DD)`)
+	var status string
+	cc.Flags().StringVar(&cmd.Status, "status", status, `Only apps with this status. Deleted apps are found only with status=deleted.`)
 }
 
-// Run makes the HTTP request corresponding to the RegenerateClientSecretAppsCommand command.
-func (cmd *RegenerateClientSecretAppsCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the SetRateLimitAppsCommand command.
+func (cmd *SetRateLimitAppsCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = fmt.Sprintf("/apps/%v/regenerate-secret", url.QueryEscape(cmd.AppID))
+		path = fmt.Sprintf("/apps/%v/rate-limit", url.QueryEscape(cmd.AppID))
+	}
+	var payload client.RateLimitPayload
+	if cmd.Payload != "" {
+		err := json.Unmarshal([]byte(cmd.Payload), &payload)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize payload: %s", err)
+		}
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.RegenerateClientSecretApps(ctx, path)
+	resp, err := c.SetRateLimitApps(ctx, path, &payload, cmd.ContentType)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -566,20 +1732,22 @@ func (cmd *RegenerateClientSecretAppsCommand) Run(c *client.Client, args []strin
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *RegenerateClientSecretAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *SetRateLimitAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	cc.Flags().StringVar(&cmd.Payload, "payload", "", "Request body encoded in JSON")
+	cc.Flags().StringVar(&cmd.ContentType, "content", "", "Request content type override, e.g. 'application/x-www-form-urlencoded'")
 	var appID string
-	cc.Flags().StringVar(&cmd.AppID, "appId", appID, ``)
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
 }
 
-// Run makes the HTTP request corresponding to the RegisterAppAppsCommand command.
-func (cmd *RegisterAppAppsCommand) Run(c *client.Client, args []string) error {
+// Run makes the HTTP request corresponding to the SetUserQuotaAppsCommand command.
+func (cmd *SetUserQuotaAppsCommand) Run(c *client.Client, args []string) error {
 	var path string
 	if len(args) > 0 {
 		path = args[0]
 	} else {
-		path = "/apps"
+		path = fmt.Sprintf("/apps/users/%v/quota", url.QueryEscape(cmd.UserID))
 	}
-	var payload client.AppPayload
+	var payload client.AppQuotaPayload
 	if cmd.Payload != "" {
 		err := json.Unmarshal([]byte(cmd.Payload), &payload)
 		if err != nil {
@@ -588,7 +1756,7 @@ func (cmd *RegisterAppAppsCommand) Run(c *client.Client, args []string) error {
 	}
 	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
 	ctx := goa.WithLogger(context.Background(), logger)
-	resp, err := c.RegisterAppApps(ctx, path, &payload, cmd.ContentType)
+	resp, err := c.SetUserQuotaApps(ctx, path, &payload, cmd.ContentType)
 	if err != nil {
 		goa.LogError(ctx, "failed", "err", err)
 		return err
@@ -599,9 +1767,11 @@ func (cmd *RegisterAppAppsCommand) Run(c *client.Client, args []string) error {
 }
 
 // RegisterFlags registers the command flags with the command line.
-func (cmd *RegisterAppAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+func (cmd *SetUserQuotaAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
 	cc.Flags().StringVar(&cmd.Payload, "payload", "", "Request body encoded in JSON")
 	cc.Flags().StringVar(&cmd.ContentType, "content", "", "Request content type override, e.g. 'application/x-www-form-urlencoded'")
+	var userID string
+	cc.Flags().StringVar(&cmd.UserID, "userId", userID, `User ID`)
 }
 
 // Run makes the HTTP request corresponding to the UpdateAppAppsCommand command.
@@ -639,6 +1809,32 @@ func (cmd *UpdateAppAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Clie
 	cc.Flags().StringVar(&cmd.AppID, "appId", appID, ``)
 }
 
+// Run makes the HTTP request corresponding to the UploadLogoAppsCommand command.
+func (cmd *UploadLogoAppsCommand) Run(c *client.Client, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		path = fmt.Sprintf("/apps/%v/logo", url.QueryEscape(cmd.AppID))
+	}
+	logger := goa.NewLogger(log.New(os.Stderr, "", log.LstdFlags))
+	ctx := goa.WithLogger(context.Background(), logger)
+	resp, err := c.UploadLogoApps(ctx, path)
+	if err != nil {
+		goa.LogError(ctx, "failed", "err", err)
+		return err
+	}
+
+	goaclient.HandleResponse(c.Client, resp, cmd.PrettyPrint)
+	return nil
+}
+
+// RegisterFlags registers the command flags with the command line.
+func (cmd *UploadLogoAppsCommand) RegisterFlags(cc *cobra.Command, c *client.Client) {
+	var appID string
+	cc.Flags().StringVar(&cmd.AppID, "appId", appID, `App ID`)
+}
+
 // Run makes the HTTP request corresponding to the VerifyAppAppsCommand command.
 func (cmd *VerifyAppAppsCommand) Run(c *client.Client, args []string) error {
 	var path string
@@ -0,0 +1,223 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Exporter sends the ended spans to a tracing backend.
+type Exporter interface {
+	Export(spans []*Span) error
+}
+
+// WriterExporter writes every span as a line of JSON, for example to the standard output.
+type WriterExporter struct {
+	mutex sync.Mutex
+	w     io.Writer
+}
+
+// NewWriterExporter creates an exporter that writes the spans to w.
+func NewWriterExporter(w io.Writer) *WriterExporter {
+	return &WriterExporter{w: w}
+}
+
+// writtenSpan is the JSON written by WriterExporter.
+type writtenSpan struct {
+	TraceID       string                 `json:"traceId"`
+	SpanID        string                 `json:"spanId"`
+	ParentSpanID  string                 `json:"parentSpanId,omitempty"`
+	Name          string                 `json:"name"`
+	Kind          SpanKind               `json:"kind"`
+	Start         string                 `json:"start"`
+	DurationMs    float64                `json:"durationMs"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+	Failed        bool                   `json:"failed,omitempty"`
+	StatusMessage string                 `json:"statusMessage,omitempty"`
+}
+
+// Export writes the spans.
+func (e *WriterExporter) Export(spans []*Span) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	enc := json.NewEncoder(e.w)
+	for _, span := range spans {
+		written := &writtenSpan{
+			TraceID:       span.Context.TraceID.String(),
+			SpanID:        span.Context.SpanID.String(),
+			Name:          span.Name,
+			Kind:          span.Kind,
+			Start:         span.StartTime.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+			DurationMs:    float64(span.EndTime.Sub(span.StartTime).Microseconds()) / 1000,
+			Attributes:    span.Attributes,
+			Failed:        span.Failed,
+			StatusMessage: span.StatusMessage,
+		}
+		if span.ParentSpanID.IsValid() {
+			written.ParentSpanID = span.ParentSpanID.String()
+		}
+		if err := enc.Encode(written); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OTLPExporter sends the spans to an OpenTelemetry collector with OTLP over HTTP, in the JSON encoding.
+type OTLPExporter struct {
+	// Endpoint is the URL of the traces endpoint of the collector, for example http://localhost:4318/v1/traces.
+	Endpoint string
+	// ServiceName is reported as the service.name resource attribute.
+	ServiceName string
+	// Client sends the requests.
+	Client *http.Client
+}
+
+// NewOTLPExporter creates an exporter that sends the spans of the service to the endpoint.
+func NewOTLPExporter(endpoint, serviceName string, client *http.Client) *OTLPExporter {
+	return &OTLPExporter{
+		Endpoint:    endpoint,
+		ServiceName: serviceName,
+		Client:      client,
+	}
+}
+
+type otlpRequest struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   *otlpResource     `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope *otlpScope  `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string           `json:"traceId"`
+	SpanID            string           `json:"spanId"`
+	ParentSpanID      string           `json:"parentSpanId,omitempty"`
+	TraceState        string           `json:"traceState,omitempty"`
+	Name              string           `json:"name"`
+	Kind              SpanKind         `json:"kind"`
+	StartTimeUnixNano string           `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string           `json:"endTimeUnixNano"`
+	Attributes        []*otlpAttribute `json:"attributes,omitempty"`
+	Status            *otlpStatus      `json:"status,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// otlpStatusError is the OTLP status code of a failed span.
+const otlpStatusError = 2
+
+// Export sends the spans in a single request.
+func (e *OTLPExporter) Export(spans []*Span) error {
+	scope := &otlpScopeSpans{
+		Scope: &otlpScope{Name: "github.com/Microkubes/microservice-apps-management/tracing"},
+		Spans: []*otlpSpan{},
+	}
+	for _, span := range spans {
+		scope.Spans = append(scope.Spans, toOTLPSpan(span))
+	}
+	request := &otlpRequest{
+		ResourceSpans: []*otlpResourceSpans{{
+			Resource: &otlpResource{
+				Attributes: toOTLPAttributes(map[string]interface{}{"service.name": e.ServiceName}),
+			},
+			ScopeSpans: []*otlpScopeSpans{scope},
+		}},
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	resp, err := e.Client.Post(e.Endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	ioutil.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("tracing: the collector returned %s", resp.Status)
+	}
+	return nil
+}
+
+func toOTLPSpan(span *Span) *otlpSpan {
+	res := &otlpSpan{
+		TraceID:           span.Context.TraceID.String(),
+		SpanID:            span.Context.SpanID.String(),
+		TraceState:        span.Context.TraceState,
+		Name:              span.Name,
+		Kind:              span.Kind,
+		StartTimeUnixNano: strconv.FormatInt(span.StartTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+		Attributes:        toOTLPAttributes(span.Attributes),
+	}
+	if span.ParentSpanID.IsValid() {
+		res.ParentSpanID = span.ParentSpanID.String()
+	}
+	if span.Failed {
+		res.Status = &otlpStatus{Code: otlpStatusError, Message: span.StatusMessage}
+	}
+	return res
+}
+
+// toOTLPAttributes converts the attributes to OTLP key-values, ordered by key.
+// 64-bit integers are encoded as strings, as required by the OTLP JSON encoding.
+func toOTLPAttributes(attributes map[string]interface{}) []*otlpAttribute {
+	keys := []string{}
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	res := []*otlpAttribute{}
+	for _, key := range keys {
+		var value map[string]interface{}
+		switch v := attributes[key].(type) {
+		case string:
+			value = map[string]interface{}{"stringValue": v}
+		case bool:
+			value = map[string]interface{}{"boolValue": v}
+		case int:
+			value = map[string]interface{}{"intValue": strconv.Itoa(v)}
+		case int64:
+			value = map[string]interface{}{"intValue": strconv.FormatInt(v, 10)}
+		case float64:
+			value = map[string]interface{}{"doubleValue": v}
+		default:
+			value = map[string]interface{}{"stringValue": fmt.Sprint(v)}
+		}
+		res = append(res, &otlpAttribute{Key: key, Value: value})
+	}
+	return res
+}
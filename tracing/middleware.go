@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/keitaroinc/goa"
+	"github.com/keitaroinc/goa/middleware"
+)
+
+// Middleware starts a server span for every request, named after the goa action. The span continues the trace
+// of the W3C trace context headers of the request. It must be mounted after the RequestID middleware, so that
+// the request ID is recorded on the span. The requests to the skipped paths, like the probes and the metrics,
+// are not traced.
+func (t *Tracer) Middleware(skipPaths ...string) goa.Middleware {
+	skipped := map[string]bool{}
+	for _, path := range skipPaths {
+		skipped[path] = true
+	}
+	return func(h goa.Handler) goa.Handler {
+		return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+			if skipped[req.URL.Path] {
+				return h(ctx, rw, req)
+			}
+			if sc, ok := Extract(req.Header); ok {
+				ctx = ContextWithRemoteSpanContext(ctx, sc)
+			}
+			action := goa.ContextAction(ctx)
+			ctx, span := t.StartSpan(ctx, action, KindServer)
+			defer span.End()
+
+			span.SetAttribute("goa.action", action)
+			span.SetAttribute("http.method", req.Method)
+			span.SetAttribute("http.target", req.URL.Path)
+			if requestID := middleware.ContextRequestID(ctx); requestID != "" {
+				span.SetAttribute("request.id", requestID)
+			}
+
+			err := h(ctx, rw, req)
+
+			status := http.StatusOK
+			if resp := goa.ContextResponse(ctx); resp != nil && resp.Status != 0 {
+				status = resp.Status
+			} else if err != nil {
+				status = http.StatusInternalServerError
+			}
+			span.SetAttribute("http.status_code", status)
+			if status >= http.StatusInternalServerError {
+				if err != nil {
+					span.SetError(err)
+				} else {
+					span.SetError(fmt.Errorf("%d %s", status, http.StatusText(status)))
+				}
+			}
+			return err
+		}
+	}
+}
+
+// Measure wraps a middleware in a child span that ends when the middleware calls the next handler,
+// so the span shows the time spent in the middleware, for example in the security chain.
+func Measure(name string, m goa.Middleware) goa.Middleware {
+	return func(h goa.Handler) goa.Handler {
+		direct := m(h)
+		return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+			parent := SpanFromContext(ctx)
+			if parent == nil {
+				return direct(ctx, rw, req)
+			}
+
+			ctx, span := StartChildSpan(ctx, name, KindInternal)
+			defer span.End()
+			passed := false
+			next := func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+				passed = true
+				span.End()
+				return h(ContextWithSpan(ctx, parent), rw, req)
+			}
+			err := m(next)(ctx, rw, req)
+			if err != nil && !passed {
+				span.SetError(err)
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,384 @@
+// Package tracing records spans of the requests and the store operations and exports them in the OpenTelemetry
+// format. The trace context is propagated with the W3C traceparent and tracestate headers.
+// It implements only the parts of OpenTelemetry the service needs.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TraceID identifies a trace.
+type TraceID [16]byte
+
+// String returns the ID as lowercase hex.
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// IsValid checks that the ID is not all zeros.
+func (id TraceID) IsValid() bool {
+	return id != TraceID{}
+}
+
+// SpanID identifies a span within a trace.
+type SpanID [8]byte
+
+// String returns the ID as lowercase hex.
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// IsValid checks that the ID is not all zeros.
+func (id SpanID) IsValid() bool {
+	return id != SpanID{}
+}
+
+// SpanContext is the part of a span that is propagated to the children of the span and to other services.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	// Sampled is set when the span is exported.
+	Sampled bool
+	// TraceState is the vendor specific trace state, passed on as it is.
+	TraceState string
+}
+
+// IsValid checks that both IDs are set.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// Headers of the W3C trace context.
+const (
+	TraceparentHeader = "traceparent"
+	TracestateHeader  = "tracestate"
+)
+
+// Extract reads the span context from the W3C trace context headers. It returns false if the traceparent
+// header is missing or invalid.
+func Extract(header http.Header) (SpanContext, bool) {
+	sc, err := parseTraceparent(header.Get(TraceparentHeader))
+	if err != nil {
+		return SpanContext{}, false
+	}
+	sc.TraceState = header.Get(TracestateHeader)
+	return sc, true
+}
+
+// Inject writes the span context to the W3C trace context headers.
+func Inject(sc SpanContext, header http.Header) {
+	if !sc.IsValid() {
+		return
+	}
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	header.Set(TraceparentHeader, fmt.Sprintf("00-%s-%s-%s", sc.TraceID, sc.SpanID, flags))
+	if sc.TraceState != "" {
+		header.Set(TracestateHeader, sc.TraceState)
+	}
+}
+
+// parseTraceparent parses a traceparent header of the form version-traceid-parentid-flags.
+// Later versions may append fields, which are ignored.
+func parseTraceparent(value string) (SpanContext, error) {
+	sc := SpanContext{}
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return sc, fmt.Errorf("invalid traceparent %q", value)
+	}
+	if parts[0] == "ff" || (parts[0] == "00" && len(parts) != 4) {
+		return sc, fmt.Errorf("invalid traceparent version in %q", value)
+	}
+	for _, part := range parts[:4] {
+		if strings.ToLower(part) != part {
+			return sc, fmt.Errorf("traceparent %q is not lowercase", value)
+		}
+	}
+
+	version, err := hex.DecodeString(parts[0])
+	if err != nil || len(version) != 1 {
+		return sc, fmt.Errorf("invalid traceparent version in %q", value)
+	}
+	if _, err := hex.Decode(sc.TraceID[:], []byte(parts[1])); err != nil {
+		return sc, fmt.Errorf("invalid trace ID in %q", value)
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(parts[2])); err != nil {
+		return sc, fmt.Errorf("invalid parent ID in %q", value)
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil {
+		return sc, fmt.Errorf("invalid trace flags in %q", value)
+	}
+	if !sc.IsValid() {
+		return sc, fmt.Errorf("traceparent %q has a zero ID", value)
+	}
+	sc.Sampled = flags[0]&1 == 1
+	return sc, nil
+}
+
+// SpanKind is the role of a span in a trace, with the values of OpenTelemetry.
+type SpanKind int
+
+const (
+	// KindInternal is an operation within the service.
+	KindInternal SpanKind = 1
+	// KindServer handles a request from a client.
+	KindServer SpanKind = 2
+	// KindClient is a call to another service or a database.
+	KindClient SpanKind = 3
+)
+
+// Span is a timed operation in a trace. All methods of Span can be called on a nil Span and do nothing,
+// so the code does not have to check whether tracing is enabled.
+type Span struct {
+	// Name of the operation.
+	Name string
+	// Kind is the role of the span in the trace.
+	Kind SpanKind
+	// Context holds the IDs of the span.
+	Context SpanContext
+	// ParentSpanID is the ID of the parent span, zero for the root span of a trace.
+	ParentSpanID SpanID
+	// StartTime and EndTime are the start and the end of the operation.
+	StartTime time.Time
+	EndTime   time.Time
+	// Attributes describe the operation. The values are strings, integers, floats or booleans.
+	Attributes map[string]interface{}
+	// Failed is set when the operation failed, with the error in StatusMessage.
+	Failed        bool
+	StatusMessage string
+
+	tracer *Tracer
+	mutex  sync.Mutex
+	ended  bool
+}
+
+// SetAttribute sets an attribute of the span.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.Attributes[key] = value
+}
+
+// SetError marks the span as failed with the error.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.Failed = true
+	s.StatusMessage = err.Error()
+}
+
+// End ends the span and queues it for export if it is sampled. Only the first call has an effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	if s.ended {
+		s.mutex.Unlock()
+		return
+	}
+	s.ended = true
+	s.EndTime = time.Now()
+	s.mutex.Unlock()
+
+	if s.Context.Sampled {
+		s.tracer.enqueue(s)
+	}
+}
+
+type contextKey int
+
+const (
+	spanKey contextKey = iota + 1
+	remoteKey
+)
+
+// ContextWithSpan returns a context that holds the span as the current span.
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, spanKey, span)
+}
+
+// SpanFromContext returns the current span, or nil if there is none.
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanKey).(*Span)
+	return span
+}
+
+// ContextWithRemoteSpanContext returns a context that holds the span context received from another service.
+// It becomes the parent of the next span started in the context.
+func ContextWithRemoteSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, remoteKey, sc)
+}
+
+// StartChildSpan starts a span as a child of the current span in the context, with the tracer of the current span.
+// It returns a nil Span if there is no current span, so an operation is traced only within a traced request.
+func StartChildSpan(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	parent := SpanFromContext(ctx)
+	if parent == nil {
+		return ctx, nil
+	}
+	return parent.tracer.StartSpan(ctx, name, kind)
+}
+
+// Sampler decides whether a new trace is sampled, that is whether its spans are exported. It is called only for
+// the traces that start in the service; a trace continued from another service keeps the decision of its parent.
+type Sampler func(traceID TraceID) bool
+
+// AlwaysSample samples every trace.
+func AlwaysSample(traceID TraceID) bool {
+	return true
+}
+
+// RatioSampler samples the given fraction of the traces, between 0 and 1. Like the TraceIDRatioBased sampler of
+// OpenTelemetry, the decision is taken from the random trace ID, so every service that uses the same ratio
+// takes the same decision for a trace.
+func RatioSampler(ratio float64) Sampler {
+	if ratio >= 1 {
+		return AlwaysSample
+	}
+	bound := uint64(ratio * (1 << 63))
+	return func(traceID TraceID) bool {
+		return binary.BigEndian.Uint64(traceID[8:16])>>1 < bound
+	}
+}
+
+// maxPending is the number of ended spans kept for export. Spans that end while the queue is full are dropped.
+const maxPending = 2048
+
+// Tracer creates the spans and exports them periodically.
+type Tracer struct {
+	// ErrorHandler is called with the errors that happen while exporting the spans.
+	ErrorHandler func(err error)
+	// Sampler decides which new traces are sampled. All traces are sampled if it is nil.
+	Sampler Sampler
+
+	exporter Exporter
+
+	mutex   sync.Mutex
+	pending []*Span
+	dropped int
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewTracer creates a Tracer that sends the spans to the exporter. The spans are not exported if the exporter is nil.
+func NewTracer(exporter Exporter) *Tracer {
+	return &Tracer{exporter: exporter}
+}
+
+// StartSpan starts a span as a child of the current span, or of the remote span context in the context.
+// A span without a parent starts a new trace, sampled as decided by the Sampler. The returned context holds the new span.
+func (t *Tracer) StartSpan(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	span := &Span{
+		Name:       name,
+		Kind:       kind,
+		StartTime:  time.Now(),
+		Attributes: map[string]interface{}{},
+		tracer:     t,
+	}
+
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.Context = parent.Context
+		span.ParentSpanID = parent.Context.SpanID
+	} else if remote, ok := ctx.Value(remoteKey).(SpanContext); ok && remote.IsValid() {
+		span.Context = remote
+		span.ParentSpanID = remote.SpanID
+	} else {
+		rand.Read(span.Context.TraceID[:])
+		span.Context.Sampled = t.Sampler == nil || t.Sampler(span.Context.TraceID)
+	}
+	rand.Read(span.Context.SpanID[:])
+
+	return ContextWithSpan(ctx, span), span
+}
+
+func (t *Tracer) enqueue(span *Span) {
+	if t.exporter == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if len(t.pending) >= maxPending {
+		t.dropped++
+		return
+	}
+	t.pending = append(t.pending, span)
+}
+
+// Flush exports the ended spans.
+func (t *Tracer) Flush() error {
+	t.mutex.Lock()
+	spans := t.pending
+	dropped := t.dropped
+	t.pending = nil
+	t.dropped = 0
+	t.mutex.Unlock()
+
+	if len(spans) > 0 {
+		if err := t.exporter.Export(spans); err != nil {
+			return err
+		}
+	}
+	if dropped > 0 {
+		return fmt.Errorf("tracing: dropped %d spans, the export queue was full", dropped)
+	}
+	return nil
+}
+
+// Start exports the ended spans in the background on every interval.
+func (t *Tracer) Start(interval time.Duration) {
+	t.stop = make(chan struct{})
+	t.done = make(chan struct{})
+
+	go func() {
+		defer close(t.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.flushInBackground()
+			case <-t.stop:
+				t.flushInBackground()
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background export, after exporting the remaining spans.
+func (t *Tracer) Stop() {
+	if t.stop == nil {
+		return
+	}
+	close(t.stop)
+	<-t.done
+	t.stop = nil
+}
+
+func (t *Tracer) flushInBackground() {
+	if err := t.Flush(); err != nil && t.ErrorHandler != nil {
+		t.ErrorHandler(err)
+	}
+}
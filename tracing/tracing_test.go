@@ -0,0 +1,277 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/keitaroinc/goa"
+	"github.com/keitaroinc/goa/middleware"
+)
+
+type recordingExporter struct {
+	spans []*Span
+}
+
+func (e *recordingExporter) Export(spans []*Span) error {
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *recordingExporter) span(name string) *Span {
+	for _, span := range e.spans {
+		if span.Name == name {
+			return span
+		}
+	}
+	return nil
+}
+
+func TestExtract(t *testing.T) {
+	header := http.Header{}
+	header.Set(TraceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	header.Set(TracestateHeader, "vendor=value")
+	sc, ok := Extract(header)
+	if !ok || sc.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" || sc.SpanID.String() != "00f067aa0ba902b7" ||
+		!sc.Sampled || sc.TraceState != "vendor=value" {
+		t.Fatalf("Expected the span context of the headers, got %+v, %v", sc, ok)
+	}
+
+	injected := http.Header{}
+	Inject(sc, injected)
+	if injected.Get(TraceparentHeader) != header.Get(TraceparentHeader) || injected.Get(TracestateHeader) != "vendor=value" {
+		t.Fatalf("Expected the same headers, got %v", injected)
+	}
+
+	for _, value := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"00-xbf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	} {
+		header.Set(TraceparentHeader, value)
+		if sc, ok := Extract(header); ok {
+			t.Errorf("Expected %q to be invalid, got %+v", value, sc)
+		}
+	}
+
+	header.Set(TraceparentHeader, "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00-extra")
+	if sc, ok := Extract(header); !ok || sc.Sampled {
+		t.Errorf("Expected a later version with extra fields to be accepted, got %+v, %v", sc, ok)
+	}
+}
+
+func TestSpans(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter)
+
+	if _, span := StartChildSpan(context.Background(), "orphan", KindInternal); span != nil {
+		t.Fatal("Expected no span without a parent")
+	}
+
+	ctx, root := tracer.StartSpan(context.Background(), "root", KindServer)
+	_, child := StartChildSpan(ctx, "child", KindClient)
+	child.SetAttribute("key", "value")
+	child.SetError(fmt.Errorf("failed"))
+	child.End()
+	root.End()
+	root.End()
+
+	if err := tracer.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if len(exporter.spans) != 2 {
+		t.Fatalf("Expected 2 exported spans, got %d", len(exporter.spans))
+	}
+	if child.Context.TraceID != root.Context.TraceID || child.ParentSpanID != root.Context.SpanID || root.ParentSpanID.IsValid() {
+		t.Fatalf("Expected the child in the trace of the root, got %+v and %+v", child.Context, root.Context)
+	}
+	if !child.Failed || child.StatusMessage != "failed" || child.Attributes["key"] != "value" {
+		t.Fatalf("Expected the status and the attributes of the child, got %+v", child)
+	}
+
+	remote := SpanContext{TraceID: TraceID{1}, SpanID: SpanID{2}, Sampled: false}
+	_, span := tracer.StartSpan(ContextWithRemoteSpanContext(context.Background(), remote), "unsampled", KindServer)
+	span.End()
+	if span.Context.TraceID != remote.TraceID || span.ParentSpanID != remote.SpanID {
+		t.Fatalf("Expected the remote parent, got %+v", span)
+	}
+	if err := tracer.Flush(); err != nil || len(exporter.spans) != 2 {
+		t.Fatalf("Expected the unsampled span not to be exported, got %d spans, %v", len(exporter.spans), err)
+	}
+}
+
+func TestRatioSampler(t *testing.T) {
+	tracer := NewTracer(&recordingExporter{})
+	tracer.Sampler = RatioSampler(0.25)
+	sampled := 0
+	for i := 0; i < 4000; i++ {
+		_, span := tracer.StartSpan(context.Background(), "root", KindServer)
+		if span.Context.Sampled {
+			sampled++
+		}
+	}
+	if sampled < 800 || sampled > 1200 {
+		t.Fatalf("Expected about a quarter of the traces to be sampled, got %d of 4000", sampled)
+	}
+
+	tracer.Sampler = RatioSampler(0)
+	remote := SpanContext{TraceID: TraceID{1}, SpanID: SpanID{2}, Sampled: true}
+	_, span := tracer.StartSpan(ContextWithRemoteSpanContext(context.Background(), remote), "continued", KindServer)
+	if !span.Context.Sampled {
+		t.Fatal("Expected a continued trace to keep the decision of the parent")
+	}
+	if _, span := tracer.StartSpan(context.Background(), "root", KindServer); span.Context.Sampled {
+		t.Fatal("Expected no new trace to be sampled with a ratio of 0")
+	}
+	if !RatioSampler(1)(TraceID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}) {
+		t.Fatal("Expected every trace to be sampled with a ratio of 1")
+	}
+}
+
+func TestMiddlewareSkipPaths(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter)
+	handler := func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		if SpanFromContext(ctx) != nil {
+			t.Errorf("Expected no span for %s", req.URL.Path)
+		}
+		return nil
+	}
+	chain := tracer.Middleware("/readyz", "/metrics")(handler)
+
+	for _, path := range []string{"/readyz", "/metrics"} {
+		req := httptest.NewRequest("GET", path, nil)
+		rw := httptest.NewRecorder()
+		if err := chain(goa.NewContext(context.Background(), rw, req, nil), rw, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tracer.Flush(); err != nil || len(exporter.spans) != 0 {
+		t.Fatalf("Expected no spans for the skipped paths, got %d, %v", len(exporter.spans), err)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter)
+
+	security := func(h goa.Handler) goa.Handler {
+		return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+			if req.Header.Get("Authorization") == "" {
+				return fmt.Errorf("unauthorized")
+			}
+			return h(ctx, rw, req)
+		}
+	}
+	handler := func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		_, span := StartChildSpan(ctx, "AppsManagementStore.FindApp", KindClient)
+		span.End()
+		rw.WriteHeader(http.StatusOK)
+		return nil
+	}
+	chain := middleware.RequestID()(tracer.Middleware()(Measure("security", security)(handler)))
+
+	req := httptest.NewRequest("POST", "/apps/verify", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set(TraceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	rw := httptest.NewRecorder()
+	ctx := goa.WithAction(goa.NewContext(context.Background(), rw, req, nil), "verifyApp")
+	if err := chain(ctx, goa.ContextResponse(ctx), req); err != nil {
+		t.Fatal(err)
+	}
+	if err := tracer.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	server, securitySpan, store := exporter.span("verifyApp"), exporter.span("security"), exporter.span("AppsManagementStore.FindApp")
+	if server == nil || securitySpan == nil || store == nil {
+		t.Fatalf("Expected the server, the security and the store spans, got %+v", exporter.spans)
+	}
+	if server.Context.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" || server.ParentSpanID.String() != "00f067aa0ba902b7" {
+		t.Errorf("Expected the server span to continue the incoming trace, got %+v", server.Context)
+	}
+	if securitySpan.ParentSpanID != server.Context.SpanID || store.ParentSpanID != server.Context.SpanID {
+		t.Errorf("Expected the security and the store spans to be children of the server span")
+	}
+	if server.Attributes["http.status_code"] != http.StatusOK || server.Attributes["request.id"] == nil {
+		t.Errorf("Expected the status code and the request ID, got %v", server.Attributes)
+	}
+
+	req = httptest.NewRequest("POST", "/apps/verify", nil)
+	ctx = goa.WithAction(goa.NewContext(context.Background(), httptest.NewRecorder(), req, nil), "verifyApp")
+	if err := chain(ctx, goa.ContextResponse(ctx), req); err == nil {
+		t.Fatal("Expected the security error")
+	}
+	tracer.Flush()
+	last := exporter.spans[len(exporter.spans)-2]
+	if last.Name != "security" || !last.Failed {
+		t.Errorf("Expected a failed security span, got %+v", last)
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		data, _ := ioutil.ReadAll(req.Body)
+		json.Unmarshal(data, &body)
+		if req.URL.Path != "/v1/traces" || req.Header.Get("Content-Type") != "application/json" {
+			rw.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	tracer := NewTracer(NewOTLPExporter(server.URL+"/v1/traces", "apps-management", server.Client()))
+	ctx, span := tracer.StartSpan(context.Background(), "getApp", KindServer)
+	span.SetAttribute("http.status_code", 200)
+	_, child := StartChildSpan(ctx, "AppsManagementStore.GetApp", KindClient)
+	child.SetError(fmt.Errorf("db down"))
+	child.End()
+	span.End()
+	if err := tracer.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, _ := json.Marshal(body)
+	for _, expected := range []string{
+		`"stringValue":"apps-management"`,
+		`"name":"getApp"`,
+		`"kind":2`,
+		`"intValue":"200"`,
+		`"traceId":"` + span.Context.TraceID.String() + `"`,
+		`"parentSpanId":"` + span.Context.SpanID.String() + `"`,
+		`"status":{"code":2,"message":"db down"}`,
+	} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("Expected %s in the request, got %s", expected, data)
+		}
+	}
+
+	failing := NewOTLPExporter(server.URL+"/wrong", "apps-management", server.Client())
+	if err := failing.Export([]*Span{span}); err == nil {
+		t.Fatal("Expected an error for a failed export")
+	}
+}
+
+func TestWriterExporter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	tracer := NewTracer(NewWriterExporter(buf))
+	_, span := tracer.StartSpan(context.Background(), "listApps", KindServer)
+	span.End()
+	if err := tracer.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"name":"listApps"`) || strings.Count(buf.String(), "\n") != 1 {
+		t.Fatalf("Expected a line for the span, got %s", buf.String())
+	}
+}
@@ -0,0 +1,162 @@
+// Package usage buffers the app verification events in memory and periodically
+// writes them to the store, aggregated per app and day.
+package usage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Microkubes/microservice-apps-management/db"
+)
+
+// Recorder writes aggregated usage records. Implemented by db.AppsManagementStore.
+type Recorder interface {
+	RecordUsage(ctx context.Context, record *db.UsageRecord) error
+}
+
+// DefaultMaxPending is the default maximal number of pending records of a Meter.
+const DefaultMaxPending = 100000
+
+// Meter collects the verification events of the apps. The events are aggregated in memory
+// and written to the Recorder on every flush interval, so recording an event never
+// blocks on the database.
+type Meter struct {
+	// ErrorHandler is called with the errors that happen while flushing in the background.
+	ErrorHandler func(err error)
+	// MaxPending is the maximal number of pending records, one per app and day. The events that would
+	// add a record over the limit are dropped, and reported as an error on the next flush. Zero means
+	// no limit.
+	MaxPending int
+
+	recorder Recorder
+	interval time.Duration
+	now      func() time.Time
+
+	mutex   sync.Mutex
+	pending map[string]*db.UsageRecord
+	dropped int
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewMeter creates a new Meter that writes to the given recorder on every interval.
+func NewMeter(recorder Recorder, interval time.Duration) *Meter {
+	return &Meter{
+		MaxPending: DefaultMaxPending,
+		recorder:   recorder,
+		interval:   interval,
+		now:        time.Now,
+		pending:    map[string]*db.UsageRecord{},
+	}
+}
+
+// Record records a single verification of an app from the given IP address.
+func (m *Meter) Record(appID, ip string, success bool) {
+	now := m.now().UTC()
+	record := &db.UsageRecord{
+		AppID: appID,
+		Day:   now.Format(db.DayFormat),
+	}
+	if success {
+		record.Success = 1
+		record.LastUsedAt = now.Unix()
+		record.LastUsedIP = ip
+	} else {
+		record.Failure = 1
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.merge(record)
+}
+
+// Flush writes all pending records to the recorder. Records that could not be written
+// are kept and retried on the next flush. Returns the first error that occurred.
+func (m *Meter) Flush(ctx context.Context) error {
+	m.mutex.Lock()
+	pending := m.pending
+	dropped := m.dropped
+	m.pending = map[string]*db.UsageRecord{}
+	m.dropped = 0
+	m.mutex.Unlock()
+
+	var firstErr error
+	if dropped > 0 {
+		firstErr = fmt.Errorf("usage: dropped %d verification events, the limit of %d pending records was reached", dropped, m.MaxPending)
+	}
+	for _, record := range pending {
+		if err := m.recorder.RecordUsage(ctx, record); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			m.mutex.Lock()
+			m.merge(record)
+			m.mutex.Unlock()
+		}
+	}
+
+	return firstErr
+}
+
+// Start starts flushing the pending records in the background on every interval.
+func (m *Meter) Start() {
+	m.stop = make(chan struct{})
+	m.done = make(chan struct{})
+
+	go func() {
+		defer close(m.done)
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.flushInBackground()
+			case <-m.stop:
+				m.flushInBackground()
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background flushing and writes the remaining pending records.
+func (m *Meter) Stop() {
+	if m.stop == nil {
+		return
+	}
+	close(m.stop)
+	<-m.done
+	m.stop = nil
+}
+
+// flushInBackground flushes without a cancellation, so that the last flush on Stop writes all records. The
+// writes are bounded by the timeouts of the store.
+func (m *Meter) flushInBackground() {
+	if err := m.Flush(context.Background()); err != nil && m.ErrorHandler != nil {
+		m.ErrorHandler(err)
+	}
+}
+
+// merge adds the record to the pending records. Must be called with the mutex held.
+func (m *Meter) merge(record *db.UsageRecord) {
+	key := record.AppID + "/" + record.Day
+	existing, ok := m.pending[key]
+	if !ok {
+		if m.MaxPending > 0 && len(m.pending) >= m.MaxPending {
+			m.dropped += record.Success + record.Failure
+			return
+		}
+		m.pending[key] = record
+		return
+	}
+
+	existing.Success += record.Success
+	existing.Failure += record.Failure
+	if record.LastUsedAt >= existing.LastUsedAt && record.LastUsedAt > 0 {
+		existing.LastUsedAt = record.LastUsedAt
+		existing.LastUsedIP = record.LastUsedIP
+	}
+}
@@ -0,0 +1,127 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Microkubes/microservice-apps-management/db"
+)
+
+type recorderMock struct {
+	sync.Mutex
+	records []*db.UsageRecord
+	fail    bool
+}
+
+func (r *recorderMock) RecordUsage(ctx context.Context, record *db.UsageRecord) error {
+	r.Lock()
+	defer r.Unlock()
+	if r.fail {
+		return fmt.Errorf("recorder failure")
+	}
+	r.records = append(r.records, record)
+	return nil
+}
+
+func TestMeterAggregatesRecords(t *testing.T) {
+	recorder := &recorderMock{}
+	meter := NewMeter(recorder, time.Minute)
+	meter.now = func() time.Time { return time.Date(2020, 3, 15, 10, 0, 0, 0, time.UTC) }
+
+	meter.Record("app-1", "10.0.0.1", true)
+	meter.Record("app-1", "10.0.0.2", true)
+	meter.Record("app-1", "10.0.0.3", false)
+	meter.Record("app-2", "10.0.0.4", false)
+
+	if err := meter.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(recorder.records) != 2 {
+		t.Fatalf("Expected 2 aggregated records, got %d", len(recorder.records))
+	}
+
+	for _, record := range recorder.records {
+		if record.Day != "2020-03-15" {
+			t.Errorf("Invalid day: %s", record.Day)
+		}
+		switch record.AppID {
+		case "app-1":
+			if record.Success != 2 || record.Failure != 1 {
+				t.Errorf("Invalid counts for app-1: %+v", record)
+			}
+			if record.LastUsedIP != "10.0.0.2" {
+				t.Errorf("Expected last used IP 10.0.0.2, got %s", record.LastUsedIP)
+			}
+		case "app-2":
+			if record.Success != 0 || record.Failure != 1 {
+				t.Errorf("Invalid counts for app-2: %+v", record)
+			}
+			if record.LastUsedAt != 0 {
+				t.Errorf("Failed verification must not update the last use, got %d", record.LastUsedAt)
+			}
+		default:
+			t.Errorf("Unexpected app: %s", record.AppID)
+		}
+	}
+}
+
+func TestMeterKeepsRecordsOnFailure(t *testing.T) {
+	recorder := &recorderMock{fail: true}
+	meter := NewMeter(recorder, time.Minute)
+
+	meter.Record("app-1", "10.0.0.1", true)
+	if err := meter.Flush(context.Background()); err == nil {
+		t.Fatal("Expected flush error")
+	}
+
+	meter.Record("app-1", "10.0.0.1", true)
+	recorder.fail = false
+	if err := meter.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(recorder.records) != 1 || recorder.records[0].Success != 2 {
+		t.Fatalf("Expected the failed record to be retried, got %+v", recorder.records)
+	}
+}
+
+func TestMeterStopFlushes(t *testing.T) {
+	recorder := &recorderMock{}
+	meter := NewMeter(recorder, time.Hour)
+	meter.Start()
+
+	meter.Record("app-1", "10.0.0.1", true)
+	meter.Stop()
+
+	if len(recorder.records) != 1 {
+		t.Fatalf("Expected pending records to be flushed on stop, got %d", len(recorder.records))
+	}
+}
+
+func TestMeterLimitsPending(t *testing.T) {
+	recorder := &recorderMock{}
+	meter := NewMeter(recorder, time.Minute)
+	meter.MaxPending = 2
+
+	meter.Record("app-1", "10.0.0.1", true)
+	meter.Record("app-2", "10.0.0.1", false)
+	meter.Record("app-3", "10.0.0.1", false)
+	meter.Record("app-3", "10.0.0.1", true)
+	meter.Record("app-1", "10.0.0.1", true)
+
+	if err := meter.Flush(context.Background()); err == nil {
+		t.Fatal("Expected the dropped events to be reported")
+	}
+	if len(recorder.records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(recorder.records))
+	}
+
+	meter.Record("app-3", "10.0.0.1", true)
+	if err := meter.Flush(context.Background()); err != nil {
+		t.Fatalf("Expected the dropped events to be reported once, got %s", err)
+	}
+}
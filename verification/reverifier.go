@@ -0,0 +1,133 @@
+package verification
+
+import (
+	"context"
+	"time"
+
+	"github.com/Microkubes/microservice-apps-management/db"
+)
+
+// Store gives access to the apps with verified domains. Implemented by db.AppsManagementStore.
+type Store interface {
+	GetAppsToReverify(ctx context.Context, checkedBefore time.Time) ([]*db.ClientApp, error)
+	SaveVerificationResult(ctx context.Context, appID string, result *db.VerificationResult) (*db.ClientApp, error)
+}
+
+// Reverifier periodically checks again the tokens of the verified domains, so an app loses the
+// verification when its owner no longer controls the domain.
+type Reverifier struct {
+	// ErrorHandler is called with the errors that happen while running in the background.
+	ErrorHandler func(err error)
+
+	store    Store
+	verifier *Verifier
+	// maxAge is how long a successful check stays valid.
+	maxAge time.Duration
+	// maxFailures is the number of consecutive failed checks after which the domain is no longer verified.
+	maxFailures int
+	// timeout is the timeout of a single check.
+	timeout time.Duration
+	now     func() time.Time
+
+	stop   chan struct{}
+	done   chan struct{}
+	cancel context.CancelFunc
+}
+
+// NewReverifier creates a new Reverifier. The domains checked more than maxAge ago are checked again
+// and lose the verification after maxFailures consecutive failed checks.
+func NewReverifier(store Store, verifier *Verifier, maxAge time.Duration, maxFailures int, timeout time.Duration) *Reverifier {
+	if maxFailures < 1 {
+		maxFailures = 1
+	}
+	return &Reverifier{
+		store:       store,
+		verifier:    verifier,
+		maxAge:      maxAge,
+		maxFailures: maxFailures,
+		timeout:     timeout,
+		now:         time.Now,
+	}
+}
+
+// Run checks again the domains whose last check is older than maxAge. Returns the first error
+// that occurred while reading or saving the apps, after processing all of them.
+func (r *Reverifier) Run(ctx context.Context) error {
+	now := r.now()
+	clientApps, err := r.store.GetAppsToReverify(ctx, now.Add(-r.maxAge))
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, clientApp := range clientApps {
+		if ctx.Err() != nil {
+			// The checks of a canceled run fail, so the run stops instead of saving them as failures.
+			break
+		}
+		result := r.check(ctx, clientApp)
+		if _, err := r.store.SaveVerificationResult(ctx, clientApp.ID, result); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// Start runs the re-verification in the background on every interval.
+func (r *Reverifier) Start(interval time.Duration) {
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := r.Run(ctx); err != nil && r.ErrorHandler != nil {
+					r.ErrorHandler(err)
+				}
+			case <-r.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background runs, canceling a run in progress.
+func (r *Reverifier) Stop() {
+	if r.stop == nil {
+		return
+	}
+	r.cancel()
+	close(r.stop)
+	<-r.done
+	r.stop = nil
+}
+
+func (r *Reverifier) check(ctx context.Context, clientApp *db.ClientApp) *db.VerificationResult {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	method := clientApp.VerificationMethod
+	if method == "" {
+		method = MethodDNS
+	}
+	result := &db.VerificationResult{
+		Method:    method,
+		Verified:  true,
+		CheckedAt: r.now().Unix(),
+	}
+
+	if err := r.verifier.Check(ctx, clientApp.Domain, clientApp.VerificationToken, method); err != nil {
+		result.Error = err.Error()
+		result.Failures = clientApp.VerificationFailures + 1
+		result.Verified = result.Failures < r.maxFailures
+	}
+
+	return result
+}
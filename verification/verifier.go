@@ -0,0 +1,249 @@
+// Package verification checks that the owner of an app controls the app domain. The owner publishes
+// a token issued by the service either in a DNS TXT record or in a file under /.well-known/ on the domain.
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Verification methods.
+const (
+	// MethodDNS checks for the token in a DNS TXT record.
+	MethodDNS = "dns"
+	// MethodHTTP checks for the token in a file under /.well-known/ on the domain.
+	MethodHTTP = "http"
+)
+
+// DNSRecordPrefix is prepended to the domain host to get the name of the TXT record.
+const DNSRecordPrefix = "_apps-verification."
+
+// DNSValuePrefix is prepended to the token to get the value of the TXT record.
+const DNSValuePrefix = "apps-verification="
+
+// WellKnownPath is the path of the file with the token on the domain.
+const WellKnownPath = "/.well-known/apps-verification.txt"
+
+// maxTokenFileSize is the maximal number of bytes read from the token file.
+const maxTokenFileSize = 4096
+
+// Resolver looks up DNS TXT records. Implemented by *net.Resolver.
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Fetcher fetches the content at a URL.
+type Fetcher interface {
+	Fetch(ctx context.Context, url string) ([]byte, error)
+}
+
+// maxRedirects is the maximal number of redirects followed while fetching the token file.
+const maxRedirects = 5
+
+// nonPublicNetworks are the address blocks that the token file is never fetched from: loopback, private,
+// shared, link-local (including the cloud metadata address 169.254.169.254) and reserved addresses.
+var nonPublicNetworks = parseNetworks(
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12",
+	"192.0.0.0/24", "192.0.2.0/24", "192.168.0.0/16", "198.18.0.0/15", "198.51.100.0/24", "203.0.113.0/24",
+	"224.0.0.0/4", "240.0.0.0/4", "::/128", "::1/128", "64:ff9b::/96", "100::/64", "2001:db8::/32",
+	"fc00::/7", "fe80::/10", "ff00::/8",
+)
+
+// HTTPFetcher fetches the content at a URL with an HTTP GET request. The domains are chosen by the app
+// owners, so the fetcher must not reach the internal network: use a client created by NewHTTPClient.
+type HTTPFetcher struct {
+	Client *http.Client
+}
+
+// NewHTTPClient creates an HTTP client for fetching the token files. It only connects to public IP
+// addresses, whatever the host names resolve to, ignores the proxy settings of the environment, and
+// only follows redirects to HTTPS on the same host.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: publicAddressesOnly,
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     time.Minute,
+		},
+		CheckRedirect: checkRedirect,
+	}
+}
+
+// publicAddressesOnly is a net.Dialer Control function that refuses to connect to non-public addresses.
+// It runs after the name resolution, so a host name that resolves to an internal address is refused too.
+func publicAddressesOnly(network, address string, conn syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !IsPublicIP(ip) {
+		return fmt.Errorf("connecting to the non-public address %s is not allowed", host)
+	}
+	return nil
+}
+
+// IsPublicIP checks whether the IP address is a public unicast address.
+func IsPublicIP(ip net.IP) bool {
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkRedirect only allows redirects to HTTPS on the host of the original request.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return errors.New("too many redirects")
+	}
+	if req.URL.Scheme != "https" {
+		return fmt.Errorf("redirect to the non-HTTPS URL %s is not allowed", req.URL)
+	}
+	if !strings.EqualFold(req.URL.Hostname(), via[0].URL.Hostname()) {
+		return fmt.Errorf("redirect to another host %s is not allowed", req.URL.Hostname())
+	}
+	return nil
+}
+
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	networks := []*net.IPNet{}
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// Fetch fetches the content at the URL. Fails if the response status is not 200 OK. Without a client,
+// a client created by NewHTTPClient with a 10 seconds timeout is used.
+func (f *HTTPFetcher) Fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := f.Client
+	if client == nil {
+		client = defaultClient
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+
+	return ioutil.ReadAll(io.LimitReader(resp.Body, maxTokenFileSize))
+}
+
+var defaultClient = NewHTTPClient(10 * time.Second)
+
+// Instructions tell the owner where to publish the verification token.
+type Instructions struct {
+	DNSRecordName  string
+	DNSRecordValue string
+	HTTPURL        string
+}
+
+// Verifier checks the verification tokens published on the app domains.
+type Verifier struct {
+	Resolver Resolver
+	Fetcher  Fetcher
+}
+
+// NewVerifier creates a new Verifier that uses the given resolver and fetcher.
+func NewVerifier(resolver Resolver, fetcher Fetcher) *Verifier {
+	return &Verifier{
+		Resolver: resolver,
+		Fetcher:  fetcher,
+	}
+}
+
+// Check checks that the token is published on the domain with the given method.
+// Returns nil if the token has been found.
+func (v *Verifier) Check(ctx context.Context, domain, token, method string) error {
+	instructions, err := GetInstructions(domain, token)
+	if err != nil {
+		return err
+	}
+
+	switch method {
+	case MethodDNS:
+		records, err := v.Resolver.LookupTXT(ctx, instructions.DNSRecordName)
+		if err != nil {
+			return fmt.Errorf("DNS lookup of %s failed: %s", instructions.DNSRecordName, err)
+		}
+		for _, record := range records {
+			if strings.TrimSpace(record) == instructions.DNSRecordValue {
+				return nil
+			}
+		}
+		return fmt.Errorf("no TXT record %q found at %s", instructions.DNSRecordValue, instructions.DNSRecordName)
+	case MethodHTTP:
+		content, err := v.Fetcher.Fetch(ctx, instructions.HTTPURL)
+		if err != nil {
+			return fmt.Errorf("fetching %s failed: %s", instructions.HTTPURL, err)
+		}
+		if strings.TrimSpace(string(content)) != token {
+			return fmt.Errorf("%s does not contain the verification token", instructions.HTTPURL)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unknown verification method %q", method)
+}
+
+// GetInstructions returns where to publish the token for the domain.
+func GetInstructions(domain, token string) (*Instructions, error) {
+	host, err := Host(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Instructions{
+		DNSRecordName:  DNSRecordPrefix + host,
+		DNSRecordValue: DNSValuePrefix + token,
+		HTTPURL:        "https://" + host + WellKnownPath,
+	}, nil
+}
+
+// Host returns the host name of the app domain, which may be given as a host name or as a URL.
+func Host(domain string) (string, error) {
+	if !strings.Contains(domain, "://") {
+		domain = "https://" + domain
+	}
+	parsed, err := url.Parse(domain)
+	if err != nil {
+		return "", err
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if host == "" {
+		return "", fmt.Errorf("invalid domain %q", domain)
+	}
+
+	return host, nil
+}
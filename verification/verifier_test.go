@@ -0,0 +1,193 @@
+package verification
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Microkubes/microservice-apps-management/db"
+)
+
+type resolverMock map[string][]string
+
+func (r resolverMock) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	records, ok := r[name]
+	if !ok {
+		return nil, fmt.Errorf("no such host")
+	}
+	return records, nil
+}
+
+type fetcherMock map[string]string
+
+func (f fetcherMock) Fetch(ctx context.Context, url string) ([]byte, error) {
+	content, ok := f[url]
+	if !ok {
+		return nil, fmt.Errorf("404 not found")
+	}
+	return []byte(content), nil
+}
+
+func TestGetInstructions(t *testing.T) {
+	for _, domain := range []string{"example.com", "https://Example.com/some/path", "http://example.com:8080"} {
+		instructions, err := GetInstructions(domain, "token")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if instructions.DNSRecordName != "_apps-verification.example.com" {
+			t.Errorf("Invalid DNS record name for %s: %s", domain, instructions.DNSRecordName)
+		}
+		if instructions.DNSRecordValue != "apps-verification=token" {
+			t.Errorf("Invalid DNS record value for %s: %s", domain, instructions.DNSRecordValue)
+		}
+		if instructions.HTTPURL != "https://example.com/.well-known/apps-verification.txt" {
+			t.Errorf("Invalid HTTP URL for %s: %s", domain, instructions.HTTPURL)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	verifier := NewVerifier(
+		resolverMock{"_apps-verification.example.com": {"v=spf1 -all", "apps-verification=token"}},
+		fetcherMock{"https://example.com/.well-known/apps-verification.txt": "token\n"},
+	)
+
+	if err := verifier.Check(context.Background(), "example.com", "token", MethodDNS); err != nil {
+		t.Errorf("Expected DNS verification to succeed: %s", err)
+	}
+	if err := verifier.Check(context.Background(), "example.com", "token", MethodHTTP); err != nil {
+		t.Errorf("Expected HTTP verification to succeed: %s", err)
+	}
+	if err := verifier.Check(context.Background(), "example.com", "other-token", MethodDNS); err == nil {
+		t.Error("Expected DNS verification with a wrong token to fail")
+	}
+	if err := verifier.Check(context.Background(), "example.com", "other-token", MethodHTTP); err == nil {
+		t.Error("Expected HTTP verification with a wrong token to fail")
+	}
+	if err := verifier.Check(context.Background(), "google.com", "token", MethodDNS); err == nil {
+		t.Error("Expected DNS verification of another domain to fail")
+	}
+}
+
+func TestHTTPFetcherRefusesNonPublicAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "token")
+	}))
+	defer server.Close()
+
+	fetcher := &HTTPFetcher{Client: NewHTTPClient(time.Second)}
+	if _, err := fetcher.Fetch(context.Background(), server.URL); err == nil || !strings.Contains(err.Error(), "non-public address") {
+		t.Errorf("Expected the loopback address to be refused, got %v", err)
+	}
+
+	for address, public := range map[string]bool{
+		"127.0.0.1":        false,
+		"10.1.2.3":         false,
+		"172.16.0.1":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"100.64.0.1":       false,
+		"0.0.0.0":          false,
+		"::1":              false,
+		"fd00::1":          false,
+		"fe80::1":          false,
+		"::ffff:127.0.0.1": false,
+		"93.184.216.34":    true,
+		"2606:4700::1111":  true,
+	} {
+		if IsPublicIP(net.ParseIP(address)) != public {
+			t.Errorf("Expected %s to be public: %t", address, public)
+		}
+	}
+}
+
+func TestHTTPFetcherRedirects(t *testing.T) {
+	client := NewHTTPClient(time.Second)
+	via := []*http.Request{httptest.NewRequest("GET", "https://example.com/.well-known/apps-verification.txt", nil)}
+
+	for target, allowed := range map[string]bool{
+		"https://example.com/token.txt":         true,
+		"https://EXAMPLE.com/token.txt":         true,
+		"http://example.com/token.txt":          false,
+		"https://internal.example.org/":         false,
+		"https://169.254.169.254/latest/meta":   false,
+		"file:///etc/passwd":                    false,
+		"https://example.com:8443/token.txt":    true,
+		"gopher://example.com/.well-known/file": false,
+	} {
+		req := httptest.NewRequest("GET", target, nil)
+		if err := client.CheckRedirect(req, via); (err == nil) != allowed {
+			t.Errorf("Expected the redirect to %s to be allowed: %t, got %v", target, allowed, err)
+		}
+	}
+
+	redirects := []*http.Request{}
+	for i := 0; i < maxRedirects; i++ {
+		redirects = append(redirects, via[0])
+	}
+	if err := client.CheckRedirect(httptest.NewRequest("GET", "https://example.com/", nil), redirects); err == nil {
+		t.Error("Expected too many redirects to be refused")
+	}
+}
+
+type storeMock struct {
+	apps    []*db.ClientApp
+	results map[string]*db.VerificationResult
+}
+
+func (s *storeMock) GetAppsToReverify(ctx context.Context, checkedBefore time.Time) ([]*db.ClientApp, error) {
+	return s.apps, nil
+}
+
+func (s *storeMock) SaveVerificationResult(ctx context.Context, appID string, result *db.VerificationResult) (*db.ClientApp, error) {
+	s.results[appID] = result
+	return &db.ClientApp{ID: appID}, nil
+}
+
+func TestReverifierRun(t *testing.T) {
+	store := &storeMock{
+		apps: []*db.ClientApp{
+			{ID: "still-valid", Domain: "example.com", VerificationToken: "token", VerificationMethod: MethodDNS, DomainVerified: true},
+			{ID: "first-failure", Domain: "example.org", VerificationToken: "token", VerificationMethod: MethodDNS, DomainVerified: true},
+			{ID: "last-failure", Domain: "example.org", VerificationToken: "token", VerificationMethod: MethodHTTP, DomainVerified: true, VerificationFailures: 2},
+		},
+		results: map[string]*db.VerificationResult{},
+	}
+	verifier := NewVerifier(resolverMock{"_apps-verification.example.com": {"apps-verification=token"}}, fetcherMock{})
+	reverifier := NewReverifier(store, verifier, 24*time.Hour, 3, time.Second)
+
+	if err := reverifier.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if result := store.results["still-valid"]; !result.Verified || result.Error != "" {
+		t.Errorf("Expected still-valid to stay verified, got %+v", result)
+	}
+	if result := store.results["first-failure"]; !result.Verified || result.Failures != 1 || result.Error == "" {
+		t.Errorf("Expected first-failure to stay verified with 1 failure, got %+v", result)
+	}
+	if result := store.results["last-failure"]; result.Verified || result.Failures != 3 {
+		t.Errorf("Expected last-failure to lose the verification, got %+v", result)
+	}
+}
+
+func TestReverifierRunCanceled(t *testing.T) {
+	store := &storeMock{
+		apps:    []*db.ClientApp{{ID: "verified", Domain: "example.com", VerificationToken: "token", DomainVerified: true}},
+		results: map[string]*db.VerificationResult{},
+	}
+	verifier := NewVerifier(resolverMock{"_apps-verification.example.com": {"apps-verification=token"}}, fetcherMock{})
+	reverifier := NewReverifier(store, verifier, 24*time.Hour, 1, time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reverifier.Run(ctx)
+	if len(store.results) != 0 {
+		t.Fatalf("Expected the checks of a canceled run not to be saved as failures, got %+v", store.results)
+	}
+}